@@ -9,6 +9,10 @@ import (
 	"github.com/user/flowlint/internal/parser"
 )
 
+var (
+	checkStrict bool
+)
+
 var checkCmd = &cobra.Command{
 	Use:   "check <diagram.md> <dependencies.yaml>",
 	Short: "Verify diagram completeness against dependencies",
@@ -21,11 +25,19 @@ Checks:
 - All databases appear
 - All caches appear
 - All external systems appear
-- Internal steps (if present) appear as nodes`,
+- Internal steps (if present) appear as nodes
+
+When the file has several mermaid blocks, a dependency counts as
+represented if it appears in any of them. Use --strict to fail on lines
+the parser does not understand, which could otherwise hide nodes.`,
 	Args: cobra.ExactArgs(2),
 	RunE: runCheck,
 }
 
+func init() {
+	checkCmd.Flags().BoolVar(&checkStrict, "strict", false, "Fail on statements the parser does not understand")
+}
+
 func runCheck(cmd *cobra.Command, args []string) error {
 	diagramPath := args[0]
 	depsPath := args[1]
@@ -42,17 +54,13 @@ func runCheck(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to read dependencies: %w", err)
 	}
 
-	// Extract mermaid
-	mermaidCode, err := parser.ExtractMermaid(string(diagramContent))
+	// Extract and parse every mermaid block; a dependency may appear in
+	// any of them
+	blocks, err := parseDiagrams(diagramPath, string(diagramContent), strictParsing(cmd, checkStrict))
 	if err != nil {
-		return fmt.Errorf("failed to extract mermaid: %w", err)
-	}
-
-	// Parse mermaid
-	diagram, err := parser.ParseMermaid(mermaidCode)
-	if err != nil {
-		return fmt.Errorf("failed to parse mermaid: %w", err)
+		return err
 	}
+	diagram := diagramsOf(blocks)
 
 	// Parse dependencies
 	deps, err := parser.ParseDependencies(depsContent)
@@ -61,7 +69,7 @@ func runCheck(cmd *cobra.Command, args []string) error {
 	}
 
 	// Check completeness
-	fmt.Println("Checking diagram completeness...\n")
+	fmt.Print("Checking diagram completeness...\n\n")
 
 	missing := []string{}
 	found := 0
@@ -162,6 +170,15 @@ func runCheck(cmd *cobra.Command, args []string) error {
 		fmt.Println("Coverage: 0/0 (no dependencies)")
 	}
 
+	// In strict mode, lines the parser skipped may hide nodes
+	diagnostics := diagnosticsOf(blocks)
+	if len(diagnostics) > 0 {
+		fmt.Println("\nUnrecognized statements (coverage may be understated):")
+		for _, d := range diagnostics {
+			fmt.Printf("  - %s\n", d)
+		}
+	}
+
 	if len(missing) > 0 {
 		fmt.Println("\nMissing items:")
 		for _, m := range missing {
@@ -170,6 +187,10 @@ func runCheck(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("diagram is incomplete: %d missing items", len(missing))
 	}
 
+	if len(diagnostics) > 0 {
+		return fmt.Errorf("diagram has %d unrecognized statements", len(diagnostics))
+	}
+
 	fmt.Println("\n✓ Diagram is complete")
 	return nil
 }
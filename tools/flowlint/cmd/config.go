@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/user/flowlint/internal/config"
+	"github.com/user/flowlint/internal/linter"
+	"gopkg.in/yaml.v3"
+)
+
+var configPath string
+
+// project is the configuration of the project the command works on,
+// loaded before any subcommand runs
+var project *config.Config
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Inspect the project configuration",
+	Long: `flowlint reads its settings from ` + config.FileName + `, found by walking
+up from the diagram's directory (or given with --config):
+
+  style_guide: styles/diagram-styles.yaml
+  strict: true
+  rules:
+    disable: [complexity]
+    severity: {orphan-nodes: error}
+  keywords:
+    async: [kafka, publish, consume, queue]
+    sync: [grpc, http, sql]
+    abbreviations:
+      - {short: svc, full: Service}
+  required_classes: [service, kafka, database, external]
+  thresholds:
+    complexity: {ungrouped_nodes: 10, edges_per_node: 2.0}
+    layout: {crossings: 2, long_edges: 3, piercings: 2}
+  validate: {backend: native}
+  render: {width: 3840, scale: 2, background: white}
+  export: {format: d2}
+
+Every command reads the file. strict applies to lint, refine and check;
+validate, render and export set the defaults of those commands' flags.
+Settings the file leaves out keep their defaults. Relative paths are
+resolved against the file's directory. Flags on the command line,
+including the rule flags (--enable, --disable, --severity), apply on top
+of the file.`,
+}
+
+var configShowCmd = &cobra.Command{
+	Use:   "show [path]",
+	Short: "Print the configuration that applies to a file or directory",
+	Long: `Prints the configuration flowlint uses for the given diagram or
+directory (default: the working directory), with every default filled
+in, and the file it was loaded from.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runConfigShow,
+}
+
+func init() {
+	configCmd.AddCommand(configShowCmd)
+}
+
+func runConfigShow(cmd *cobra.Command, args []string) error {
+	cfg := project
+	if cfg.Path == "" {
+		fmt.Printf("# No %s found; using defaults\n", config.FileName)
+	} else {
+		fmt.Printf("# Loaded from %s\n", cfg.Path)
+	}
+	encoder := yaml.NewEncoder(os.Stdout)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(cfg); err != nil {
+		return fmt.Errorf("failed to print config: %w", err)
+	}
+	return encoder.Close()
+}
+
+// loadConfig returns the project configuration for a file or directory
+func loadConfig(path string) (*config.Config, error) {
+	dir := path
+	if info, err := os.Stat(path); err != nil || !info.IsDir() {
+		dir = filepath.Dir(path)
+	}
+	return config.Discover(dir, configPath)
+}
+
+// lintConfig returns the lint settings of the project: its
+// configuration and style guide with the rule flags applied on top
+func lintConfig(flags linter.Config) (linter.Config, error) {
+	if err := flags.Validate(); err != nil {
+		return linter.Config{}, err
+	}
+	guide, err := project.Guide()
+	if err != nil {
+		return linter.Config{}, err
+	}
+	rules := project.Linter().WithRules(flags)
+	rules.Guide = guide
+	return rules, nil
+}
+
+// strictParsing reports whether a command parses strictly: its --strict
+// flag if given, else the project's strict setting
+func strictParsing(cmd *cobra.Command, flag bool) bool {
+	if cmd.Flags().Changed("strict") {
+		return flag
+	}
+	return project.Strict || flag
+}
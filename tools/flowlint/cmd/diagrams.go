@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/user/flowlint/internal/parser"
+)
+
+// diagramBlock is one parsed mermaid block of a markdown document
+type diagramBlock struct {
+	parser.MermaidBlock
+	Diagram *parser.Diagram
+}
+
+// parseDiagrams extracts and parses every mermaid block in the markdown
+// content of path. Locations in the diagrams point into path. In strict
+// mode unrecognized statements are kept as diagnostics.
+func parseDiagrams(path, content string, strict bool) ([]diagramBlock, error) {
+	blocks := parser.ExtractMermaidBlocks(content)
+	if len(blocks) == 0 {
+		return nil, fmt.Errorf("failed to extract mermaid: no mermaid code block found")
+	}
+
+	parsed := make([]diagramBlock, 0, len(blocks))
+	for _, block := range blocks {
+		diagram, err := parser.ParseMermaidBlock(path, block, parser.ParseOptions{Strict: strict})
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse mermaid block %d (line %d): %w", block.Index+1, block.Line, err)
+		}
+		parsed = append(parsed, diagramBlock{MermaidBlock: block, Diagram: diagram})
+	}
+	return parsed, nil
+}
+
+// blockTitle names a block in output when a document has several
+func blockTitle(block diagramBlock, total int) string {
+	return fmt.Sprintf("Diagram %d of %d (line %d)", block.Index+1, total, block.Line)
+}
+
+// diagnosticsOf collects the parse diagnostics of blocks
+func diagnosticsOf(blocks []diagramBlock) []parser.Diagnostic {
+	diagnostics := []parser.Diagnostic{}
+	for _, block := range blocks {
+		diagnostics = append(diagnostics, block.Diagram.Diagnostics...)
+	}
+	return diagnostics
+}
+
+// locPrefix formats a location as a "file:line:col: " message prefix, or
+// nothing if the location is unknown
+func locPrefix(loc parser.Location) string {
+	if !loc.IsValid() {
+		return ""
+	}
+	return loc.String() + ": "
+}
+
+// replaceBlocks writes fixed code back into each block that has it,
+// working from the last block so earlier offsets stay valid
+func replaceBlocks(content string, blocks []diagramBlock, fixed []string) string {
+	for i := len(blocks) - 1; i >= 0; i-- {
+		if fixed[i] != "" {
+			content = parser.ReplaceMermaidBlock(content, blocks[i].MermaidBlock, fixed[i])
+		}
+	}
+	return content
+}
+
+// diagramSet treats the diagrams of one document as a whole: a node
+// counts as present if any of the diagrams has it
+type diagramSet []*parser.Diagram
+
+func (s diagramSet) HasNodeWithLabel(label string) bool {
+	for _, diagram := range s {
+		if diagram.HasNodeWithLabel(label) {
+			return true
+		}
+	}
+	return false
+}
+
+// diagramsOf collects the parsed diagrams of blocks
+func diagramsOf(blocks []diagramBlock) diagramSet {
+	set := make(diagramSet, 0, len(blocks))
+	for _, block := range blocks {
+		set = append(set, block.Diagram)
+	}
+	return set
+}
+
+// indentLines indents every line of a multi-line message but the first
+func indentLines(text, indent string) string {
+	return strings.ReplaceAll(text, "\n", "\n"+indent)
+}
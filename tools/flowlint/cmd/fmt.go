@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/user/flowlint/internal/parser"
+)
+
+var (
+	fmtCheck bool
+)
+
+var fmtCmd = &cobra.Command{
+	Use:   "fmt <diagram.md>",
+	Short: "Rewrite diagrams in canonical form",
+	Long: `Rewrites every Mermaid block in the markdown file in canonical form,
+so that diagram diffs show only meaningful changes:
+
+- Sections in a fixed order: classDefs, subgraphs, nodes, edges,
+  class assignments, then style/linkStyle/click
+- Four-space indentation per nesting level
+- Canonical arrows with one space around them; labels in |pipe| form
+- Class assignments grouped by class: class A,B service
+- %% comments kept with the statement they describe
+
+linkStyle indices are renumbered to follow the edges they styled.
+Blocks containing lines the parser does not understand are left alone.
+
+Use --check to report unformatted diagrams without rewriting them.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runFmt,
+}
+
+func init() {
+	fmtCmd.Flags().BoolVar(&fmtCheck, "check", false, "Report unformatted diagrams and exit non-zero instead of rewriting")
+}
+
+func runFmt(cmd *cobra.Command, args []string) error {
+	diagramPath := args[0]
+
+	content, err := os.ReadFile(diagramPath)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+
+	// Strict parsing so blocks with unknown statements are not reformatted
+	blocks, err := parseDiagrams(diagramPath, string(content), true)
+	if err != nil {
+		return err
+	}
+
+	formatted := make([]string, len(blocks))
+	changed := 0
+	for i, block := range blocks {
+		code, err := parser.Format(block.Diagram)
+		if err != nil {
+			return fmt.Errorf("failed to format: %w", err)
+		}
+		if code == block.Code {
+			continue
+		}
+		formatted[i] = code
+		changed++
+		if fmtCheck {
+			fmt.Printf("✗ %s:%d: diagram is not formatted\n", diagramPath, block.Line)
+		}
+	}
+
+	if changed == 0 {
+		fmt.Println("✓ Diagrams are formatted")
+		return nil
+	}
+
+	if fmtCheck {
+		return fmt.Errorf("%d of %d diagrams need formatting (run flowlint fmt)", changed, len(blocks))
+	}
+
+	if err := os.WriteFile(diagramPath, []byte(replaceBlocks(string(content), blocks, formatted)), 0644); err != nil {
+		return fmt.Errorf("failed to write output: %w", err)
+	}
+	fmt.Printf("✓ Formatted %d of %d diagrams in %s\n", changed, len(blocks), diagramPath)
+	return nil
+}
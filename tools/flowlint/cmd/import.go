@@ -0,0 +1,209 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/user/flowlint/internal/config"
+	"github.com/user/flowlint/internal/dot"
+	"github.com/user/flowlint/internal/importer"
+	"github.com/user/flowlint/internal/linter"
+	"github.com/user/flowlint/internal/parser"
+	"github.com/user/flowlint/internal/styles"
+)
+
+var (
+	importFrom     string
+	importOutput   string
+	importTemplate string
+	importStyles   string
+	importName     string
+	importRules    linter.Config
+)
+
+// templatePath is where the diagram template lives in a repository
+const templatePath = "templates/diagram-template.md"
+
+// builtinTemplate is used when no diagram template is found: the header
+// and legend of the repository's template around the diagram
+const builtinTemplate = `# Service Flow: __SERVICE_NAME__
+
+> Generated: __TIMESTAMP__
+> Source: __TARGET_PATH__
+
+---
+
+## Diagram
+
+` + "```mermaid\nflowchart TD\n```" + `
+
+---
+
+## Legend
+
+| Symbol | Meaning |
+|--------|---------|
+| ` + "`==>`" + ` | **Synchronous** (gRPC/HTTP) |
+| ` + "`-.->`" + ` | **Asynchronous** (Kafka) |
+| ` + "`-->`" + ` | Internal call / step chain |
+`
+
+var importCmd = &cobra.Command{
+	Use:   "import --from dot <legacy.dot>",
+	Short: "Convert Graphviz DOT into a style-compliant diagram",
+	Long: `Converts a diagram written in another language into a Mermaid
+flowchart inside the diagram template:
+
+- Clusters become subgraphs with quoted titles
+- Node types are inferred from the class or type attribute, then the
+  shape (cylinder, doubleoctagon, diamond, ...), then keywords in the
+  label; each type gets the style guide's shape and class
+- Dashed edges and edges touching a topic become async (-.->), bold
+  edges and edges between clusters sync (==>), edges inside a cluster
+  internal (-->)
+- The classDefs from the style guide are applied, and the flowchart
+  runs in its direction whatever the DOT rankdir
+
+The result is linted and auto-fixed; issues that need a human, such as
+orphan nodes or abbreviations, are reported; --enable, --disable and
+--severity choose the rules. The template and style
+guide are found by walking up from the input file, or given with
+--template and --styles; style_guide in .flowlint.yaml also sets the
+style guide. Built-in ones are used if none is found. Output goes
+next to the input as .md unless -o is given.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runImport,
+}
+
+func init() {
+	importCmd.Flags().StringVar(&importFrom, "from", "dot", "Input language: dot")
+	importCmd.Flags().StringVarP(&importOutput, "output", "o", "", "Output file (default: the input with a .md extension)")
+	importCmd.Flags().StringVar(&importTemplate, "template", "", "Markdown template (default: "+templatePath+" above the input, else a built-in one)")
+	importCmd.Flags().StringVar(&importStyles, "styles", "", "Style guide (default: style_guide from "+config.FileName+" or "+config.StyleGuidePath+" above the input)")
+	importCmd.Flags().StringVar(&importName, "name", "", "Service name for the template (default: the graph name)")
+	addRuleFlags(importCmd, &importRules)
+}
+
+func runImport(cmd *cobra.Command, args []string) error {
+	inputPath := args[0]
+	if importFrom != "dot" {
+		return fmt.Errorf("unknown input language %q: use dot", importFrom)
+	}
+	rules, err := lintConfig(importRules)
+	if err != nil {
+		return err
+	}
+
+	source, err := os.ReadFile(inputPath)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+	graph, err := dot.Parse(string(source))
+	if err != nil {
+		return fmt.Errorf("failed to parse %s: %w", inputPath, err)
+	}
+
+	template, tmplPath := builtinTemplate, "built-in template"
+	if path, err := locate(importTemplate, inputPath, templatePath, "--template"); err == nil {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read template: %w", err)
+		}
+		template, tmplPath = string(data), path
+	}
+	if importStyles != "" {
+		if rules.Guide, err = styles.LoadGuide(importStyles); err != nil {
+			return err
+		}
+	}
+
+	code, err := importer.FromDOT(graph, importer.Options{Guide: rules.Guide})
+	if err != nil {
+		return err
+	}
+	code, issues, err := lintImported(code, rules)
+	if err != nil {
+		return err
+	}
+
+	name := importName
+	if name == "" {
+		name = graph.ID
+	}
+	if name == "" {
+		name = strings.TrimSuffix(filepath.Base(inputPath), filepath.Ext(inputPath))
+	}
+
+	blocks := parser.ExtractMermaidBlocks(template)
+	if len(blocks) == 0 {
+		return fmt.Errorf("template %s has no mermaid block", tmplPath)
+	}
+	content := parser.ReplaceMermaidBlock(template, blocks[0], code)
+	content = strings.NewReplacer(
+		"__SERVICE_NAME__", name,
+		"__SERVICE_SHORT__", name,
+		"__TIMESTAMP__", time.Now().UTC().Format(time.RFC3339),
+		"__TARGET_PATH__", inputPath,
+	).Replace(content)
+
+	outputPath := importOutput
+	if outputPath == "" {
+		outputPath = strings.TrimSuffix(inputPath, filepath.Ext(inputPath)) + ".md"
+	}
+	if err := os.WriteFile(outputPath, []byte(content), 0644); err != nil {
+		return fmt.Errorf("failed to write output: %w", err)
+	}
+
+	for _, issue := range issues {
+		if issue.Severity == linter.SeverityError {
+			fmt.Printf("❌ ERROR: %s %s\n", issue.Message, ruleTag(issue))
+		} else {
+			fmt.Printf("⚠️  WARNING: %s %s\n", issue.Message, ruleTag(issue))
+		}
+		if issue.Suggestion != "" {
+			fmt.Printf("   Suggestion: %s\n", indentLines(issue.Suggestion, "     "))
+		}
+	}
+	fmt.Printf("✓ Imported %s to %s (%d nodes, %d edges)\n", inputPath, outputPath, len(graph.Nodes), len(graph.Edges))
+	return nil
+}
+
+// lintImported applies the linter's fixes to converted code and returns
+// it in canonical form with the issues left over
+func lintImported(code string, cfg linter.Config) (string, []linter.Issue, error) {
+	d, err := parser.ParseMermaid(code)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to parse converted diagram: %w", err)
+	}
+	if fixedCode, n := linter.Fix(code, linter.Lint(d, cfg)); n > 0 {
+		if d, err = parser.ParseMermaid(fixedCode); err != nil {
+			return "", nil, fmt.Errorf("failed to parse fixed diagram: %w", err)
+		}
+		if code, err = parser.Format(d); err != nil {
+			return "", nil, fmt.Errorf("failed to format: %w", err)
+		}
+	}
+	return code, linter.Lint(d, cfg), nil
+}
+
+// locate returns path if it was given, or else looks for rel in the
+// directories above the input file and then above the working directory
+func locate(path, inputPath, rel, flag string) (string, error) {
+	if path != "" {
+		return path, nil
+	}
+	starts := []string{filepath.Dir(inputPath)}
+	if wd, err := os.Getwd(); err == nil {
+		starts = append(starts, wd)
+	}
+	for _, start := range starts {
+		if found := config.FindUp(start, rel); found != "" {
+			return found, nil
+		}
+	}
+	return "", fmt.Errorf("could not find %s above %s; pass %s", rel, inputPath, flag)
+}
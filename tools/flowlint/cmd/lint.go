@@ -5,13 +5,18 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
+	"github.com/user/flowlint/internal/layout"
 	"github.com/user/flowlint/internal/linter"
 	"github.com/user/flowlint/internal/parser"
 )
 
 var (
-	lintFix    bool
-	lintOutput string
+	lintFix     bool
+	lintOutput  string
+	lintStrict  bool
+	lintDeps    string
+	lintMetrics bool
+	lintRules   linter.Config
 )
 
 var lintCmd = &cobra.Command{
@@ -25,11 +30,22 @@ Checks performed:
 - Async calls use -.-> arrows
 - All subgraph titles are quoted
 - classDef styles are defined and applied
+- Inline style/linkStyle colors come from the palette
 - No abbreviations in node labels
 - No orphan nodes (unconnected)
 - No duplicate node IDs
-
-Use --fix to automatically fix issues where possible.`,
+- Node shapes match their type, and every node has a class
+- The diagram is within the style guide's size budget
+- Edges do not cross, span many layers or cut through subgraphs
+
+Every mermaid block in the file is linted. Use --fix to automatically
+fix issues where possible; each fix is written back to its own block.
+Use --strict to report lines the parser does not understand instead of
+skipping them. With --deps, node classes are inferred from the
+dependency types in dependencies.yaml. --metrics prints layout
+metrics estimated with a layered layout. Rules and their settings come from .flowlint.yaml (see
+flowlint config); --enable, --disable and --severity apply on top, and
+flowlint rules lists them.`,
 	Args: cobra.ExactArgs(1),
 	RunE: runLint,
 }
@@ -37,10 +53,23 @@ Use --fix to automatically fix issues where possible.`,
 func init() {
 	lintCmd.Flags().BoolVar(&lintFix, "fix", false, "Automatically fix issues")
 	lintCmd.Flags().StringVarP(&lintOutput, "output", "o", "", "Output file for fixed diagram")
+	lintCmd.Flags().BoolVar(&lintStrict, "strict", false, "Report unrecognized statements as errors")
+	lintCmd.Flags().BoolVar(&lintMetrics, "metrics", false, "Print layout metrics: crossings, long edges, edges through subgraphs")
+	lintCmd.Flags().StringVar(&lintDeps, "deps", "", "dependencies.yaml the diagram documents, to infer node classes")
+	addRuleFlags(lintCmd, &lintRules)
 }
 
 func runLint(cmd *cobra.Command, args []string) error {
 	diagramPath := args[0]
+	rules, err := lintConfig(lintRules)
+	if err != nil {
+		return err
+	}
+	if lintDeps != "" {
+		if rules.Deps, err = readDependencies(lintDeps); err != nil {
+			return err
+		}
+	}
 
 	// Read the markdown file
 	content, err := os.ReadFile(diagramPath)
@@ -48,56 +77,82 @@ func runLint(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to read file: %w", err)
 	}
 
-	// Extract mermaid code block
-	mermaidCode, err := parser.ExtractMermaid(string(content))
+	// Extract and parse every mermaid block
+	blocks, err := parseDiagrams(diagramPath, string(content), strictParsing(cmd, lintStrict))
 	if err != nil {
-		return fmt.Errorf("failed to extract mermaid: %w", err)
+		return err
 	}
 
-	// Parse the mermaid diagram
-	diagram, err := parser.ParseMermaid(mermaidCode)
-	if err != nil {
-		return fmt.Errorf("failed to parse mermaid: %w", err)
-	}
-
-	// Run linting rules
-	issues := linter.Lint(diagram)
-
-	// Print issues
 	hasErrors := false
-	for _, issue := range issues {
-		switch issue.Severity {
-		case linter.SeverityError:
-			fmt.Printf("❌ ERROR: %s\n", issue.Message)
-			if issue.Line > 0 {
-				fmt.Printf("   Line %d: %s\n", issue.Line, issue.Context)
+	issueCount := 0
+	fixed := make([]string, len(blocks))
+	totalFixes := 0
+	for i, block := range blocks {
+		if len(blocks) > 1 {
+			fmt.Printf("── %s ──\n\n", blockTitle(block, len(blocks)))
+		}
+
+		// Run linting rules
+		issues := linter.Lint(block.Diagram, rules)
+		issueCount += len(issues)
+
+		// Print issues
+		for _, issue := range issues {
+			switch issue.Severity {
+			case linter.SeverityError:
+				fmt.Printf("❌ ERROR: %s %s\n", issue.Message, ruleTag(issue))
+				if issue.Loc.IsValid() {
+					fmt.Printf("   %s: %s\n", issue.Loc, issue.Context)
+				}
+				if issue.Suggestion != "" {
+					fmt.Printf("   Suggestion: %s\n", indentLines(issue.Suggestion, "     "))
+				}
+				hasErrors = true
+			case linter.SeverityWarning:
+				fmt.Printf("⚠️  WARNING: %s %s\n", issue.Message, ruleTag(issue))
+				if issue.Loc.IsValid() {
+					fmt.Printf("   %s: %s\n", issue.Loc, issue.Context)
+				}
+				if issue.Suggestion != "" {
+					fmt.Printf("   Suggestion: %s\n", indentLines(issue.Suggestion, "     "))
+				}
 			}
-			if issue.Suggestion != "" {
-				fmt.Printf("   Suggestion: %s\n", issue.Suggestion)
+			fmt.Println()
+		}
+
+		if lintMetrics {
+			printLayoutMetrics(block.Diagram)
+		}
+
+		if len(issues) == 0 {
+			fmt.Println("✓ No style issues found")
+			if len(blocks) > 1 {
+				fmt.Println()
 			}
-			hasErrors = true
-		case linter.SeverityWarning:
-			fmt.Printf("⚠️  WARNING: %s\n", issue.Message)
-			if issue.Suggestion != "" {
-				fmt.Printf("   Suggestion: %s\n", issue.Suggestion)
+			continue
+		}
+
+		// Fixes only ever touch the block they were found in
+		if lintFix {
+			fixedCode, fixCount := linter.Fix(block.Code, issues)
+			if fixCount > 0 {
+				fixed[i] = fixedCode
+				totalFixes += fixCount
 			}
 		}
-		fmt.Println()
 	}
 
-	if len(issues) == 0 {
-		fmt.Println("✓ No style issues found")
+	if issueCount == 0 {
 		return nil
 	}
 
 	// Apply fixes if requested
 	if lintFix {
-		fixedCode, fixCount := linter.Fix(mermaidCode, issues)
-		if fixCount > 0 {
-			fmt.Printf("\n✓ Applied %d automatic fixes\n", fixCount)
+		if totalFixes > 0 {
+			fmt.Printf("\n✓ Applied %d automatic fixes\n", totalFixes)
 
-			// Replace mermaid block in original content
-			fixedContent := parser.ReplaceMermaid(string(content), fixedCode)
+			// Replace fixed blocks in original content
+			fixedContent := replaceBlocks(string(content), blocks, fixed)
 
 			// Write output
 			outputPath := lintOutput
@@ -115,8 +170,17 @@ func runLint(cmd *cobra.Command, args []string) error {
 	}
 
 	if hasErrors && !lintFix {
-		return fmt.Errorf("found %d issues (use --fix to auto-fix)", len(issues))
+		return fmt.Errorf("found %d issues (use --fix to auto-fix)", issueCount)
 	}
 
 	return nil
 }
+
+// printLayoutMetrics prints the layout metrics of a diagram with the
+// nodes in declaration order, and the crossings left after reordering
+func printLayoutMetrics(d *parser.Diagram) {
+	declared := layout.Declared(d).Metrics
+	best := layout.Compute(d).Metrics
+	fmt.Printf("Layout: %d layers, %d crossings (%d after reordering), %d long edges, %d edges through subgraphs\n\n",
+		declared.Ranks, declared.Crossings, best.Crossings, declared.LongEdges, declared.Piercings)
+}
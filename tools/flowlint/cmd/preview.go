@@ -0,0 +1,71 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/user/flowlint/internal/layout"
+	"github.com/user/flowlint/internal/render"
+)
+
+var (
+	previewDiagram  int
+	previewSubgraph string
+)
+
+var previewCmd = &cobra.Command{
+	Use:   "preview <diagram.md>",
+	Short: "Draw a diagram in the terminal",
+	Long: `Lays out a Mermaid flowchart like render does and draws it with
+Unicode box-drawing characters, for a look at the diagram over SSH or in
+CI logs:
+
+- Subgraphs are double-line frames with the title in the top border
+- Node outlines hint at the shape: ┌┐ rectangle, ╭╮ rounded or stadium,
+  ╒╕ cylinder, ╓╖ double rectangle, ╱╲ diamond or hexagon
+- Sync edges (==>) are heavy ━┃, async edges (-.->) dotted ┄┆ and
+  internal edges (-->) thin ─│
+
+Labels too long for their box are cut short with …
+
+Use --subgraph to zoom into one group: only its nodes, nested subgraphs
+and the edges between them are drawn. Use --diagram to pick a block when
+the document has several.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPreview,
+}
+
+func init() {
+	previewCmd.Flags().IntVar(&previewDiagram, "diagram", 1, "Which mermaid block to preview, counting from 1")
+	previewCmd.Flags().StringVar(&previewSubgraph, "subgraph", "", "Only draw the subgraph with this ID")
+}
+
+func runPreview(cmd *cobra.Command, args []string) error {
+	diagramPath := args[0]
+
+	content, err := os.ReadFile(diagramPath)
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+
+	blocks, err := parseDiagrams(diagramPath, string(content), false)
+	if err != nil {
+		return err
+	}
+	if previewDiagram < 1 || previewDiagram > len(blocks) {
+		return fmt.Errorf("no diagram %d: %s has %d", previewDiagram, diagramPath, len(blocks))
+	}
+	diagram := blocks[previewDiagram-1].Diagram
+
+	if previewSubgraph != "" {
+		sub := diagram.Subdiagram(previewSubgraph)
+		if sub == nil {
+			return fmt.Errorf("no subgraph %q in %s", previewSubgraph, diagramPath)
+		}
+		diagram = sub
+	}
+
+	fmt.Print(render.Terminal(layout.Compute(diagram)))
+	return nil
+}
@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"os/exec"
 
 	"github.com/spf13/cobra"
 	"github.com/user/flowlint/internal/linter"
@@ -11,6 +12,8 @@ import (
 
 var (
 	refineOutput string
+	refineStrict bool
+	refineRules  linter.Config
 )
 
 var refineCmd = &cobra.Command{
@@ -22,19 +25,29 @@ var refineCmd = &cobra.Command{
 2. Lint - Check style guide and auto-fix
 3. Check - Verify completeness against dependencies
 
-Requires npx (Node.js) for syntax validation.
-Use --output to specify output file (defaults to overwriting input).`,
+Syntax validation uses mermaid-cli through npx (Node.js). When npx is
+not installed, the native grammar check is used instead.
+Use --output to specify output file (defaults to overwriting input).
+Use --strict to report lines the parser does not understand as errors.
+Node classes are inferred from the dependency types. Lint rules come
+from .flowlint.yaml; --enable, --disable and --severity apply on top.`,
 	Args: cobra.ExactArgs(2),
 	RunE: runRefine,
 }
 
 func init() {
 	refineCmd.Flags().StringVarP(&refineOutput, "output", "o", "", "Output file for refined diagram")
+	refineCmd.Flags().BoolVar(&refineStrict, "strict", false, "Report unrecognized statements as errors")
+	addRuleFlags(refineCmd, &refineRules)
 }
 
 func runRefine(cmd *cobra.Command, args []string) error {
 	diagramPath := args[0]
 	depsPath := args[1]
+	rules, err := lintConfig(refineRules)
+	if err != nil {
+		return err
+	}
 
 	fmt.Println("╔══════════════════════════════════════════════════╗")
 	fmt.Println("║          flowlint refinement pipeline            ║")
@@ -47,15 +60,21 @@ func runRefine(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to read diagram: %w", err)
 	}
 
-	depsContent, err := os.ReadFile(depsPath)
+	deps, err := readDependencies(depsPath)
 	if err != nil {
-		return fmt.Errorf("failed to read dependencies: %w", err)
+		return err
 	}
+	rules.Deps = deps
 
 	// Step 1: Validate (required)
 	fmt.Println("Step 1: Syntax Validation")
 	fmt.Println("─────────────────────────")
-	if err := runValidate(cmd, []string{diagramPath}); err != nil {
+	backend := project.Validate.Backend
+	if _, err := exec.LookPath("npx"); backend == backendMmdc && err != nil {
+		fmt.Printf("npx not found, using the native validator (Mermaid %s grammar)\n", parser.MermaidVersion)
+		backend = backendNative
+	}
+	if err := validateFile(diagramPath, backend); err != nil {
 		return fmt.Errorf("validation failed: %w", err)
 	}
 	fmt.Println()
@@ -64,41 +83,47 @@ func runRefine(cmd *cobra.Command, args []string) error {
 	fmt.Println("Step 2: Style Linting")
 	fmt.Println("─────────────────────")
 
-	mermaidCode, err := parser.ExtractMermaid(string(diagramContent))
-	if err != nil {
-		return fmt.Errorf("failed to extract mermaid: %w", err)
-	}
-
-	diagram, err := parser.ParseMermaid(mermaidCode)
+	blocks, err := parseDiagrams(diagramPath, string(diagramContent), strictParsing(cmd, refineStrict))
 	if err != nil {
-		return fmt.Errorf("failed to parse mermaid: %w", err)
+		return err
 	}
 
-	issues := linter.Lint(diagram)
-
 	errorCount := 0
 	warningCount := 0
-	for _, issue := range issues {
-		switch issue.Severity {
-		case linter.SeverityError:
-			fmt.Printf("  ❌ %s\n", issue.Message)
-			errorCount++
-		case linter.SeverityWarning:
-			fmt.Printf("  ⚠️  %s\n", issue.Message)
-			warningCount++
+	fixCount := 0
+	fixed := make([]string, len(blocks))
+	for i, block := range blocks {
+		issues := linter.Lint(block.Diagram, rules)
+		if len(blocks) > 1 && len(issues) > 0 {
+			fmt.Printf("  %s:\n", blockTitle(block, len(blocks)))
+		}
+		for _, issue := range issues {
+			switch issue.Severity {
+			case linter.SeverityError:
+				fmt.Printf("  ❌ %s%s %s\n", locPrefix(issue.Loc), issue.Message, ruleTag(issue))
+				errorCount++
+			case linter.SeverityWarning:
+				fmt.Printf("  ⚠️  %s%s %s\n", locPrefix(issue.Loc), issue.Message, ruleTag(issue))
+				warningCount++
+			}
+		}
+
+		// Fixes only ever touch the block they were found in
+		fixedCode, n := linter.Fix(block.Code, issues)
+		if n > 0 {
+			fixed[i] = fixedCode
+			fixCount += n
 		}
 	}
 
-	if len(issues) == 0 {
+	if errorCount+warningCount == 0 {
 		fmt.Println("  ✓ No style issues found")
 	} else {
 		fmt.Printf("\n  Found %d errors, %d warnings\n", errorCount, warningCount)
-
-		// Apply fixes
-		fixedCode, fixCount := linter.Fix(mermaidCode, issues)
 		if fixCount > 0 {
 			fmt.Printf("  ✓ Applied %d automatic fixes\n", fixCount)
-			diagramContent = []byte(parser.ReplaceMermaid(string(diagramContent), fixedCode))
+
+			diagramContent = []byte(replaceBlocks(string(diagramContent), blocks, fixed))
 		}
 	}
 	fmt.Println()
@@ -107,16 +132,13 @@ func runRefine(cmd *cobra.Command, args []string) error {
 	fmt.Println("Step 3: Completeness Check")
 	fmt.Println("──────────────────────────")
 
-	deps, err := parser.ParseDependencies(depsContent)
+	// Re-parse diagrams with fixes applied
+	blocks, err = parseDiagrams(diagramPath, string(diagramContent), strictParsing(cmd, refineStrict))
 	if err != nil {
-		return fmt.Errorf("failed to parse dependencies: %w", err)
+		return err
 	}
 
-	// Re-parse diagram with fixes applied
-	mermaidCode, _ = parser.ExtractMermaid(string(diagramContent))
-	diagram, _ = parser.ParseMermaid(mermaidCode)
-
-	missing := checkCompleteness(diagram, deps)
+	missing := checkCompleteness(diagramsOf(blocks), deps)
 	if len(missing) > 0 {
 		fmt.Printf("  ⚠️  Missing %d items:\n", len(missing))
 		for _, m := range missing {
@@ -155,7 +177,12 @@ func runRefine(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
-func checkCompleteness(diagram *parser.Diagram, deps *parser.DepsFile) []string {
+// nodeLookup finds nodes by label in a diagram or a set of diagrams
+type nodeLookup interface {
+	HasNodeWithLabel(label string) bool
+}
+
+func checkCompleteness(diagram nodeLookup, deps *parser.DepsFile) []string {
 	missing := []string{}
 
 	for _, svc := range deps.Services {
@@ -204,3 +231,16 @@ func checkCompleteness(diagram *parser.Diagram, deps *parser.DepsFile) []string
 
 	return missing
 }
+
+// readDependencies reads and parses a dependencies.yaml file
+func readDependencies(path string) (*parser.DepsFile, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read dependencies: %w", err)
+	}
+	deps, err := parser.ParseDependencies(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse dependencies: %w", err)
+	}
+	return deps, nil
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"github.com/spf13/cobra"
+	"github.com/user/flowlint/internal/config"
 )
 
 var rootCmd = &cobra.Command{
@@ -14,10 +15,34 @@ It ensures diagrams follow consistent styling, proper arrow usage,
 and complete coverage of dependencies.
 
 Commands:
-  validate  - Check Mermaid syntax with mermaid-cli
+  validate  - Check Mermaid syntax (mermaid-cli or native)
   lint      - Check style guide compliance and auto-fix
+  rules     - List lint rules
   check     - Verify diagram matches dependencies.yaml
-  refine    - Run full refinement pipeline`,
+  refine    - Run full refinement pipeline
+  fmt       - Rewrite diagrams in canonical form
+  render    - Draw a diagram as SVG, PNG or PDF without mermaid-cli
+  preview   - Draw a diagram in the terminal
+  export    - Translate a diagram to Graphviz DOT, PlantUML or D2
+  import    - Convert Graphviz DOT into a style-compliant diagram
+  config    - Inspect the project configuration (.flowlint.yaml)`,
+	PersistentPreRunE: loadProject,
+}
+
+// loadProject loads the project configuration of the file a command
+// works on, its first argument, or of the working directory. Help and
+// shell completion work without it, so a broken file does not hide them.
+func loadProject(cmd *cobra.Command, args []string) error {
+	if cmd.Name() == "help" || cmd.Name() == cobra.ShellCompRequestCmd || cmd.HasParent() && cmd.Parent().Name() == "completion" {
+		return nil
+	}
+	target := "."
+	if len(args) > 0 {
+		target = args[0]
+	}
+	var err error
+	project, err = loadConfig(target)
+	return err
 }
 
 func Execute() error {
@@ -25,8 +50,17 @@ func Execute() error {
 }
 
 func init() {
+	rootCmd.PersistentFlags().StringVar(&configPath, "config", "", "Config file (default: "+config.FileName+" above the diagram)")
+
 	rootCmd.AddCommand(validateCmd)
 	rootCmd.AddCommand(lintCmd)
+	rootCmd.AddCommand(rulesCmd)
 	rootCmd.AddCommand(checkCmd)
 	rootCmd.AddCommand(refineCmd)
+	rootCmd.AddCommand(fmtCmd)
+	rootCmd.AddCommand(renderCmd)
+	rootCmd.AddCommand(previewCmd)
+	rootCmd.AddCommand(exportCmd)
+	rootCmd.AddCommand(importCmd)
+	rootCmd.AddCommand(configCmd)
 }
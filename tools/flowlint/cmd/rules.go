@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/user/flowlint/internal/linter"
+)
+
+var rulesCmd = &cobra.Command{
+	Use:   "rules",
+	Short: "List lint rules",
+	Long: `Lists every lint rule with its ID, name, default severity, whether
+lint --fix can repair it, and a description. The docs URL of each rule
+explains it in detail.
+
+Rules are selected with --enable and --disable, by ID or name, on the
+commands that lint (lint, refine, import). --severity rule=level sets a
+rule to error or warning, or turns it off:
+
+  flowlint lint diagram.md --disable complexity --severity FL004=error`,
+	Args: cobra.NoArgs,
+	RunE: runRules,
+}
+
+func runRules(cmd *cobra.Command, args []string) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tSEVERITY\tFIX\tDESCRIPTION")
+	for _, rule := range linter.Rules() {
+		fix := ""
+		if rule.Fixable() {
+			fix = "yes"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", rule.ID(), rule.Name(), severityName(rule.DefaultSeverity()), fix, rule.Description())
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	fmt.Println()
+	fmt.Println("Details: " + linter.RulesDocsURL)
+	return nil
+}
+
+// addRuleFlags adds the flags that select lint rules to a command
+func addRuleFlags(cmd *cobra.Command, cfg *linter.Config) {
+	cmd.Flags().StringSliceVar(&cfg.Enable, "enable", nil, "Rules to run, by ID or name (all: every rule)")
+	cmd.Flags().StringSliceVar(&cfg.Disable, "disable", nil, "Rules to skip, by ID or name (all: every rule)")
+	cmd.Flags().StringToStringVar(&cfg.Severity, "severity", nil, "Rule severity overrides: rule=error|warning|off")
+}
+
+// ruleTag names the rule that reported an issue, e.g. [FL001 subgraph-quotes]
+func ruleTag(issue linter.Issue) string {
+	if rule := linter.LookupRule(issue.Rule); rule != nil {
+		return fmt.Sprintf("[%s %s]", rule.ID(), rule.Name())
+	}
+	return ""
+}
+
+// severityName writes a severity for output
+func severityName(severity linter.Severity) string {
+	if severity == linter.SeverityError {
+		return "error"
+	}
+	return "warning"
+}
@@ -8,58 +8,147 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/user/flowlint/internal/config"
 	"github.com/user/flowlint/internal/parser"
 )
 
+// Validation backends
+const (
+	backendNative = "native" // built-in grammar check, no dependencies
+	backendMmdc   = "mmdc"   // @mermaid-js/mermaid-cli via npx
+)
+
+var (
+	validateBackend string
+)
+
 var validateCmd = &cobra.Command{
 	Use:   "validate <diagram.md>",
-	Short: "Validate Mermaid syntax using mermaid-cli",
-	Long: `Extracts the Mermaid code block from a markdown file and
-validates it using @mermaid-js/mermaid-cli via npx.
+	Short: "Validate Mermaid syntax",
+	Long: `Extracts the Mermaid code blocks from a markdown file and
+validates their syntax. Every mermaid block in the file is validated.
+
+Backends (--backend):
+  mmdc    Render with @mermaid-js/mermaid-cli via npx (default).
+          Requires npx (comes with Node.js); the mermaid-cli package
+          will be auto-installed if not present.
+  native  Check the flowchart grammar of Mermaid ` + parser.MermaidVersion + ` in Go:
+          statement syntax, bracket balance, unclosed shapes, brackets
+          and quotes in unquoted labels, link tokens, subgraph/end
+          balance, reserved words as node IDs and linkStyle indices.
+          Works offline with no dependencies.
 
-Requires npx (comes with Node.js). The mermaid-cli package will be
-auto-installed if not present.
+The default backend can be set with validate.backend in ` + config.FileName + `.
 
 Returns exit code 0 if valid, 1 if invalid.`,
 	Args: cobra.ExactArgs(1),
 	RunE: runValidate,
 }
 
+func init() {
+	validateCmd.Flags().StringVar(&validateBackend, "backend", backendMmdc, "Validation backend: native or mmdc")
+}
+
 func runValidate(cmd *cobra.Command, args []string) error {
-	diagramPath := args[0]
+	backend := project.Validate.Backend
+	if cmd.Flags().Changed("backend") {
+		backend = validateBackend
+	}
+	return validateFile(args[0], backend)
+}
 
+// validateFile validates every mermaid block of a markdown file with the
+// given backend
+func validateFile(diagramPath, backend string) error {
 	// Read the markdown file
 	content, err := os.ReadFile(diagramPath)
 	if err != nil {
 		return fmt.Errorf("failed to read file: %w", err)
 	}
 
-	// Extract mermaid code block
-	mermaidCode, err := parser.ExtractMermaid(string(content))
-	if err != nil {
-		return fmt.Errorf("failed to extract mermaid: %w", err)
+	// Extract every mermaid code block
+	blocks := parser.ExtractMermaidBlocks(string(content))
+	if len(blocks) == 0 {
+		return fmt.Errorf("failed to extract mermaid: no mermaid code block found")
 	}
 
-	// Create temp file for mermaid code
-	tmpDir, err := os.MkdirTemp("", "flowlint-")
-	if err != nil {
-		return fmt.Errorf("failed to create temp dir: %w", err)
+	var validate func(block parser.MermaidBlock) error
+	switch backend {
+	case backendNative:
+		validate = func(block parser.MermaidBlock) error {
+			return validateNative(diagramPath, block)
+		}
+
+	case backendMmdc:
+		// Use npx to run mermaid-cli (auto-installs if needed)
+		npx, err := exec.LookPath("npx")
+		if err != nil {
+			return fmt.Errorf("npx not found. Please install Node.js or use --backend=native")
+		}
+
+		// Create temp dir for mermaid code
+		tmpDir, err := os.MkdirTemp("", "flowlint-")
+		if err != nil {
+			return fmt.Errorf("failed to create temp dir: %w", err)
+		}
+		defer os.RemoveAll(tmpDir)
+
+		validate = func(block parser.MermaidBlock) error {
+			return validateBlock(npx, tmpDir, block)
+		}
+
+	default:
+		return fmt.Errorf("unknown backend %q (use native or mmdc)", backend)
 	}
-	defer os.RemoveAll(tmpDir)
 
-	mermaidFile := filepath.Join(tmpDir, "diagram.mmd")
-	if err := os.WriteFile(mermaidFile, []byte(mermaidCode), 0644); err != nil {
-		return fmt.Errorf("failed to write temp file: %w", err)
+	failed := 0
+	var lastErr error
+	for _, block := range blocks {
+		if len(blocks) > 1 {
+			fmt.Printf("Diagram %d of %d (line %d): ", block.Index+1, len(blocks), block.Line)
+		}
+		if err := validate(block); err != nil {
+			failed++
+			lastErr = err
+		}
 	}
 
-	outputFile := filepath.Join(tmpDir, "diagram.svg")
+	if failed > 1 || (failed == 1 && len(blocks) > 1) {
+		return fmt.Errorf("%d of %d diagrams failed validation", failed, len(blocks))
+	}
+	return lastErr
+}
 
-	// Use npx to run mermaid-cli (auto-installs if needed)
-	npx, err := exec.LookPath("npx")
+// validateNative checks a single block against the flowchart grammar and
+// prints the result
+func validateNative(diagramPath string, block parser.MermaidBlock) error {
+	diagram, err := parser.ParseMermaidBlock(diagramPath, block, parser.ParseOptions{})
 	if err != nil {
-		return fmt.Errorf("npx not found. Please install Node.js")
+		return fmt.Errorf("failed to parse mermaid: %w", err)
 	}
 
+	diags := parser.Validate(diagram)
+	if len(diags) > 0 {
+		fmt.Println("❌ Mermaid syntax error:")
+		for _, d := range diags {
+			fmt.Printf("   %s\n", d)
+		}
+		return fmt.Errorf("diagram has syntax errors")
+	}
+
+	fmt.Printf("✓ Mermaid syntax is valid (native check, Mermaid %s grammar)\n", parser.MermaidVersion)
+	return nil
+}
+
+// validateBlock runs mermaid-cli on a single block and prints the result
+func validateBlock(npx, tmpDir string, block parser.MermaidBlock) error {
+	mermaidFile := filepath.Join(tmpDir, fmt.Sprintf("diagram-%d.mmd", block.Index))
+	if err := os.WriteFile(mermaidFile, []byte(block.Code), 0644); err != nil {
+		return fmt.Errorf("failed to write temp file: %w", err)
+	}
+
+	outputFile := filepath.Join(tmpDir, fmt.Sprintf("diagram-%d.svg", block.Index))
+
 	// Run: npx -p @mermaid-js/mermaid-cli mmdc -i <file> -o <output> -q
 	execArgs := []string{"-p", "@mermaid-js/mermaid-cli", "mmdc", "-i", mermaidFile, "-o", outputFile, "-q"}
 	execCmd := exec.Command(npx, execArgs...)
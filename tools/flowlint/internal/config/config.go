@@ -0,0 +1,204 @@
+// Package config loads the project configuration, .flowlint.yaml. The
+// file is found by walking up from the diagram's directory, so one file
+// at the root of a repository covers every diagram in it. Settings the
+// file leaves out keep their defaults.
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/user/flowlint/internal/linter"
+	"github.com/user/flowlint/internal/styles"
+	"gopkg.in/yaml.v3"
+)
+
+// FileName is the name of the project configuration file
+const FileName = ".flowlint.yaml"
+
+// StyleGuidePath is where the style guide lives in a repository, for
+// projects that do not configure it
+const StyleGuidePath = "styles/diagram-styles.yaml"
+
+// Config is the project configuration
+type Config struct {
+	Path string `yaml:"-"` // file the config was loaded from, "" for defaults
+
+	StyleGuide      string     `yaml:"style_guide"` // path to diagram-styles.yaml
+	Strict          bool       `yaml:"strict"`      // report statements the parser does not understand
+	Rules           Rules      `yaml:"rules"`
+	Keywords        Keywords   `yaml:"keywords"`
+	RequiredClasses []string   `yaml:"required_classes"`
+	Thresholds      Thresholds `yaml:"thresholds"`
+	Validate        Validate   `yaml:"validate"`
+	Render          Render     `yaml:"render"`
+	Export          Export     `yaml:"export"`
+}
+
+// Rules selects lint rules by ID or name, as the --enable, --disable and
+// --severity flags do
+type Rules struct {
+	Enable   []string          `yaml:"enable,omitempty"`
+	Disable  []string          `yaml:"disable,omitempty"`
+	Severity map[string]string `yaml:"severity,omitempty"`
+}
+
+// Keywords are the word lists the lint rules match labels against
+type Keywords struct {
+	Async         []string              `yaml:"async"`
+	Sync          []string              `yaml:"sync"`
+	Abbreviations []linter.Abbreviation `yaml:"abbreviations"`
+}
+
+// Thresholds are the limits of the lint rules
+type Thresholds struct {
+	Complexity linter.Thresholds       `yaml:"complexity"`
+	Layout     linter.LayoutThresholds `yaml:"layout"`
+}
+
+// Validate holds the defaults of the validate command
+type Validate struct {
+	Backend string `yaml:"backend"` // native or mmdc
+}
+
+// Render holds the defaults of the render command
+type Render struct {
+	Width      int     `yaml:"width"`      // page width in pixels
+	Scale      float64 `yaml:"scale"`      // device pixels per pixel in PNG output
+	Background string  `yaml:"background"` // background color, or transparent
+}
+
+// Export holds the defaults of the export command
+type Export struct {
+	Format string `yaml:"format"` // dot, plantuml or d2
+}
+
+// Default returns the configuration used when no file is found
+func Default() *Config {
+	lint := linter.DefaultConfig()
+	return &Config{
+		Keywords: Keywords{
+			Async:         lint.AsyncKeywords,
+			Sync:          lint.SyncKeywords,
+			Abbreviations: lint.Abbreviations,
+		},
+		RequiredClasses: lint.RequiredClasses,
+		Thresholds:      Thresholds{Complexity: *lint.Thresholds, Layout: *lint.Layout},
+		Validate:        Validate{Backend: "mmdc"},
+		Render:          Render{Width: 800, Scale: 1, Background: "white"},
+		Export:          Export{Format: "dot"},
+	}
+}
+
+// Discover finds the configuration for files in dir: the file at path if
+// one is given, else the nearest .flowlint.yaml in dir or a parent, or
+// the defaults if there is none. An unset style guide path is looked up
+// from dir the same way.
+func Discover(dir, path string) (*Config, error) {
+	c := Default()
+	if path == "" {
+		path = FindUp(dir, FileName)
+	}
+	if path != "" {
+		loaded, err := Load(path)
+		if err != nil {
+			return nil, err
+		}
+		c = loaded
+	}
+	if c.StyleGuide == "" {
+		c.StyleGuide = FindUp(dir, StyleGuidePath)
+	}
+	return c, nil
+}
+
+// Load reads a configuration file. Relative paths in it are resolved
+// against the file's directory, and unknown keys are errors so typos do
+// not go unnoticed.
+func Load(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+
+	c := Default()
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if err := c.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	c.Path = path
+	if c.StyleGuide != "" && !filepath.IsAbs(c.StyleGuide) {
+		c.StyleGuide = filepath.Join(filepath.Dir(path), c.StyleGuide)
+	}
+	return c, nil
+}
+
+// validate checks the rule selection and the command settings
+func (c *Config) validate() error {
+	if err := c.Linter().Validate(); err != nil {
+		return err
+	}
+	switch {
+	case c.Validate.Backend != "native" && c.Validate.Backend != "mmdc":
+		return fmt.Errorf("unknown validate backend %q: use native or mmdc", c.Validate.Backend)
+	case c.Render.Width < 0 || c.Render.Scale <= 0:
+		return fmt.Errorf("render width must not be negative and scale must be positive")
+	case c.Export.Format != "dot" && c.Export.Format != "plantuml" && c.Export.Format != "d2":
+		return fmt.Errorf("unknown export format %q: use dot, plantuml or d2", c.Export.Format)
+	}
+	return nil
+}
+
+// Linter returns the lint settings of the configuration
+func (c *Config) Linter() linter.Config {
+	complexity, layout := c.Thresholds.Complexity, c.Thresholds.Layout
+	return linter.Config{
+		Enable:          c.Rules.Enable,
+		Disable:         c.Rules.Disable,
+		Severity:        c.Rules.Severity,
+		RequiredClasses: c.RequiredClasses,
+		AsyncKeywords:   c.Keywords.Async,
+		SyncKeywords:    c.Keywords.Sync,
+		Abbreviations:   c.Keywords.Abbreviations,
+		Thresholds:      &complexity,
+		Layout:          &layout,
+	}
+}
+
+// Guide loads the style guide the configuration points at, or returns
+// the built-in one if there is none
+func (c *Config) Guide() (*styles.Guide, error) {
+	if c.StyleGuide == "" {
+		return styles.DefaultGuide(), nil
+	}
+	return styles.LoadGuide(c.StyleGuide)
+}
+
+// FindUp looks for rel in dir and each of its parents and returns the
+// first path that exists, or ""
+func FindUp(dir, rel string) string {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return ""
+	}
+	for {
+		candidate := filepath.Join(dir, rel)
+		if _, err := os.Stat(candidate); err == nil {
+			return candidate
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
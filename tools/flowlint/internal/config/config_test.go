@@ -0,0 +1,76 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeConfig writes a .flowlint.yaml with the given content to a
+// temporary directory and loads it
+func writeConfig(t *testing.T, content string) *Config {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), FileName)
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	return c
+}
+
+func TestLoadComplexityThresholds(t *testing.T) {
+	c := writeConfig(t, "thresholds:\n  complexity: {fan_out: 0, edges_per_node: 0}\n")
+	defaults := Default().Thresholds.Complexity
+
+	got := *c.Linter().Thresholds
+	want := defaults
+	want.FanOut = 0
+	want.EdgesPerNode = 0
+	if got != want {
+		t.Errorf("thresholds = %+v, want %+v: keys set to 0 stay 0, absent keys keep their defaults", got, want)
+	}
+}
+
+func TestLoadLayoutThresholds(t *testing.T) {
+	c := writeConfig(t, "thresholds:\n  layout: {crossings: 0}\n")
+	defaults := Default().Thresholds.Layout
+
+	got := *c.Linter().Layout
+	want := defaults
+	want.Crossings = 0
+	if got != want {
+		t.Errorf("layout thresholds = %+v, want %+v: keys set to 0 stay 0, absent keys keep their defaults", got, want)
+	}
+}
+
+func TestLoadCommandSettings(t *testing.T) {
+	c := writeConfig(t, "strict: true\nvalidate: {backend: native}\nrender: {width: 3840}\nexport: {format: d2}\n")
+	want := Default()
+	want.Strict = true
+	want.Validate.Backend = "native"
+	want.Render.Width = 3840
+	want.Export.Format = "d2"
+	if c.Strict != want.Strict || c.Validate != want.Validate || c.Render != want.Render || c.Export != want.Export {
+		t.Errorf("settings = %v %+v %+v %+v, want %v %+v %+v %+v: absent keys keep their defaults",
+			c.Strict, c.Validate, c.Render, c.Export, want.Strict, want.Validate, want.Render, want.Export)
+	}
+}
+
+func TestLoadRejectsBadCommandSettings(t *testing.T) {
+	for _, content := range []string{
+		"validate: {backend: mermaid}\n",
+		"render: {scale: 0}\n",
+		"export: {format: svg}\n",
+	} {
+		path := filepath.Join(t.TempDir(), FileName)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := Load(path); err == nil {
+			t.Errorf("Load(%q) succeeded, want an error", content)
+		}
+	}
+}
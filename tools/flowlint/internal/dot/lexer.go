@@ -0,0 +1,164 @@
+package dot
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// tokenKind identifies the lexical class of a DOT token
+type tokenKind int
+
+const (
+	tokEOF    tokenKind = iota
+	tokID               // identifier, numeral, quoted or HTML string
+	tokEdgeOp           // -> or --
+	tokPunct            // { } [ ] = ; , :
+)
+
+// token is a single DOT token
+type token struct {
+	kind   tokenKind
+	text   string // value of an ID with quotes removed, or the operator
+	quoted bool   // a quoted or HTML string, never a keyword
+	line   int
+}
+
+// lex splits DOT source into tokens. Comments, preprocessor lines and
+// string concatenation ("a" + "b") are handled here.
+func lex(src string) ([]token, error) {
+	tokens := []token{}
+	line := 1
+	i := 0
+	atLineStart := true
+	for i < len(src) {
+		c := src[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+			atLineStart = true
+			continue
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+			continue
+		case c == '#' && atLineStart:
+			// Preprocessor output lines are ignored
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+			continue
+		case strings.HasPrefix(src[i:], "//"):
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+			continue
+		case strings.HasPrefix(src[i:], "/*"):
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated comment", line)
+			}
+			line += strings.Count(src[i:i+2+end], "\n")
+			i += end + 4
+			continue
+		}
+		atLineStart = false
+
+		switch {
+		case strings.HasPrefix(src[i:], "->") || strings.HasPrefix(src[i:], "--"):
+			tokens = append(tokens, token{kind: tokEdgeOp, text: src[i : i+2], line: line})
+			i += 2
+
+		case strings.ContainsRune("{}[]=;,:", rune(c)):
+			tokens = append(tokens, token{kind: tokPunct, text: string(c), line: line})
+			i++
+
+		case c == '"':
+			start := line
+			var b strings.Builder
+			j := i + 1
+			for ; j < len(src) && src[j] != '"'; j++ {
+				switch {
+				case src[j] == '\\' && j+1 < len(src) && src[j+1] == '"':
+					b.WriteByte('"')
+					j++
+				case src[j] == '\\' && j+1 < len(src) && src[j+1] == '\n':
+					// Line continuation
+					line++
+					j++
+				default:
+					if src[j] == '\n' {
+						line++
+					}
+					b.WriteByte(src[j])
+				}
+			}
+			if j >= len(src) {
+				return nil, fmt.Errorf("line %d: unterminated string", start)
+			}
+			i = j + 1
+			// "a" + "b" concatenates
+			if n := len(tokens); n >= 2 && tokens[n-1].kind == tokPunct && tokens[n-1].text == "+" && tokens[n-2].quoted {
+				tokens[n-2].text += b.String()
+				tokens = tokens[:n-1]
+				continue
+			}
+			tokens = append(tokens, token{kind: tokID, text: b.String(), quoted: true, line: start})
+
+		case c == '+':
+			tokens = append(tokens, token{kind: tokPunct, text: "+", line: line})
+			i++
+
+		case c == '<':
+			// HTML string: balanced angle brackets
+			start := line
+			depth := 0
+			j := i
+			for ; j < len(src); j++ {
+				switch src[j] {
+				case '<':
+					depth++
+				case '>':
+					depth--
+				case '\n':
+					line++
+				}
+				if depth == 0 {
+					break
+				}
+			}
+			if j >= len(src) {
+				return nil, fmt.Errorf("line %d: unterminated HTML string", start)
+			}
+			tokens = append(tokens, token{kind: tokID, text: src[i+1 : j], quoted: true, line: start})
+			i = j + 1
+
+		default:
+			r, size := utf8.DecodeRuneInString(src[i:])
+			if !isIDRune(r) && r != '-' && r != '.' {
+				return nil, fmt.Errorf("line %d: unexpected character %q", line, r)
+			}
+			j := i
+			for j < len(src) {
+				r, size := utf8.DecodeRuneInString(src[j:])
+				if !isIDRune(r) && !(r == '.' || r == '-' && !strings.HasPrefix(src[j:], "->") && !strings.HasPrefix(src[j:], "--")) {
+					break
+				}
+				j += size
+			}
+			if j == i {
+				j = i + size
+			}
+			tokens = append(tokens, token{kind: tokID, text: src[i:j], line: line})
+			i = j
+		}
+	}
+	tokens = append(tokens, token{kind: tokEOF, line: line})
+	return tokens, nil
+}
+
+// isIDRune reports whether r can appear in an unquoted DOT identifier
+func isIDRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) || r >= 0x80
+}
@@ -0,0 +1,364 @@
+// Package dot parses Graphviz DOT graphs: nodes, edges, attributes and
+// cluster subgraphs. It keeps what a diagram translation needs and ignores
+// ports and layout-only details.
+package dot
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Graph is a parsed DOT graph
+type Graph struct {
+	ID       string
+	Directed bool
+	Attrs    map[string]string // graph attributes at the top level
+	Nodes    []*Node           // in order of first mention
+	Edges    []*Edge
+	Clusters []*Cluster // in source order, outermost first
+
+	nodes map[string]*Node
+}
+
+// Node is a DOT node with its attributes, node defaults included
+type Node struct {
+	ID      string
+	Attrs   map[string]string
+	Cluster string // innermost cluster the node is declared in, "" at the top
+	Line    int
+}
+
+// Edge is a single edge; a chain A -> B -> C becomes two edges
+type Edge struct {
+	From, To string
+	Attrs    map[string]string
+	Line     int
+}
+
+// Cluster is a subgraph whose name starts with "cluster"
+type Cluster struct {
+	ID     string
+	Attrs  map[string]string
+	Parent string // enclosing cluster, "" at the top level
+	Line   int
+}
+
+// Label returns the node's label attribute, or its ID when it has none
+func (n *Node) Label() string {
+	if label, ok := n.Attrs["label"]; ok && label != `\N` {
+		return label
+	}
+	return n.ID
+}
+
+// Node returns the node with the given ID, or nil
+func (g *Graph) Node(id string) *Node {
+	return g.nodes[id]
+}
+
+// scope holds the attribute defaults of a graph or subgraph body
+type scope struct {
+	node, edge map[string]string
+	cluster    string // innermost enclosing cluster
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+	g      *Graph
+}
+
+// Parse parses DOT source. Only the first graph in the source is read.
+func Parse(src string) (*Graph, error) {
+	tokens, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens, g: &Graph{Attrs: make(map[string]string), nodes: make(map[string]*Node)}}
+	if err := p.graph(); err != nil {
+		return nil, err
+	}
+	return p.g, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+// keyword reports whether the next token is the given keyword, which DOT
+// matches case-insensitively
+func (p *parser) keyword(word string) bool {
+	t := p.peek()
+	return t.kind == tokID && !t.quoted && strings.EqualFold(t.text, word)
+}
+
+func (p *parser) punct(c string) bool {
+	t := p.peek()
+	return t.kind == tokPunct && t.text == c
+}
+
+func (p *parser) expect(c string) error {
+	if !p.punct(c) {
+		return p.errorf("expected %q", c)
+	}
+	p.next()
+	return nil
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	t := p.peek()
+	found := t.text
+	if t.kind == tokEOF {
+		found = "end of input"
+	}
+	return fmt.Errorf("line %d: %s, found %q", t.line, fmt.Sprintf(format, args...), found)
+}
+
+// graph parses: [strict] (graph | digraph) [ID] { stmt_list }
+func (p *parser) graph() error {
+	if p.keyword("strict") {
+		p.next()
+	}
+	switch {
+	case p.keyword("digraph"):
+		p.g.Directed = true
+	case p.keyword("graph"):
+	default:
+		return p.errorf("expected graph or digraph")
+	}
+	p.next()
+	if p.peek().kind == tokID {
+		p.g.ID = p.next().text
+	}
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	return p.stmts(&scope{node: map[string]string{}, edge: map[string]string{}}, p.g.Attrs)
+}
+
+// stmts parses statements up to the closing brace of a body. Graph
+// attributes set in the body go to attrs.
+func (p *parser) stmts(sc *scope, attrs map[string]string) error {
+	for {
+		switch {
+		case p.punct("}"):
+			p.next()
+			return nil
+		case p.peek().kind == tokEOF:
+			return p.errorf("expected \"}\"")
+		case p.punct(";"):
+			p.next()
+			continue
+		}
+		if err := p.stmt(sc, attrs); err != nil {
+			return err
+		}
+	}
+}
+
+func (p *parser) stmt(sc *scope, attrs map[string]string) error {
+	// Attribute statements: graph [...], node [...], edge [...]
+	for _, kind := range []string{"graph", "node", "edge"} {
+		if !p.keyword(kind) {
+			continue
+		}
+		p.next()
+		list, err := p.attrList()
+		if err != nil {
+			return err
+		}
+		target := map[string]map[string]string{"graph": attrs, "node": sc.node, "edge": sc.edge}[kind]
+		for k, v := range list {
+			target[k] = v
+		}
+		return nil
+	}
+
+	// ID = ID sets a graph attribute
+	if t := p.peek(); t.kind == tokID && p.tokens[p.pos+1].kind == tokPunct && p.tokens[p.pos+1].text == "=" {
+		p.next()
+		p.next()
+		if p.peek().kind != tokID {
+			return p.errorf("expected attribute value")
+		}
+		attrs[t.text] = p.next().text
+		return nil
+	}
+
+	// Node or edge statement; operands are node IDs or subgraphs
+	line := p.peek().line
+	isNode := !p.keyword("subgraph") && !p.punct("{")
+	first, err := p.operand(sc)
+	if err != nil {
+		return err
+	}
+	operands := [][]string{first}
+	for p.peek().kind == tokEdgeOp {
+		p.next()
+		next, err := p.operand(sc)
+		if err != nil {
+			return err
+		}
+		operands = append(operands, next)
+	}
+	list := map[string]string{}
+	if p.punct("[") {
+		if list, err = p.attrList(); err != nil {
+			return err
+		}
+	}
+
+	if len(operands) == 1 {
+		// A node statement, or a bare subgraph
+		if isNode {
+			for k, v := range list {
+				p.g.nodes[first[0]].Attrs[k] = v
+			}
+		}
+		return nil
+	}
+	for i := 0; i+1 < len(operands); i++ {
+		for _, from := range operands[i] {
+			for _, to := range operands[i+1] {
+				e := &Edge{From: from, To: to, Attrs: copyAttrs(sc.edge), Line: line}
+				for k, v := range list {
+					e.Attrs[k] = v
+				}
+				p.g.Edges = append(p.g.Edges, e)
+			}
+		}
+	}
+	return nil
+}
+
+// operand parses a node ID, with an optional port, or a subgraph, and
+// returns the node IDs it stands for
+func (p *parser) operand(sc *scope) ([]string, error) {
+	if p.keyword("subgraph") || p.punct("{") {
+		return p.subgraph(sc)
+	}
+	if p.peek().kind != tokID {
+		return nil, p.errorf("expected node ID")
+	}
+	t := p.next()
+	// Ports (node:port:compass) are ignored
+	for p.punct(":") {
+		p.next()
+		if p.peek().kind == tokID {
+			p.next()
+		}
+	}
+	p.mention(t.text, sc, t.line)
+	return []string{t.text}, nil
+}
+
+// mention creates a node on first mention with the scope's defaults, and
+// moves a top-level node into a cluster that mentions it later
+func (p *parser) mention(id string, sc *scope, line int) {
+	n := p.g.nodes[id]
+	if n == nil {
+		n = &Node{ID: id, Attrs: copyAttrs(sc.node), Cluster: sc.cluster, Line: line}
+		p.g.nodes[id] = n
+		p.g.Nodes = append(p.g.Nodes, n)
+		return
+	}
+	if n.Cluster == "" {
+		n.Cluster = sc.cluster
+	}
+}
+
+// subgraph parses: [subgraph [ID]] { stmt_list }. Subgraphs named
+// cluster... become clusters; others only scope attribute defaults.
+func (p *parser) subgraph(parent *scope) ([]string, error) {
+	id := ""
+	line := p.peek().line
+	if p.keyword("subgraph") {
+		p.next()
+		if p.peek().kind == tokID {
+			id = p.next().text
+		}
+	}
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+
+	sc := &scope{node: copyAttrs(parent.node), edge: copyAttrs(parent.edge), cluster: parent.cluster}
+	attrs := map[string]string{}
+	if strings.HasPrefix(id, "cluster") {
+		p.g.Clusters = append(p.g.Clusters, &Cluster{ID: id, Attrs: attrs, Parent: parent.cluster, Line: line})
+		sc.cluster = id
+	}
+
+	before := len(p.g.Nodes)
+	start := len(p.g.Edges)
+	if err := p.stmts(sc, attrs); err != nil {
+		return nil, err
+	}
+
+	// The subgraph stands for every node mentioned inside it
+	ids := []string{}
+	seen := map[string]bool{}
+	add := func(id string) {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	for _, n := range p.g.Nodes[before:] {
+		add(n.ID)
+	}
+	for _, e := range p.g.Edges[start:] {
+		add(e.From)
+		add(e.To)
+	}
+	for _, n := range p.g.Nodes[:before] {
+		if n.Cluster == sc.cluster && sc.cluster != parent.cluster {
+			add(n.ID)
+		}
+	}
+	return ids, nil
+}
+
+// attrList parses one or more [k=v, ...] lists
+func (p *parser) attrList() (map[string]string, error) {
+	attrs := map[string]string{}
+	for p.punct("[") {
+		p.next()
+		for !p.punct("]") {
+			if p.peek().kind != tokID {
+				return nil, p.errorf("expected attribute name or \"]\"")
+			}
+			key := p.next()
+			value := "true"
+			if p.punct("=") {
+				p.next()
+				if p.peek().kind != tokID {
+					return nil, p.errorf("expected attribute value")
+				}
+				value = p.next().text
+			}
+			attrs[key.text] = value
+			if p.punct(",") || p.punct(";") {
+				p.next()
+			}
+		}
+		p.next()
+	}
+	return attrs, nil
+}
+
+func copyAttrs(attrs map[string]string) map[string]string {
+	copied := make(map[string]string, len(attrs))
+	for k, v := range attrs {
+		copied[k] = v
+	}
+	return copied
+}
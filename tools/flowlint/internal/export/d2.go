@@ -0,0 +1,211 @@
+package export
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/user/flowlint/internal/parser"
+)
+
+// d2Shape is how a mermaid shape is drawn in D2
+type d2Shape struct {
+	shape        string
+	rounded      bool
+	doubleBorder bool
+}
+
+var d2Shapes = map[string]d2Shape{
+	"rectangle":         {shape: "rectangle"},
+	"rounded":           {shape: "rectangle", rounded: true},
+	"stadium":           {shape: "oval"},
+	"double_rectangle":  {shape: "rectangle", doubleBorder: true},
+	"cylinder":          {shape: "cylinder"},
+	"diamond":           {shape: "diamond"},
+	"circle":            {shape: "circle"},
+	"double_circle":     {shape: "circle", doubleBorder: true},
+	"hexagon":           {shape: "hexagon"},
+	"parallelogram":     {shape: "parallelogram"},
+	"parallelogram_alt": {shape: "parallelogram"},
+	"trapezoid":         {shape: "rectangle"},
+	"trapezoid_alt":     {shape: "rectangle"},
+	"asymmetric":        {shape: "step"},
+}
+
+// d2TypeShapes overrides the shape for node types D2 has a shape for
+var d2TypeShapes = map[string]string{
+	"kafka_topic": "queue",
+}
+
+// d2Heads maps link heads to D2 arrowhead shapes
+var d2Heads = map[string]string{
+	parser.HeadCross:  "cross",
+	parser.HeadCircle: "circle",
+}
+
+// d2Directions maps mermaid directions to D2 ones
+var d2Directions = map[string]string{
+	"LR": "right",
+	"RL": "left",
+	"BT": "up",
+}
+
+// d2KeyRe matches keys that need no quotes
+var d2KeyRe = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// D2 translates a diagram into D2. Subgraphs become containers, so nodes
+// inside them are referenced by their path, e.g. entry.E1.
+func D2(d *parser.Diagram) string {
+	var b strings.Builder
+	direction, ok := d2Directions[d.Direction]
+	if !ok {
+		direction = "down"
+	}
+	fmt.Fprintf(&b, "direction: %s\n", direction)
+
+	var writeLevel func(parentID string, depth int)
+	writeLevel = func(parentID string, depth int) {
+		indent := strings.Repeat("    ", depth)
+		for _, sg := range subgraphsIn(d, parentID) {
+			fmt.Fprintf(&b, "%s%s: %s {\n", indent, d2Key(sg.ID), d2Quote(sg.Title))
+			writeLevel(sg.ID, depth+1)
+			fmt.Fprintf(&b, "%s}\n", indent)
+		}
+		for _, node := range nodesIn(d, parentID) {
+			attrs := d2NodeAttrs(d, node)
+			if len(attrs) == 0 {
+				fmt.Fprintf(&b, "%s%s: %s\n", indent, d2Key(node.ID), d2Quote(node.Label))
+				continue
+			}
+			fmt.Fprintf(&b, "%s%s: %s {\n", indent, d2Key(node.ID), d2Quote(node.Label))
+			for _, attr := range attrs {
+				fmt.Fprintf(&b, "%s    %s\n", indent, attr)
+			}
+			fmt.Fprintf(&b, "%s}\n", indent)
+		}
+	}
+	writeLevel("", 0)
+
+	for _, e := range d.Edges {
+		from, to := d2Path(d, e.From), d2Path(d, e.To)
+		line := fmt.Sprintf("%s %s %s", from, d2Arrow(e), to)
+		if e.Label != "" {
+			line += ": " + d2Quote(e.Label)
+		}
+		attrs := d2EdgeAttrs(e)
+		if len(attrs) == 0 {
+			b.WriteString(line + "\n")
+			continue
+		}
+		b.WriteString(line + " {\n")
+		for _, attr := range attrs {
+			fmt.Fprintf(&b, "    %s\n", attr)
+		}
+		b.WriteString("}\n")
+	}
+	return b.String()
+}
+
+func d2NodeAttrs(d *parser.Diagram, node *parser.Node) []string {
+	attrs := []string{}
+	shape, ok := d2Shapes[node.Shape]
+	if !ok {
+		shape = d2Shapes["rectangle"]
+	}
+	if s, ok := d2TypeShapes[nodeType(d, node)]; ok {
+		shape.shape = s
+	}
+	if shape.shape != "rectangle" {
+		attrs = append(attrs, "shape: "+shape.shape)
+	}
+	if shape.rounded {
+		attrs = append(attrs, "style.border-radius: 8")
+	}
+	if shape.doubleBorder {
+		attrs = append(attrs, "style.double-border: true")
+	}
+
+	p := nodePaint(d, node)
+	if p.fill != "" {
+		attrs = append(attrs, "style.fill: "+d2Quote(p.fill))
+	}
+	if p.stroke != "" {
+		attrs = append(attrs, "style.stroke: "+d2Quote(p.stroke))
+	}
+	if p.text != "" {
+		attrs = append(attrs, "style.font-color: "+d2Quote(p.text))
+	}
+	return attrs
+}
+
+// d2Arrow writes the connection token: one-way, both ways or no head
+func d2Arrow(e *parser.Edge) string {
+	switch {
+	case e.Head == parser.HeadNone:
+		return "--"
+	case e.Bidirectional:
+		return "<->"
+	}
+	return "->"
+}
+
+// d2EdgeAttrs keeps the link semantics: sync edges are thick, async
+// edges dashed and ~~~ links invisible
+func d2EdgeAttrs(e *parser.Edge) []string {
+	attrs := []string{}
+	switch e.Stroke {
+	case parser.StrokeThick:
+		attrs = append(attrs, "style.stroke-width: 3")
+	case parser.StrokeDotted:
+		attrs = append(attrs, "style.stroke-dash: 3")
+	case parser.StrokeInvisible:
+		attrs = append(attrs, "style.opacity: 0")
+	}
+	if color := parser.ParseStyleProps(e.Style)["stroke"]; color != "" {
+		attrs = append(attrs, "style.stroke: "+d2Quote(color))
+	}
+	if head, ok := d2Heads[e.Head]; ok {
+		attrs = append(attrs, "target-arrowhead.shape: "+head)
+		if e.Bidirectional {
+			attrs = append(attrs, "source-arrowhead.shape: "+head)
+		}
+	}
+	return attrs
+}
+
+// d2Path returns the key path of a node or subgraph, through the
+// containers it is nested in
+func d2Path(d *parser.Diagram, id string) string {
+	parent := ""
+	if node := d.Nodes[id]; node != nil {
+		parent = node.Subgraph
+	} else if sg := d.FindSubgraph(id); sg != nil {
+		parent = sg.Parent
+	}
+
+	keys := []string{d2Key(id)}
+	if parent != "" {
+		keys = append(keys, d2Key(parent))
+		for _, ancestor := range d.Ancestors(parent) {
+			keys = append(keys, d2Key(ancestor))
+		}
+	}
+	for i, j := 0, len(keys)-1; i < j; i, j = i+1, j-1 {
+		keys[i], keys[j] = keys[j], keys[i]
+	}
+	return strings.Join(keys, ".")
+}
+
+// d2Key quotes a key unless it is a plain identifier
+func d2Key(id string) string {
+	if d2KeyRe.MatchString(id) {
+		return id
+	}
+	return d2Quote(id)
+}
+
+// d2Quote writes a double-quoted D2 string
+func d2Quote(s string) string {
+	return strconv.Quote(s)
+}
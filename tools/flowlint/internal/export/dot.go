@@ -0,0 +1,188 @@
+package export
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/user/flowlint/internal/parser"
+)
+
+// dotShape is how a mermaid shape is drawn in Graphviz
+type dotShape struct {
+	shape       string
+	rounded     bool
+	peripheries int // 2 for a double outline
+}
+
+var dotShapes = map[string]dotShape{
+	"rectangle":         {shape: "box"},
+	"rounded":           {shape: "box", rounded: true},
+	"stadium":           {shape: "box", rounded: true},
+	"double_rectangle":  {shape: "box", peripheries: 2},
+	"cylinder":          {shape: "cylinder"},
+	"diamond":           {shape: "diamond"},
+	"circle":            {shape: "circle"},
+	"double_circle":     {shape: "doublecircle"},
+	"hexagon":           {shape: "hexagon"},
+	"parallelogram":     {shape: "parallelogram"},
+	"parallelogram_alt": {shape: "parallelogram"},
+	"trapezoid":         {shape: "trapezium"},
+	"trapezoid_alt":     {shape: "invtrapezium"},
+	"asymmetric":        {shape: "cds"},
+}
+
+// dotHeads maps link heads to Graphviz arrow types
+var dotHeads = map[string]string{
+	parser.HeadArrow:  "normal",
+	parser.HeadCross:  "tee",
+	parser.HeadCircle: "dot",
+	parser.HeadNone:   "none",
+}
+
+// DOT translates a diagram into a Graphviz digraph. Subgraphs become
+// clusters; an edge to a subgraph points at its first node and is clipped
+// at the cluster border.
+func DOT(d *parser.Diagram) string {
+	var b strings.Builder
+	b.WriteString("digraph {\n")
+	fmt.Fprintf(&b, "    rankdir=%s\n", rankdir(d.Direction))
+	b.WriteString("    compound=true\n")
+	b.WriteString("    node [shape=box]\n")
+
+	var writeLevel func(parentID string, depth int)
+	writeLevel = func(parentID string, depth int) {
+		indent := strings.Repeat("    ", depth)
+		for _, sg := range subgraphsIn(d, parentID) {
+			fmt.Fprintf(&b, "%ssubgraph %s {\n", indent, dotQuote("cluster_"+sg.ID))
+			fmt.Fprintf(&b, "%s    label=%s\n", indent, dotQuote(sg.Title))
+			if firstNode(d, sg) == "" {
+				// Empty clusters are not drawn without a node to hold them open
+				fmt.Fprintf(&b, "%s    %s [shape=point, style=invis]\n", indent, dotQuote(sg.ID))
+			}
+			writeLevel(sg.ID, depth+1)
+			fmt.Fprintf(&b, "%s}\n", indent)
+		}
+		for _, node := range nodesIn(d, parentID) {
+			fmt.Fprintf(&b, "%s%s [%s]\n", indent, dotQuote(node.ID), strings.Join(dotNodeAttrs(d, node), ", "))
+		}
+	}
+	writeLevel("", 1)
+
+	for _, e := range d.Edges {
+		from, fromAttr := dotEndpoint(d, e.From, "ltail")
+		to, toAttr := dotEndpoint(d, e.To, "lhead")
+		attrs := dotEdgeAttrs(e)
+		if fromAttr != "" {
+			attrs = append(attrs, fromAttr)
+		}
+		if toAttr != "" {
+			attrs = append(attrs, toAttr)
+		}
+		if len(attrs) == 0 {
+			fmt.Fprintf(&b, "    %s -> %s\n", dotQuote(from), dotQuote(to))
+			continue
+		}
+		fmt.Fprintf(&b, "    %s -> %s [%s]\n", dotQuote(from), dotQuote(to), strings.Join(attrs, ", "))
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// rankdir maps a mermaid direction to a Graphviz rankdir
+func rankdir(direction string) string {
+	switch direction {
+	case "LR", "RL", "BT":
+		return direction
+	}
+	return "TB"
+}
+
+func dotNodeAttrs(d *parser.Diagram, node *parser.Node) []string {
+	attrs := []string{"label=" + dotQuote(node.Label)}
+	shape, ok := dotShapes[node.Shape]
+	if !ok {
+		shape = dotShapes["rectangle"]
+	}
+	if shape.shape != "box" {
+		attrs = append(attrs, "shape="+shape.shape)
+	}
+	if shape.peripheries > 0 {
+		attrs = append(attrs, fmt.Sprintf("peripheries=%d", shape.peripheries))
+	}
+
+	p := nodePaint(d, node)
+	style := []string{}
+	if shape.rounded {
+		style = append(style, "rounded")
+	}
+	if p.fill != "" {
+		style = append(style, "filled")
+	}
+	if len(style) > 0 {
+		attrs = append(attrs, "style="+dotQuote(strings.Join(style, ",")))
+	}
+	if p.fill != "" {
+		attrs = append(attrs, "fillcolor="+dotQuote(p.fill))
+	}
+	if p.stroke != "" {
+		attrs = append(attrs, "color="+dotQuote(p.stroke))
+	}
+	if p.text != "" {
+		attrs = append(attrs, "fontcolor="+dotQuote(p.text))
+	}
+	return attrs
+}
+
+// dotEdgeAttrs keeps the link semantics: sync edges are bold, async
+// edges dashed and ~~~ links invisible
+func dotEdgeAttrs(e *parser.Edge) []string {
+	attrs := []string{}
+	if e.Label != "" {
+		attrs = append(attrs, "label="+dotQuote(e.Label))
+	}
+	switch e.Stroke {
+	case parser.StrokeThick:
+		attrs = append(attrs, "style=bold", "penwidth=2")
+	case parser.StrokeDotted:
+		attrs = append(attrs, "style=dashed")
+	case parser.StrokeInvisible:
+		attrs = append(attrs, "style=invis")
+	}
+	if head := dotHeads[e.Head]; head != "" && head != "normal" {
+		attrs = append(attrs, "arrowhead="+head)
+	}
+	if e.Bidirectional {
+		attrs = append(attrs, "dir=both")
+		if head := dotHeads[e.Head]; head != "" && head != "normal" {
+			attrs = append(attrs, "arrowtail="+head)
+		}
+	}
+	if color := parser.ParseStyleProps(e.Style)["stroke"]; color != "" {
+		attrs = append(attrs, "color="+dotQuote(color))
+	}
+	return attrs
+}
+
+// dotEndpoint returns the node an edge endpoint is drawn to. An endpoint
+// that is a subgraph is represented by its first node, with an lhead or
+// ltail attribute clipping the edge at the cluster.
+func dotEndpoint(d *parser.Diagram, id, clip string) (string, string) {
+	sg := d.FindSubgraph(id)
+	if sg == nil || d.Nodes[id] != nil {
+		return id, ""
+	}
+	node := firstNode(d, sg)
+	if node == "" {
+		node = sg.ID
+	}
+	return node, clip + "=" + dotQuote("cluster_"+sg.ID)
+}
+
+// dotQuote writes a DOT string, turning line breaks into \n
+func dotQuote(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
@@ -0,0 +1,130 @@
+// Package export translates parsed diagrams into other diagram languages:
+// Graphviz DOT, PlantUML and D2. Subgraphs become clusters or containers,
+// classDef colors become fill, stroke and text attributes, and the sync,
+// async and internal arrows keep their meaning as bold, dashed and plain
+// lines. Output is deterministic: elements follow the source order.
+package export
+
+import (
+	"sort"
+
+	"github.com/user/flowlint/internal/parser"
+	"github.com/user/flowlint/internal/styles"
+)
+
+// paint is the resolved color of a node; empty fields are left unset
+type paint struct {
+	fill, stroke, text string
+}
+
+// nodePaint merges the default classDef, the node's classes and its style
+// statements
+func nodePaint(d *parser.Diagram, node *parser.Node) paint {
+	p := paint{}
+	apply := func(style string) {
+		if style == "" {
+			return
+		}
+		props := parser.ParseStyleProps(style)
+		if v := props["fill"]; v != "" {
+			p.fill = v
+		}
+		if v := props["stroke"]; v != "" {
+			p.stroke = v
+		}
+		if v := props["color"]; v != "" {
+			p.text = v
+		}
+	}
+	apply(d.ClassDefs["default"])
+	for _, class := range node.Classes {
+		apply(d.ClassDefs[class])
+	}
+	apply(node.Style)
+	return p
+}
+
+// guide is the style guide node types are read with
+var guide = styles.DefaultGuide()
+
+// nodeType infers what a node stands for (service, database, kafka_topic,
+// ...) from its classes and shape, using the style guide mappings. Of the
+// types whose class the node has, one the guide draws with the node's
+// shape wins, then the one the shape stands for; without a known class
+// the shape alone decides. It returns "" if nothing matches.
+func nodeType(d *parser.Diagram, node *parser.Node) string {
+	called := false
+	for _, e := range d.Edges {
+		if e.To == node.ID {
+			called = true
+		}
+	}
+	byShape := guide.TypeOfShape(node.Shape, node.Label, called)
+
+	classed := []string{}
+	for t, class := range styles.NodeTypeToClass {
+		for _, c := range node.Classes {
+			if c == class {
+				classed = append(classed, t)
+			}
+		}
+	}
+	sort.Strings(classed)
+	for _, t := range classed {
+		if t == byShape {
+			return t
+		}
+	}
+	for _, t := range classed {
+		if guide.ShapeOf(t) == node.Shape {
+			return t
+		}
+	}
+	for _, t := range classed {
+		if styles.NodeTypeToClass[t] == t {
+			return t
+		}
+	}
+	if len(classed) > 0 {
+		return classed[0]
+	}
+	return byShape
+}
+
+// nodesIn returns the nodes directly inside a subgraph, "" for the top
+// level, in source order
+func nodesIn(d *parser.Diagram, subgraphID string) []*parser.Node {
+	nodes := []*parser.Node{}
+	for _, node := range d.SortedNodes() {
+		if node.Subgraph == subgraphID {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes
+}
+
+// subgraphsIn returns the subgraphs directly inside a subgraph, "" for
+// the top level, in source order
+func subgraphsIn(d *parser.Diagram, parentID string) []*parser.Subgraph {
+	subgraphs := []*parser.Subgraph{}
+	for _, sg := range d.Subgraphs {
+		if sg.Parent == parentID {
+			subgraphs = append(subgraphs, sg)
+		}
+	}
+	return subgraphs
+}
+
+// firstNode returns the first node inside a subgraph or its nested
+// subgraphs, or "" if it is empty
+func firstNode(d *parser.Diagram, sg *parser.Subgraph) string {
+	if nodes := nodesIn(d, sg.ID); len(nodes) > 0 {
+		return nodes[0].ID
+	}
+	for _, child := range subgraphsIn(d, sg.ID) {
+		if id := firstNode(d, child); id != "" {
+			return id
+		}
+	}
+	return ""
+}
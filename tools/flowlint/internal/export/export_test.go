@@ -0,0 +1,95 @@
+package export
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/user/flowlint/internal/parser"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// mustParse parses mermaid code, failing the test on error
+func mustParse(t *testing.T, code string) *parser.Diagram {
+	t.Helper()
+	d, err := parser.ParseMermaid(code)
+	if err != nil {
+		t.Fatalf("ParseMermaid: %v", err)
+	}
+	return d
+}
+
+func TestExportGolden(t *testing.T) {
+	formats := []struct {
+		ext    string
+		export func(*parser.Diagram) string
+	}{
+		{"dot", DOT},
+		{"puml", PlantUML},
+		{"d2", D2},
+	}
+
+	inputs, err := filepath.Glob(filepath.Join("testdata", "*.mmd"))
+	if err != nil || len(inputs) == 0 {
+		t.Fatalf("no inputs in testdata: %v", err)
+	}
+	for _, input := range inputs {
+		name := strings.TrimSuffix(filepath.Base(input), ".mmd")
+		code, err := os.ReadFile(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, f := range formats {
+			t.Run(name+"."+f.ext, func(t *testing.T) {
+				got := f.export(mustParse(t, string(code)))
+				golden := filepath.Join("testdata", name+"."+f.ext+".golden")
+				if *update {
+					if err := os.WriteFile(golden, []byte(got), 0o644); err != nil {
+						t.Fatal(err)
+					}
+				}
+				want, err := os.ReadFile(golden)
+				if err != nil {
+					t.Fatalf("%v (run go test -update to create it)", err)
+				}
+				if got != string(want) {
+					t.Errorf("output differs from %s:\n%s\nwant:\n%s", golden, got, want)
+				}
+			})
+		}
+	}
+}
+
+func TestDOTSubgraphEdges(t *testing.T) {
+	d := mustParse(t, "flowchart TD\n"+
+		"    subgraph entry [\"Entry\"]\n        E1[API]\n    end\n"+
+		"    subgraph target [\"Target\"]\n        S1[Service]\n        S2[Worker]\n    end\n"+
+		"    subgraph empty [\"Empty\"]\n    end\n"+
+		"    entry ==> target\n    S2 --> entry\n    target -.-> X[Topic]\n    X --> empty")
+	out := DOT(d)
+
+	want := []string{
+		`    compound=true`,
+		`    "E1" -> "S1" [style=bold, penwidth=2, ltail="cluster_entry", lhead="cluster_target"]`,
+		`    "S2" -> "E1" [lhead="cluster_entry"]`,
+		`    "S1" -> "X" [style=dashed, ltail="cluster_target"]`,
+		`    "X" -> "empty" [lhead="cluster_empty"]`,
+		`        "empty" [shape=point, style=invis]`,
+	}
+	lines := strings.Split(out, "\n")
+	for _, line := range want {
+		found := false
+		for _, l := range lines {
+			if l == line {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("DOT output has no line %q:\n%s", line, out)
+		}
+	}
+}
@@ -0,0 +1,172 @@
+package export
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/user/flowlint/internal/parser"
+)
+
+// plantUMLElements maps node types to PlantUML description diagram elements
+var plantUMLElements = map[string]string{
+	"service":        "component",
+	"handler":        "component",
+	"database":       "database",
+	"kafka_topic":    "queue",
+	"consumer_group": "collections",
+	"external":       "cloud",
+	"cache":          "storage",
+}
+
+// plantUMLHeads maps link heads to PlantUML arrow heads
+var plantUMLHeads = map[string][2]string{ // tail, head
+	parser.HeadArrow:  {"<", ">"},
+	parser.HeadCross:  {"x", "x"},
+	parser.HeadCircle: {"o", "o"},
+	parser.HeadNone:   {"", ""},
+}
+
+// aliasRe matches the characters PlantUML does not allow in an alias
+var aliasRe = regexp.MustCompile(`[^A-Za-z0-9_]`)
+
+// PlantUML translates a diagram into a PlantUML component diagram.
+// Subgraphs become rectangles holding their members, and each node is
+// drawn as the element that suits its type, with the type as stereotype.
+func PlantUML(d *parser.Diagram) string {
+	aliases := plantUMLAliases(d)
+
+	var b strings.Builder
+	b.WriteString("@startuml\n")
+	if d.Direction == "LR" || d.Direction == "RL" {
+		b.WriteString("left to right direction\n")
+	}
+
+	var writeLevel func(parentID string, depth int)
+	writeLevel = func(parentID string, depth int) {
+		indent := strings.Repeat("    ", depth)
+		for _, sg := range subgraphsIn(d, parentID) {
+			fmt.Fprintf(&b, "%srectangle %s as %s {\n", indent, plantUMLQuote(sg.Title), aliases[sg.ID])
+			writeLevel(sg.ID, depth+1)
+			fmt.Fprintf(&b, "%s}\n", indent)
+		}
+		for _, node := range nodesIn(d, parentID) {
+			t := nodeType(d, node)
+			element, ok := plantUMLElements[t]
+			if !ok {
+				element = "rectangle"
+			}
+			line := fmt.Sprintf("%s%s %s as %s", indent, element, plantUMLQuote(node.Label), aliases[node.ID])
+			if t != "" {
+				line += " <<" + t + ">>"
+			}
+			if color := plantUMLColor(nodePaint(d, node)); color != "" {
+				line += " " + color
+			}
+			b.WriteString(line + "\n")
+		}
+	}
+	writeLevel("", 0)
+
+	for _, e := range d.Edges {
+		from, to := aliases[e.From], aliases[e.To]
+		if from == "" || to == "" {
+			continue
+		}
+		line := fmt.Sprintf("%s %s %s", from, plantUMLArrow(e), to)
+		if e.Label != "" {
+			line += " : " + strings.ReplaceAll(e.Label, "\n", `\n`)
+		}
+		b.WriteString(line + "\n")
+	}
+
+	b.WriteString("@enduml\n")
+	return b.String()
+}
+
+// plantUMLAliases gives every node and subgraph an alias made of the
+// characters PlantUML accepts, keeping IDs that are already valid
+func plantUMLAliases(d *parser.Diagram) map[string]string {
+	aliases := make(map[string]string)
+	used := make(map[string]bool)
+	assign := func(id string) {
+		alias := aliasRe.ReplaceAllString(id, "_")
+		for n := 2; used[alias]; n++ {
+			alias = fmt.Sprintf("%s_%d", aliasRe.ReplaceAllString(id, "_"), n)
+		}
+		aliases[id] = alias
+		used[alias] = true
+	}
+	ids := []string{}
+	for _, sg := range d.Subgraphs {
+		ids = append(ids, sg.ID)
+	}
+	for _, node := range d.SortedNodes() {
+		ids = append(ids, node.ID)
+	}
+	// Valid IDs first, so they keep their name
+	for _, id := range ids {
+		if !aliasRe.MatchString(id) {
+			assign(id)
+		}
+	}
+	for _, id := range ids {
+		if _, done := aliases[id]; !done {
+			assign(id)
+		}
+	}
+	return aliases
+}
+
+// plantUMLArrow writes a link as a PlantUML arrow: bold for sync, dashed
+// for async, hidden for ~~~ links
+func plantUMLArrow(e *parser.Edge) string {
+	styles := []string{}
+	if color := parser.ParseStyleProps(e.Style)["stroke"]; color != "" {
+		styles = append(styles, color)
+	}
+	switch e.Stroke {
+	case parser.StrokeThick:
+		styles = append(styles, "bold")
+	case parser.StrokeDotted:
+		styles = append(styles, "dashed")
+	case parser.StrokeInvisible:
+		styles = append(styles, "hidden")
+	}
+
+	heads := plantUMLHeads[e.Head]
+	tail := ""
+	if e.Bidirectional {
+		tail = heads[0]
+	}
+	if len(styles) == 0 {
+		return tail + "--" + heads[1]
+	}
+	return tail + "-[" + strings.Join(styles, ",") + "]-" + heads[1]
+}
+
+// plantUMLColor writes node colors as an inline #back;line;text spec
+func plantUMLColor(p paint) string {
+	parts := []string{}
+	if p.fill != "" {
+		parts = append(parts, "back:"+strings.TrimPrefix(p.fill, "#"))
+	}
+	if p.stroke != "" {
+		parts = append(parts, "line:"+strings.TrimPrefix(p.stroke, "#"))
+	}
+	if p.text != "" {
+		parts = append(parts, "text:"+strings.TrimPrefix(p.text, "#"))
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return "#" + strings.Join(parts, ";")
+}
+
+// plantUMLQuote writes a PlantUML string. Strings cannot hold double
+// quotes, so those become single quotes.
+func plantUMLQuote(s string) string {
+	s = strings.ReplaceAll(s, `"`, "'")
+	s = strings.ReplaceAll(s, "\n", `\n`)
+	return `"` + s + `"`
+}
@@ -0,0 +1,102 @@
+// Package font provides the glyph outlines and advance widths of DejaVu
+// Sans, so that text can be measured and drawn without a system font.
+// It covers ASCII, Latin-1 and the punctuation and arrows common in
+// diagram labels; other characters are drawn as '?'.
+//
+// DejaVu fonts are derived from Bitstream Vera and may be redistributed
+// under the Bitstream Vera license.
+package font
+
+import (
+	"strconv"
+	"strings"
+)
+
+//go:generate go run gen.go /usr/share/fonts/truetype/dejavu/DejaVuSans.ttf /usr/share/fonts/truetype/dejavu/DejaVuSans-Bold.ttf
+
+// Point is a position in pixels, y down
+type Point struct {
+	X, Y float64
+}
+
+// face is one weight of the font. Paths are in font units, y up.
+type face struct {
+	unitsPerEm int
+	ascent     int
+	descent    int // negative: below the baseline
+	glyphs     map[rune]glyph
+}
+
+type glyph struct {
+	advance int
+	path    string // M, L, Q and Z commands with integer coordinates
+}
+
+func faceOf(bold bool) *face {
+	if bold {
+		return &boldFace
+	}
+	return &regularFace
+}
+
+func (f *face) glyph(r rune) glyph {
+	if g, ok := f.glyphs[r]; ok {
+		return g
+	}
+	return f.glyphs['?']
+}
+
+// Width returns the advance width of text at size pixels
+func Width(text string, size float64, bold bool) float64 {
+	f := faceOf(bold)
+	units := 0
+	for _, r := range text {
+		units += f.glyph(r).advance
+	}
+	return float64(units) * size / float64(f.unitsPerEm)
+}
+
+// Segment is a step of an outline: M moves to Points[0], L draws a line
+// to Points[0], Q a quadratic curve through control point Points[0] to
+// Points[1], and Z closes the contour
+type Segment struct {
+	Op     byte
+	Points []Point
+}
+
+// Outline returns the contours of text at size pixels, starting at x on
+// the baseline y. Contours follow the nonzero fill rule.
+func Outline(text string, x, y, size float64, bold bool) []Segment {
+	f := faceOf(bold)
+	scale := size / float64(f.unitsPerEm)
+	segments := []Segment{}
+	for _, r := range text {
+		g := f.glyph(r)
+		segments = append(segments, glyphSegments(g.path, x, y, scale)...)
+		x += float64(g.advance) * scale
+	}
+	return segments
+}
+
+// glyphSegments parses a glyph path, placing its origin at (x, y)
+func glyphSegments(path string, x, y, scale float64) []Segment {
+	segments := []Segment{}
+	for path != "" {
+		op := path[0]
+		end := strings.IndexAny(path[1:], "MLQZ") + 1
+		if end == 0 {
+			end = len(path)
+		}
+		fields := strings.Fields(path[1:end])
+		path = path[end:]
+
+		seg := Segment{Op: op}
+		for i := 0; i+1 < len(fields); i += 2 {
+			ux, _ := strconv.Atoi(fields[i])
+			uy, _ := strconv.Atoi(fields[i+1])
+			seg.Points = append(seg.Points, Point{X: x + float64(ux)*scale, Y: y - float64(uy)*scale})
+		}
+		segments = append(segments, seg)
+	}
+	return segments
+}
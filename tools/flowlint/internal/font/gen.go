@@ -0,0 +1,363 @@
+//go:build ignore
+
+// gen extracts glyph outlines and advance widths from TrueType fonts into
+// glyphs.go. Run it with go generate from this directory; it reads the
+// DejaVu Sans fonts installed by most Linux distributions.
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+)
+
+// charset lists the runes to extract: printable ASCII and Latin-1, plus
+// punctuation and symbols common in diagram labels
+var charset = func() []rune {
+	runes := []rune{}
+	for r := rune(0x20); r <= 0x7e; r++ {
+		runes = append(runes, r)
+	}
+	for r := rune(0xa0); r <= 0xff; r++ {
+		runes = append(runes, r)
+	}
+	return append(runes, []rune("–—‘’“”•…→←↑↓↔⇒✓✗")...)
+}()
+
+func main() {
+	if len(os.Args) != 3 {
+		log.Fatal("usage: go run gen.go <regular.ttf> <bold.ttf>")
+	}
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by gen.go from DejaVu Sans; DO NOT EDIT.\n\n")
+	b.WriteString("package font\n\n")
+	for i, face := range []string{"regularFace", "boldFace"} {
+		f, err := load(os.Args[i+1])
+		if err != nil {
+			log.Fatal(err)
+		}
+		fmt.Fprintf(&b, "var %s = face{\n\tunitsPerEm: %d,\n\tascent: %d,\n\tdescent: %d,\n\tglyphs: map[rune]glyph{\n",
+			face, f.unitsPerEm, f.ascent, f.descent)
+		for _, r := range charset {
+			id, ok := f.cmap[r]
+			if !ok {
+				continue
+			}
+			path, err := f.outline(id, 0)
+			if err != nil {
+				log.Fatalf("%U: %v", r, err)
+			}
+			fmt.Fprintf(&b, "\t\t%q: {%d, %q},\n", r, f.advance(id), path)
+		}
+		b.WriteString("\t},\n}\n\n")
+	}
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("glyphs.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// ttf is the subset of a TrueType font gen needs
+type ttf struct {
+	data       []byte
+	tables     map[string][]byte
+	unitsPerEm int
+	ascent     int
+	descent    int
+	longLoca   bool
+	numHMetric int
+	cmap       map[rune]uint16
+}
+
+func load(path string) (*ttf, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	f := &ttf{data: data, tables: make(map[string][]byte), cmap: make(map[rune]uint16)}
+	numTables := int(u16(data, 4))
+	for i := 0; i < numTables; i++ {
+		rec := data[12+16*i:]
+		offset, length := u32(rec, 8), u32(rec, 12)
+		f.tables[string(rec[:4])] = data[offset : offset+length]
+	}
+	for _, tag := range []string{"head", "hhea", "hmtx", "loca", "glyf", "cmap"} {
+		if f.tables[tag] == nil {
+			return nil, fmt.Errorf("%s: missing %s table", path, tag)
+		}
+	}
+
+	head, hhea := f.tables["head"], f.tables["hhea"]
+	f.unitsPerEm = int(u16(head, 18))
+	f.longLoca = u16(head, 50) == 1
+	f.ascent = int(int16(u16(hhea, 4)))
+	f.descent = int(int16(u16(hhea, 6)))
+	f.numHMetric = int(u16(hhea, 34))
+	return f, f.parseCmap()
+}
+
+// parseCmap reads the Unicode BMP (format 4) subtable
+func (f *ttf) parseCmap() error {
+	cmap := f.tables["cmap"]
+	for i := 0; i < int(u16(cmap, 2)); i++ {
+		rec := cmap[4+8*i:]
+		platform, encoding := u16(rec, 0), u16(rec, 2)
+		sub := cmap[u32(rec, 4):]
+		if platform != 3 || encoding != 1 || u16(sub, 0) != 4 {
+			continue
+		}
+		segs := int(u16(sub, 6)) / 2
+		ends, starts := 14, 16+2*segs
+		deltas, ranges := starts+2*segs, starts+4*segs
+		for s := 0; s < segs; s++ {
+			end, start := u16(sub, ends+2*s), u16(sub, starts+2*s)
+			delta, rangeOffset := u16(sub, deltas+2*s), u16(sub, ranges+2*s)
+			for c := uint32(start); c <= uint32(end) && c != 0xffff; c++ {
+				var id uint16
+				if rangeOffset == 0 {
+					id = uint16(c) + delta
+				} else {
+					at := ranges + 2*s + int(rangeOffset) + 2*int(c-uint32(start))
+					if id = u16(sub, at); id != 0 {
+						id += delta
+					}
+				}
+				if id != 0 {
+					f.cmap[rune(c)] = id
+				}
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("no Unicode cmap subtable")
+}
+
+func (f *ttf) advance(id uint16) int {
+	i := int(id)
+	if i >= f.numHMetric {
+		i = f.numHMetric - 1
+	}
+	return int(u16(f.tables["hmtx"], 4*i))
+}
+
+func (f *ttf) glyphData(id uint16) []byte {
+	loca := f.tables["loca"]
+	var start, end uint32
+	if f.longLoca {
+		start, end = u32(loca, 4*int(id)), u32(loca, 4*int(id)+4)
+	} else {
+		start, end = 2*uint32(u16(loca, 2*int(id))), 2*uint32(u16(loca, 2*int(id)+2))
+	}
+	return f.tables["glyf"][start:end]
+}
+
+type point struct {
+	x, y   float64
+	onCurv bool
+}
+
+// outline returns a glyph as an SVG-like path in font units, y up
+func (f *ttf) outline(id uint16, depth int) (string, error) {
+	contours, err := f.contours(id, depth, [6]float64{1, 0, 0, 1, 0, 0})
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	for _, c := range contours {
+		writeContour(&b, c)
+	}
+	return b.String(), nil
+}
+
+// contours returns a glyph's contours transformed by m (a b c d e f)
+func (f *ttf) contours(id uint16, depth int, m [6]float64) ([][]point, error) {
+	if depth > 8 {
+		return nil, fmt.Errorf("composite glyphs nested too deeply")
+	}
+	g := f.glyphData(id)
+	if len(g) == 0 {
+		return nil, nil
+	}
+	n := int(int16(u16(g, 0)))
+	if n < 0 {
+		return f.composite(g, depth, m)
+	}
+
+	endPts := make([]int, n)
+	for i := range endPts {
+		endPts[i] = int(u16(g, 10+2*i))
+	}
+	numPts := 0
+	if n > 0 {
+		numPts = endPts[n-1] + 1
+	}
+	at := 10 + 2*n
+	at += 2 + int(u16(g, at))
+
+	flags := make([]byte, 0, numPts)
+	for len(flags) < numPts {
+		flag := g[at]
+		at++
+		flags = append(flags, flag)
+		if flag&8 != 0 {
+			repeat := int(g[at])
+			at++
+			for k := 0; k < repeat; k++ {
+				flags = append(flags, flag)
+			}
+		}
+	}
+
+	coords := func(short, same byte) []int {
+		vals := make([]int, numPts)
+		v := 0
+		for i, flag := range flags {
+			switch {
+			case flag&short != 0:
+				d := int(g[at])
+				at++
+				if flag&same == 0 {
+					d = -d
+				}
+				v += d
+			case flag&same == 0:
+				v += int(int16(u16(g, at)))
+				at += 2
+			}
+			vals[i] = v
+		}
+		return vals
+	}
+	xs := coords(2, 16)
+	ys := coords(4, 32)
+
+	contours := [][]point{}
+	start := 0
+	for _, end := range endPts {
+		c := []point{}
+		for i := start; i <= end; i++ {
+			x, y := float64(xs[i]), float64(ys[i])
+			c = append(c, point{m[0]*x + m[2]*y + m[4], m[1]*x + m[3]*y + m[5], flags[i]&1 != 0})
+		}
+		contours = append(contours, c)
+		start = end + 1
+	}
+	return contours, nil
+}
+
+// composite resolves a glyph built from other glyphs
+func (f *ttf) composite(g []byte, depth int, m [6]float64) ([][]point, error) {
+	contours := [][]point{}
+	at := 10
+	for {
+		flags, id := u16(g, at), u16(g, at+2)
+		at += 4
+		var dx, dy float64
+		if flags&1 != 0 {
+			dx, dy = float64(int16(u16(g, at))), float64(int16(u16(g, at+2)))
+			at += 4
+		} else {
+			dx, dy = float64(int8(g[at])), float64(int8(g[at+1]))
+			at += 2
+		}
+		if flags&2 == 0 {
+			return nil, fmt.Errorf("composite glyph with point-matched components")
+		}
+		a, b, c, d := 1.0, 0.0, 0.0, 1.0
+		f2dot14 := func(at int) float64 { return float64(int16(u16(g, at))) / 16384 }
+		switch {
+		case flags&8 != 0:
+			a = f2dot14(at)
+			d = a
+			at += 2
+		case flags&0x40 != 0:
+			a, d = f2dot14(at), f2dot14(at+2)
+			at += 4
+		case flags&0x80 != 0:
+			a, b, c, d = f2dot14(at), f2dot14(at+2), f2dot14(at+4), f2dot14(at+6)
+			at += 8
+		}
+		// Component transform followed by the parent's
+		cm := [6]float64{
+			m[0]*a + m[2]*b, m[1]*a + m[3]*b,
+			m[0]*c + m[2]*d, m[1]*c + m[3]*d,
+			m[0]*dx + m[2]*dy + m[4], m[1]*dx + m[3]*dy + m[5],
+		}
+		sub, err := f.contours(id, depth+1, cm)
+		if err != nil {
+			return nil, err
+		}
+		contours = append(contours, sub...)
+		if flags&0x20 == 0 {
+			return contours, nil
+		}
+	}
+}
+
+// writeContour writes a TrueType contour as M, L, Q and Z commands.
+// Between two off-curve points lies an implied on-curve midpoint.
+func writeContour(b *strings.Builder, c []point) {
+	if len(c) == 0 {
+		return
+	}
+	// Start on an on-curve point, or the midpoint of the first two
+	first := -1
+	for i, p := range c {
+		if p.onCurv {
+			first = i
+			break
+		}
+	}
+	var start point
+	if first >= 0 {
+		c = append(c[first:], c[:first]...)
+		start = c[0]
+		c = c[1:]
+	} else {
+		start = mid(c[len(c)-1], c[0])
+	}
+	fmt.Fprintf(b, "M%d %d", round(start.x), round(start.y))
+
+	var ctrl *point
+	for _, p := range append(c, start) {
+		switch {
+		case p.onCurv && ctrl == nil:
+			fmt.Fprintf(b, "L%d %d", round(p.x), round(p.y))
+		case p.onCurv:
+			fmt.Fprintf(b, "Q%d %d %d %d", round(ctrl.x), round(ctrl.y), round(p.x), round(p.y))
+			ctrl = nil
+		case ctrl == nil:
+			p := p
+			ctrl = &p
+		default:
+			m := mid(*ctrl, p)
+			fmt.Fprintf(b, "Q%d %d %d %d", round(ctrl.x), round(ctrl.y), round(m.x), round(m.y))
+			p := p
+			ctrl = &p
+		}
+	}
+	b.WriteString("Z")
+}
+
+func mid(a, b point) point {
+	return point{(a.x + b.x) / 2, (a.y + b.y) / 2, true}
+}
+
+func round(v float64) int {
+	if v < 0 {
+		return -int(-v + 0.5)
+	}
+	return int(v + 0.5)
+}
+
+func u16(b []byte, at int) uint16 { return binary.BigEndian.Uint16(b[at:]) }
+func u32(b []byte, at int) uint32 { return binary.BigEndian.Uint32(b[at:]) }
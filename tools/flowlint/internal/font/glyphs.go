@@ -0,0 +1,433 @@
+// Code generated by gen.go from DejaVu Sans; DO NOT EDIT.
+
+package font
+
+var regularFace = face{
+	unitsPerEm: 2048,
+	ascent:     1901,
+	descent:    -483,
+	glyphs: map[rune]glyph{
+		' ':      {651, ""},
+		'!':      {821, "M309 254L512 254L512 0L309 0L309 254ZM309 1493L512 1493L512 838L492 481L330 481L309 838L309 1493Z"},
+		'"':      {942, "M367 1493L367 938L197 938L197 1493L367 1493ZM745 1493L745 938L575 938L575 1493L745 1493Z"},
+		'#':      {1716, "M1047 901L756 901L672 567L965 567L1047 901ZM897 1470L793 1055L1085 1055L1190 1470L1350 1470L1247 1055L1559 1055L1559 901L1208 901L1126 567L1444 567L1444 414L1087 414L983 0L823 0L926 414L633 414L530 0L369 0L473 414L158 414L158 567L510 567L594 901L272 901L272 1055L633 1055L735 1470L897 1470Z"},
+		'$':      {1303, "M692 -301L592 -301L591 0Q486 2 381 25Q276 47 170 92L170 272Q272 208 377 176Q481 143 592 142L592 598Q371 634 271 720Q170 806 170 956Q170 1119 279 1213Q388 1307 592 1321L592 1556L692 1556L692 1324Q785 1320 872 1305Q959 1289 1042 1262L1042 1087Q959 1129 872 1152Q784 1175 692 1179L692 752Q919 717 1026 627Q1133 537 1133 381Q1133 212 1020 115Q906 17 692 2L692 -301ZM592 770L592 1180Q476 1167 415 1114Q354 1061 354 973Q354 887 411 839Q467 791 592 770ZM692 578L692 145Q819 162 884 217Q948 272 948 362Q948 450 887 502Q825 554 692 578Z"},
+		'%':      {1946, "M1489 657Q1402 657 1353 583Q1303 509 1303 377Q1303 247 1353 173Q1402 98 1489 98Q1574 98 1624 173Q1673 247 1673 377Q1673 508 1624 583Q1574 657 1489 657ZM1489 784Q1647 784 1740 674Q1833 564 1833 377Q1833 190 1740 81Q1646 -29 1489 -29Q1329 -29 1236 81Q1143 190 1143 377Q1143 565 1237 675Q1330 784 1489 784ZM457 1393Q371 1393 322 1319Q272 1244 272 1114Q272 982 321 908Q370 834 457 834Q544 834 594 908Q643 982 643 1114Q643 1243 593 1318Q543 1393 457 1393ZM1360 1520L1520 1520L586 -29L426 -29L1360 1520ZM457 1520Q615 1520 709 1411Q803 1301 803 1114Q803 925 710 816Q616 707 457 707Q298 707 206 817Q113 926 113 1114Q113 1300 206 1410Q299 1520 457 1520Z"},
+		'&':      {1597, "M498 803Q407 722 365 642Q322 561 322 473Q322 327 428 230Q534 133 694 133Q789 133 872 165Q955 196 1028 260L498 803ZM639 915L1147 395Q1206 484 1239 586Q1272 687 1278 801L1464 801Q1452 669 1400 540Q1348 411 1255 285L1534 0L1282 0L1139 147Q1035 58 921 15Q807 -29 676 -29Q435 -29 282 109Q129 246 129 461Q129 589 196 702Q263 814 397 913Q349 976 324 1039Q299 1101 299 1161Q299 1323 410 1422Q521 1520 705 1520Q788 1520 871 1502Q953 1484 1038 1448L1038 1266Q951 1313 872 1338Q793 1362 725 1362Q620 1362 555 1307Q489 1251 489 1163Q489 1112 519 1061Q548 1009 639 915Z"},
+		'\'':     {563, "M367 1493L367 938L197 938L197 1493L367 1493Z"},
+		'(':      {799, "M635 1554Q501 1324 436 1099Q371 874 371 643Q371 412 437 186Q502 -41 635 -270L475 -270Q325 -35 251 192Q176 419 176 643Q176 866 250 1092Q324 1318 475 1554L635 1554Z"},
+		')':      {799, "M164 1554L324 1554Q474 1318 549 1092Q623 866 623 643Q623 419 549 192Q474 -35 324 -270L164 -270Q297 -41 363 186Q428 412 428 643Q428 874 363 1099Q297 1324 164 1554Z"},
+		'*':      {1024, "M963 1247L604 1053L963 858L905 760L569 963L569 586L455 586L455 963L119 760L61 858L420 1053L61 1247L119 1346L455 1143L455 1520L569 1520L569 1143L905 1346L963 1247Z"},
+		'+':      {1716, "M942 1284L942 727L1499 727L1499 557L942 557L942 0L774 0L774 557L217 557L217 727L774 727L774 1284L942 1284Z"},
+		',':      {651, "M240 254L451 254L451 82L287 -238L158 -238L240 82L240 254Z"},
+		'-':      {739, "M100 643L639 643L639 479L100 479L100 643Z"},
+		'.':      {651, "M219 254L430 254L430 0L219 0L219 254Z"},
+		'/':      {690, "M520 1493L690 1493L170 -190L0 -190L520 1493Z"},
+		'0':      {1303, "M651 1360Q495 1360 417 1207Q338 1053 338 745Q338 438 417 285Q495 131 651 131Q808 131 887 285Q965 438 965 745Q965 1053 887 1207Q808 1360 651 1360ZM651 1520Q902 1520 1035 1322Q1167 1123 1167 745Q1167 368 1035 170Q902 -29 651 -29Q400 -29 268 170Q135 368 135 745Q135 1123 268 1322Q400 1520 651 1520Z"},
+		'1':      {1303, "M254 170L584 170L584 1309L225 1237L225 1421L582 1493L784 1493L784 170L1114 170L1114 0L254 0L254 170Z"},
+		'2':      {1303, "M393 170L1098 170L1098 0L150 0L150 170Q265 289 464 490Q662 690 713 748Q810 857 849 933Q887 1008 887 1081Q887 1200 804 1275Q720 1350 586 1350Q491 1350 386 1317Q280 1284 160 1217L160 1421Q282 1470 388 1495Q494 1520 582 1520Q814 1520 952 1404Q1090 1288 1090 1094Q1090 1002 1056 920Q1021 837 930 725Q905 696 771 558Q637 419 393 170Z"},
+		'3':      {1303, "M831 805Q976 774 1058 676Q1139 578 1139 434Q1139 213 987 92Q835 -29 555 -29Q461 -29 362 -11Q262 8 156 45L156 240Q240 191 340 166Q440 141 549 141Q739 141 839 216Q938 291 938 434Q938 566 846 641Q753 715 588 715L414 715L414 881L596 881Q745 881 824 941Q903 1000 903 1112Q903 1227 822 1289Q740 1350 588 1350Q505 1350 410 1332Q315 1314 201 1276L201 1456Q316 1488 417 1504Q517 1520 606 1520Q836 1520 970 1416Q1104 1311 1104 1133Q1104 1009 1033 924Q962 838 831 805Z"},
+		'4':      {1303, "M774 1317L264 520L774 520L774 1317ZM721 1493L975 1493L975 520L1188 520L1188 352L975 352L975 0L774 0L774 352L100 352L100 547L721 1493Z"},
+		'5':      {1303, "M221 1493L1014 1493L1014 1323L406 1323L406 957Q450 972 494 980Q538 987 582 987Q832 987 978 850Q1124 713 1124 479Q1124 238 974 105Q824 -29 551 -29Q457 -29 360 -13Q262 3 158 35L158 238Q248 189 344 165Q440 141 547 141Q720 141 821 232Q922 323 922 479Q922 635 821 726Q720 817 547 817Q466 817 386 799Q305 781 221 743L221 1493Z"},
+		'6':      {1303, "M676 827Q540 827 461 734Q381 641 381 479Q381 318 461 225Q540 131 676 131Q812 131 892 225Q971 318 971 479Q971 641 892 734Q812 827 676 827ZM1077 1460L1077 1276Q1001 1312 924 1331Q846 1350 770 1350Q570 1350 465 1215Q359 1080 344 807Q403 894 492 941Q581 987 688 987Q913 987 1044 851Q1174 714 1174 479Q1174 249 1038 110Q902 -29 676 -29Q417 -29 280 170Q143 368 143 745Q143 1099 311 1310Q479 1520 762 1520Q838 1520 916 1505Q993 1490 1077 1460Z"},
+		'7':      {1303, "M168 1493L1128 1493L1128 1407L586 0L375 0L885 1323L168 1323L168 1493Z"},
+		'8':      {1303, "M651 709Q507 709 425 632Q342 555 342 420Q342 285 425 208Q507 131 651 131Q795 131 878 209Q961 286 961 420Q961 555 879 632Q796 709 651 709ZM449 795Q319 827 247 916Q174 1005 174 1133Q174 1312 302 1416Q429 1520 651 1520Q874 1520 1001 1416Q1128 1312 1128 1133Q1128 1005 1056 916Q983 827 854 795Q1000 761 1082 662Q1163 563 1163 420Q1163 203 1031 87Q898 -29 651 -29Q404 -29 272 87Q139 203 139 420Q139 563 221 662Q303 761 449 795ZM375 1114Q375 998 448 933Q520 868 651 868Q781 868 855 933Q928 998 928 1114Q928 1230 855 1295Q781 1360 651 1360Q520 1360 448 1295Q375 1230 375 1114Z"},
+		'9':      {1303, "M225 31L225 215Q301 179 379 160Q457 141 532 141Q732 141 838 276Q943 410 958 684Q900 598 811 552Q722 506 614 506Q390 506 260 642Q129 777 129 1012Q129 1242 265 1381Q401 1520 627 1520Q886 1520 1023 1322Q1159 1123 1159 745Q1159 392 992 182Q824 -29 541 -29Q465 -29 387 -14Q309 1 225 31ZM627 664Q763 664 843 757Q922 850 922 1012Q922 1173 843 1267Q763 1360 627 1360Q491 1360 412 1267Q332 1173 332 1012Q332 850 412 757Q491 664 627 664Z"},
+		':':      {690, "M240 254L451 254L451 0L240 0L240 254ZM240 1059L451 1059L451 805L240 805L240 1059Z"},
+		';':      {690, "M240 1059L451 1059L451 805L240 805L240 1059ZM240 254L451 254L451 82L287 -238L158 -238L240 82L240 254Z"},
+		'<':      {1716, "M1499 1008L467 641L1499 276L1499 94L217 559L217 725L1499 1190L1499 1008Z"},
+		'=':      {1716, "M217 930L1499 930L1499 762L217 762L217 930ZM217 522L1499 522L1499 352L217 352L217 522Z"},
+		'>':      {1716, "M217 1008L217 1190L1499 725L1499 559L217 94L217 276L1247 641L217 1008Z"},
+		'?':      {1087, "M391 254L594 254L594 0L391 0L391 254ZM588 401L397 401L397 555Q397 656 425 721Q453 786 543 872L633 961Q690 1014 716 1061Q741 1108 741 1157Q741 1246 676 1301Q610 1356 502 1356Q423 1356 334 1321Q244 1286 147 1219L147 1407Q241 1464 338 1492Q434 1520 537 1520Q721 1520 833 1423Q944 1326 944 1167Q944 1091 908 1023Q872 954 782 868L694 782Q647 735 628 709Q608 682 600 657Q594 636 591 606Q588 576 588 524L588 401Z"},
+		'@':      {2048, "M762 537Q762 394 833 313Q904 231 1028 231Q1151 231 1222 313Q1292 395 1292 537Q1292 677 1220 760Q1148 842 1026 842Q905 842 834 760Q762 678 762 537ZM1307 238Q1247 161 1170 125Q1092 88 989 88Q817 88 710 213Q602 337 602 537Q602 737 710 862Q818 987 989 987Q1092 987 1170 950Q1248 912 1307 836L1307 967L1450 967L1450 231Q1596 253 1679 365Q1761 476 1761 653Q1761 760 1730 854Q1698 948 1634 1028Q1530 1159 1381 1229Q1231 1298 1055 1298Q932 1298 819 1266Q706 1233 610 1169Q453 1067 365 902Q276 736 276 543Q276 384 334 245Q391 106 500 0Q605 -104 743 -159Q881 -213 1038 -213Q1167 -213 1292 -170Q1416 -126 1520 -45L1610 -156Q1485 -253 1338 -305Q1190 -356 1038 -356Q853 -356 689 -291Q525 -225 397 -100Q269 25 202 190Q135 354 135 543Q135 725 203 890Q271 1055 397 1180Q526 1307 695 1375Q864 1442 1053 1442Q1265 1442 1447 1355Q1628 1268 1751 1108Q1826 1010 1866 895Q1905 780 1905 657Q1905 394 1746 242Q1587 90 1307 84L1307 238Z"},
+		'A':      {1401, "M700 1294L426 551L975 551L700 1294ZM586 1493L815 1493L1384 0L1174 0L1038 383L365 383L229 0L16 0L586 1493Z"},
+		'B':      {1405, "M403 713L403 166L727 166Q890 166 969 234Q1047 301 1047 440Q1047 580 969 647Q890 713 727 713L403 713ZM403 1327L403 877L702 877Q850 877 923 933Q995 988 995 1102Q995 1215 923 1271Q850 1327 702 1327L403 1327ZM201 1493L717 1493Q948 1493 1073 1397Q1198 1301 1198 1124Q1198 987 1134 906Q1070 825 946 805Q1095 773 1178 672Q1260 570 1260 418Q1260 218 1124 109Q988 0 737 0L201 0L201 1493Z"},
+		'C':      {1430, "M1319 1378L1319 1165Q1217 1260 1102 1307Q986 1354 856 1354Q600 1354 464 1198Q328 1041 328 745Q328 450 464 294Q600 137 856 137Q986 137 1102 184Q1217 231 1319 326L1319 115Q1213 43 1095 7Q976 -29 844 -29Q505 -29 310 179Q115 386 115 745Q115 1105 310 1313Q505 1520 844 1520Q978 1520 1097 1485Q1215 1449 1319 1378Z"},
+		'D':      {1577, "M403 1327L403 166L647 166Q956 166 1100 306Q1243 446 1243 748Q1243 1048 1100 1188Q956 1327 647 1327L403 1327ZM201 1493L616 1493Q1050 1493 1253 1313Q1456 1132 1456 748Q1456 362 1252 181Q1048 0 616 0L201 0L201 1493Z"},
+		'E':      {1294, "M201 1493L1145 1493L1145 1323L403 1323L403 881L1114 881L1114 711L403 711L403 170L1163 170L1163 0L201 0L201 1493Z"},
+		'F':      {1178, "M201 1493L1059 1493L1059 1323L403 1323L403 883L995 883L995 713L403 713L403 0L201 0L201 1493Z"},
+		'G':      {1587, "M1219 213L1219 614L889 614L889 780L1419 780L1419 139Q1302 56 1161 14Q1020 -29 860 -29Q510 -29 313 176Q115 380 115 745Q115 1111 313 1316Q510 1520 860 1520Q1006 1520 1138 1484Q1269 1448 1380 1378L1380 1163Q1268 1258 1142 1306Q1016 1354 877 1354Q603 1354 466 1201Q328 1048 328 745Q328 443 466 290Q603 137 877 137Q984 137 1068 156Q1152 174 1219 213Z"},
+		'H':      {1540, "M201 1493L403 1493L403 881L1137 881L1137 1493L1339 1493L1339 0L1137 0L1137 711L403 711L403 0L201 0L201 1493Z"},
+		'I':      {604, "M201 1493L403 1493L403 0L201 0L201 1493Z"},
+		'J':      {604, "M201 1493L403 1493L403 104Q403 -166 301 -288Q198 -410 -29 -410L-106 -410L-106 -240L-43 -240Q91 -240 146 -165Q201 -90 201 104L201 1493Z"},
+		'K':      {1343, "M201 1493L403 1493L403 862L1073 1493L1333 1493L592 797L1386 0L1120 0L403 719L403 0L201 0L201 1493Z"},
+		'L':      {1141, "M201 1493L403 1493L403 170L1130 170L1130 0L201 0L201 1493Z"},
+		'M':      {1767, "M201 1493L502 1493L883 477L1266 1493L1567 1493L1567 0L1370 0L1370 1311L985 287L782 287L397 1311L397 0L201 0L201 1493Z"},
+		'N':      {1532, "M201 1493L473 1493L1135 244L1135 1493L1331 1493L1331 0L1059 0L397 1249L397 0L201 0L201 1493Z"},
+		'O':      {1612, "M807 1356Q587 1356 458 1192Q328 1028 328 745Q328 463 458 299Q587 135 807 135Q1027 135 1156 299Q1284 463 1284 745Q1284 1028 1156 1192Q1027 1356 807 1356ZM807 1520Q1121 1520 1309 1310Q1497 1099 1497 745Q1497 392 1309 182Q1121 -29 807 -29Q492 -29 304 181Q115 391 115 745Q115 1099 304 1310Q492 1520 807 1520Z"},
+		'P':      {1235, "M403 1327L403 766L657 766Q798 766 875 839Q952 912 952 1047Q952 1181 875 1254Q798 1327 657 1327L403 1327ZM201 1493L657 1493Q908 1493 1037 1380Q1165 1266 1165 1047Q1165 826 1037 713Q908 600 657 600L403 600L403 0L201 0L201 1493Z"},
+		'Q':      {1612, "M807 1356Q587 1356 458 1192Q328 1028 328 745Q328 463 458 299Q587 135 807 135Q1027 135 1156 299Q1284 463 1284 745Q1284 1028 1156 1192Q1027 1356 807 1356ZM1090 27L1356 -264L1112 -264L891 -25Q858 -27 841 -28Q823 -29 807 -29Q492 -29 304 182Q115 392 115 745Q115 1099 304 1310Q492 1520 807 1520Q1121 1520 1309 1310Q1497 1099 1497 745Q1497 485 1393 300Q1288 115 1090 27Z"},
+		'R':      {1423, "M909 700Q974 678 1036 606Q1097 534 1159 408L1364 0L1147 0L956 383Q882 533 813 582Q743 631 623 631L403 631L403 0L201 0L201 1493L657 1493Q913 1493 1039 1386Q1165 1279 1165 1063Q1165 922 1100 829Q1034 736 909 700ZM403 1327L403 797L657 797Q803 797 878 865Q952 932 952 1063Q952 1194 878 1261Q803 1327 657 1327L403 1327Z"},
+		'S':      {1300, "M1096 1444L1096 1247Q981 1302 879 1329Q777 1356 682 1356Q517 1356 428 1292Q338 1228 338 1110Q338 1011 398 961Q457 910 623 879L745 854Q971 811 1079 703Q1186 594 1186 412Q1186 195 1041 83Q895 -29 614 -29Q508 -29 389 -5Q269 19 141 66L141 274Q264 205 382 170Q500 135 614 135Q787 135 881 203Q975 271 975 397Q975 507 908 569Q840 631 686 662L563 686Q337 731 236 827Q135 923 135 1094Q135 1292 275 1406Q414 1520 659 1520Q764 1520 873 1501Q982 1482 1096 1444Z"},
+		'T':      {1251, "M-6 1493L1257 1493L1257 1323L727 1323L727 0L524 0L524 1323L-6 1323L-6 1493Z"},
+		'U':      {1499, "M178 1493L381 1493L381 586Q381 346 468 241Q555 135 750 135Q944 135 1031 241Q1118 346 1118 586L1118 1493L1321 1493L1321 561Q1321 269 1177 120Q1032 -29 750 -29Q467 -29 323 120Q178 269 178 561L178 1493Z"},
+		'V':      {1401, "M586 0L16 1493L227 1493L700 236L1174 1493L1384 1493L815 0L586 0Z"},
+		'W':      {2025, "M68 1493L272 1493L586 231L899 1493L1126 1493L1440 231L1753 1493L1958 1493L1583 0L1329 0L1014 1296L696 0L442 0L68 1493Z"},
+		'X':      {1403, "M129 1493L346 1493L717 938L1090 1493L1307 1493L827 776L1339 0L1122 0L702 635L279 0L61 0L594 797L129 1493Z"},
+		'Y':      {1251, "M-4 1493L213 1493L627 879L1038 1493L1255 1493L727 711L727 0L524 0L524 711L-4 1493Z"},
+		'Z':      {1403, "M115 1493L1288 1493L1288 1339L344 170L1311 170L1311 0L92 0L92 154L1036 1323L115 1323L115 1493Z"},
+		'[':      {799, "M176 1556L600 1556L600 1413L360 1413L360 -127L600 -127L600 -270L176 -270L176 1556Z"},
+		'\\':     {690, "M170 1493L690 -190L520 -190L0 1493L170 1493Z"},
+		']':      {799, "M623 1556L623 -270L199 -270L199 -127L438 -127L438 1413L199 1413L199 1556L623 1556Z"},
+		'^':      {1716, "M956 1493L1499 936L1298 936L858 1331L418 936L217 936L760 1493L956 1493Z"},
+		'_':      {1024, "M1044 -340L1044 -483L-20 -483L-20 -340L1044 -340Z"},
+		'`':      {1024, "M367 1638L649 1264L496 1264L170 1638L367 1638Z"},
+		'a':      {1255, "M702 563Q479 563 393 512Q307 461 307 338Q307 240 372 183Q436 125 547 125Q700 125 793 234Q885 342 885 522L885 563L702 563ZM1069 639L1069 0L885 0L885 170Q822 68 728 20Q634 -29 498 -29Q326 -29 225 68Q123 164 123 326Q123 515 250 611Q376 707 627 707L885 707L885 725Q885 852 802 922Q718 991 567 991Q471 991 380 968Q289 945 205 899L205 1069Q306 1108 401 1128Q496 1147 586 1147Q829 1147 949 1021Q1069 895 1069 639Z"},
+		'b':      {1300, "M997 559Q997 762 914 878Q830 993 684 993Q538 993 455 878Q371 762 371 559Q371 356 455 241Q538 125 684 125Q830 125 914 241Q997 356 997 559ZM371 950Q429 1050 518 1099Q606 1147 729 1147Q933 1147 1061 985Q1188 823 1188 559Q1188 295 1061 133Q933 -29 729 -29Q606 -29 518 20Q429 68 371 168L371 0L186 0L186 1556L371 1556L371 950Z"},
+		'c':      {1126, "M999 1077L999 905Q921 948 843 970Q764 991 684 991Q505 991 406 878Q307 764 307 559Q307 354 406 241Q505 127 684 127Q764 127 843 149Q921 170 999 213L999 43Q922 7 840 -11Q757 -29 664 -29Q411 -29 262 130Q113 289 113 559Q113 833 264 990Q414 1147 676 1147Q761 1147 842 1130Q923 1112 999 1077Z"},
+		'd':      {1300, "M930 950L930 1556L1114 1556L1114 0L930 0L930 168Q872 68 784 20Q695 -29 571 -29Q368 -29 241 133Q113 295 113 559Q113 823 241 985Q368 1147 571 1147Q695 1147 784 1099Q872 1050 930 950ZM303 559Q303 356 387 241Q470 125 616 125Q762 125 846 241Q930 356 930 559Q930 762 846 878Q762 993 616 993Q470 993 387 878Q303 762 303 559Z"},
+		'e':      {1260, "M1151 606L1151 516L305 516Q317 326 420 227Q522 127 705 127Q811 127 911 153Q1010 179 1108 231L1108 57Q1009 15 905 -7Q801 -29 694 -29Q426 -29 270 127Q113 283 113 549Q113 824 262 986Q410 1147 662 1147Q888 1147 1020 1002Q1151 856 1151 606ZM967 660Q965 811 883 901Q800 991 664 991Q510 991 418 904Q325 817 311 659L967 660Z"},
+		'f':      {721, "M760 1556L760 1403L584 1403Q485 1403 447 1363Q408 1323 408 1219L408 1120L711 1120L711 977L408 977L408 0L223 0L223 977L47 977L47 1120L223 1120L223 1198Q223 1385 310 1471Q397 1556 586 1556L760 1556Z"},
+		'g':      {1300, "M930 573Q930 773 848 883Q765 993 616 993Q468 993 386 883Q303 773 303 573Q303 374 386 264Q468 154 616 154Q765 154 848 264Q930 374 930 573ZM1114 139Q1114 -147 987 -287Q860 -426 598 -426Q501 -426 415 -412Q329 -397 248 -367L248 -188Q329 -232 408 -253Q487 -274 569 -274Q750 -274 840 -180Q930 -85 930 106L930 197Q873 98 784 49Q695 0 571 0Q365 0 239 157Q113 314 113 573Q113 833 239 990Q365 1147 571 1147Q695 1147 784 1098Q873 1049 930 950L930 1120L1114 1120L1114 139Z"},
+		'h':      {1298, "M1124 676L1124 0L940 0L940 670Q940 829 878 908Q816 987 692 987Q543 987 457 892Q371 797 371 633L371 0L186 0L186 1556L371 1556L371 946Q437 1047 527 1097Q616 1147 733 1147Q926 1147 1025 1028Q1124 908 1124 676Z"},
+		'i':      {569, "M193 1120L377 1120L377 0L193 0L193 1120ZM193 1556L377 1556L377 1323L193 1323L193 1556Z"},
+		'j':      {569, "M193 1120L377 1120L377 -20Q377 -234 296 -330Q214 -426 33 -426L-37 -426L-37 -270L12 -270Q117 -270 155 -222Q193 -173 193 -20L193 1120ZM193 1556L377 1556L377 1323L193 1323L193 1556Z"},
+		'k':      {1186, "M186 1556L371 1556L371 637L920 1120L1155 1120L561 596L1180 0L940 0L371 547L371 0L186 0L186 1556Z"},
+		'l':      {569, "M193 1556L377 1556L377 0L193 0L193 1556Z"},
+		'm':      {1995, "M1065 905Q1134 1029 1230 1088Q1326 1147 1456 1147Q1631 1147 1726 1025Q1821 902 1821 676L1821 0L1636 0L1636 670Q1636 831 1579 909Q1522 987 1405 987Q1262 987 1179 892Q1096 797 1096 633L1096 0L911 0L911 670Q911 832 854 910Q797 987 678 987Q537 987 454 892Q371 796 371 633L371 0L186 0L186 1120L371 1120L371 946Q434 1049 522 1098Q610 1147 731 1147Q853 1147 939 1085Q1024 1023 1065 905Z"},
+		'n':      {1298, "M1124 676L1124 0L940 0L940 670Q940 829 878 908Q816 987 692 987Q543 987 457 892Q371 797 371 633L371 0L186 0L186 1120L371 1120L371 946Q437 1047 527 1097Q616 1147 733 1147Q926 1147 1025 1028Q1124 908 1124 676Z"},
+		'o':      {1253, "M627 991Q479 991 393 876Q307 760 307 559Q307 358 393 243Q478 127 627 127Q774 127 860 243Q946 359 946 559Q946 758 860 875Q774 991 627 991ZM627 1147Q867 1147 1004 991Q1141 835 1141 559Q1141 284 1004 128Q867 -29 627 -29Q386 -29 250 128Q113 284 113 559Q113 835 250 991Q386 1147 627 1147Z"},
+		'p':      {1300, "M371 168L371 -426L186 -426L186 1120L371 1120L371 950Q429 1050 518 1099Q606 1147 729 1147Q933 1147 1061 985Q1188 823 1188 559Q1188 295 1061 133Q933 -29 729 -29Q606 -29 518 20Q429 68 371 168ZM997 559Q997 762 914 878Q830 993 684 993Q538 993 455 878Q371 762 371 559Q371 356 455 241Q538 125 684 125Q830 125 914 241Q997 356 997 559Z"},
+		'q':      {1300, "M303 559Q303 356 387 241Q470 125 616 125Q762 125 846 241Q930 356 930 559Q930 762 846 878Q762 993 616 993Q470 993 387 878Q303 762 303 559ZM930 168Q872 68 784 20Q695 -29 571 -29Q368 -29 241 133Q113 295 113 559Q113 823 241 985Q368 1147 571 1147Q695 1147 784 1099Q872 1050 930 950L930 1120L1114 1120L1114 -426L930 -426L930 168Z"},
+		'r':      {842, "M842 948Q811 966 775 975Q738 983 694 983Q538 983 455 882Q371 780 371 590L371 0L186 0L186 1120L371 1120L371 946Q429 1048 522 1098Q615 1147 748 1147Q767 1147 790 1145Q813 1142 841 1137L842 948Z"},
+		's':      {1067, "M907 1087L907 913Q829 953 745 973Q661 993 571 993Q434 993 366 951Q297 909 297 825Q297 761 346 725Q395 688 543 655L606 641Q802 599 885 523Q967 446 967 309Q967 153 844 62Q720 -29 504 -29Q414 -29 317 -12Q219 6 111 41L111 231Q213 178 312 152Q411 125 508 125Q638 125 708 170Q778 214 778 295Q778 370 728 410Q677 450 506 487L442 502Q271 538 195 613Q119 687 119 817Q119 975 231 1061Q343 1147 549 1147Q651 1147 741 1132Q831 1117 907 1087Z"},
+		't':      {803, "M375 1438L375 1120L754 1120L754 977L375 977L375 369Q375 232 413 193Q450 154 565 154L754 154L754 0L565 0Q352 0 271 80Q190 159 190 369L190 977L55 977L55 1120L190 1120L190 1438L375 1438Z"},
+		'u':      {1298, "M174 442L174 1120L358 1120L358 449Q358 290 420 211Q482 131 606 131Q755 131 842 226Q928 321 928 485L928 1120L1112 1120L1112 0L928 0L928 172Q861 70 773 21Q684 -29 567 -29Q374 -29 274 91Q174 211 174 442ZM637 1147L637 1147Z"},
+		'v':      {1212, "M61 1120L256 1120L606 180L956 1120L1151 1120L731 0L481 0L61 1120Z"},
+		'w':      {1675, "M86 1120L270 1120L500 246L729 1120L946 1120L1176 246L1405 1120L1589 1120L1296 0L1079 0L838 918L596 0L379 0L86 1120Z"},
+		'x':      {1212, "M1124 1120L719 575L1145 0L928 0L602 440L276 0L59 0L494 586L96 1120L313 1120L610 721L907 1120L1124 1120Z"},
+		'y':      {1212, "M659 -104Q581 -304 507 -365Q433 -426 309 -426L162 -426L162 -272L270 -272Q346 -272 388 -236Q430 -200 481 -66L514 18L61 1120L256 1120L606 244L956 1120L1151 1120L659 -104Z"},
+		'z':      {1075, "M113 1120L987 1120L987 952L295 147L987 147L987 0L88 0L88 168L780 973L113 973L113 1120Z"},
+		'{':      {1303, "M1047 -190L1047 -334L985 -334Q736 -334 652 -260Q567 -186 567 35L567 274Q567 425 513 483Q459 541 317 541L256 541L256 684L317 684Q460 684 514 742Q567 799 567 948L567 1188Q567 1409 652 1483Q736 1556 985 1556L1047 1556L1047 1413L979 1413Q838 1413 795 1369Q752 1325 752 1184L752 936Q752 779 707 708Q661 637 551 612Q662 585 707 514Q752 443 752 287L752 39Q752 -102 795 -146Q838 -190 979 -190L1047 -190Z"},
+		'|':      {690, "M430 1565L430 -483L260 -483L260 1565L430 1565Z"},
+		'}':      {1303, "M256 -190L326 -190Q466 -190 509 -147Q551 -104 551 39L551 287Q551 443 596 514Q641 585 752 612Q641 637 596 708Q551 779 551 936L551 1184Q551 1326 509 1370Q466 1413 326 1413L256 1413L256 1556L319 1556Q568 1556 652 1483Q735 1409 735 1188L735 948Q735 799 789 742Q843 684 985 684L1047 684L1047 541L985 541Q843 541 789 483Q735 425 735 274L735 35Q735 -186 652 -260Q568 -334 319 -334L256 -334L256 -190Z"},
+		'~':      {1716, "M1499 817L1499 639Q1394 560 1305 526Q1215 492 1118 492Q1008 492 862 551Q851 555 846 557Q839 560 824 565Q669 627 575 627Q487 627 401 589Q315 550 217 467L217 645Q322 724 412 759Q501 793 598 793Q708 793 855 733Q865 729 870 727Q878 724 892 719Q1047 657 1141 657Q1227 657 1312 695Q1396 733 1499 817Z"},
+		'\u00a0': {651, ""},
+		'¡':      {821, "M512 866L309 866L309 1120L512 1120L512 866ZM512 -373L309 -373L309 282L330 639L492 639L512 282L512 -373Z"},
+		'¢':      {1303, "M678 131L678 987Q531 969 449 856Q367 743 367 559Q367 374 449 261Q531 148 678 131ZM1059 1077L1059 905Q985 946 917 967Q849 988 781 991L780 127Q850 132 919 153Q987 174 1059 213L1059 43Q994 13 926 -5Q857 -22 780 -29L780 -313L678 -313L678 -25Q437 -5 305 149Q172 302 172 559Q172 817 305 970Q437 1123 678 1145L678 1432L780 1432L781 1145Q854 1141 923 1125Q991 1108 1059 1077Z"},
+		'£':      {1303, "M1102 1460L1102 1278Q1026 1319 958 1340Q890 1360 829 1360Q681 1360 623 1283Q565 1205 565 993L565 778L956 778L956 635L565 635L565 170L1122 170L1122 0L129 0L129 170L365 170L365 635L166 635L166 778L365 778L365 1016Q365 1277 472 1399Q579 1520 811 1520Q872 1520 948 1505Q1023 1489 1102 1460Z"},
+		'¤':      {1303, "M891 993L1098 1202L1212 1087L1006 881Q1043 822 1061 763Q1079 703 1079 641Q1079 578 1060 521Q1041 463 1001 406L1210 199L1096 86L889 293Q830 253 772 234Q714 215 653 215Q595 215 535 234Q475 252 414 289L207 82L94 197L301 403Q264 465 246 524Q227 583 227 641Q227 705 246 763Q265 821 303 877L96 1083L211 1198L418 991Q473 1030 531 1049Q589 1067 653 1067Q713 1067 772 1049Q830 1031 891 993ZM922 643Q922 755 845 832Q767 909 653 909Q541 909 462 832Q383 755 383 643Q383 529 462 451Q540 373 653 373Q766 373 844 452Q922 530 922 643Z"},
+		'¥':      {1303, "M1165 455L752 455L752 0L551 0L551 455L135 455L135 578L551 578L551 629L467 784L135 784L135 907L399 907L82 1493L272 1493L651 793L1028 1493L1219 1493L901 907L1165 907L1165 784L834 784L750 629L750 578L1165 578L1165 455Z"},
+		'¦':      {690, "M430 408L430 -350L260 -350L260 408L430 408ZM430 1432L430 674L260 674L260 1432L430 1432Z"},
+		'§':      {1024, "M379 936Q316 890 285 845Q254 800 254 754Q254 678 324 612Q393 545 643 410Q706 455 737 501Q768 546 768 592Q768 667 697 735Q625 803 379 936ZM829 1462L829 1298Q746 1337 675 1357Q603 1376 547 1376Q450 1376 396 1336Q342 1296 342 1225Q342 1135 548 1020Q574 1005 588 997Q799 878 865 801Q930 724 930 623Q930 533 884 463Q838 393 745 340Q807 288 836 234Q864 179 864 115Q864 -27 762 -111Q660 -195 487 -195Q414 -195 337 -181Q260 -166 172 -137L172 27Q259 -12 333 -32Q407 -51 465 -51Q567 -51 624 -9Q680 33 680 109Q680 211 459 334L434 348Q220 468 156 545Q92 621 92 723Q92 814 139 886Q185 957 276 1006Q217 1050 188 1106Q158 1162 158 1231Q158 1361 258 1441Q358 1520 524 1520Q597 1520 674 1506Q750 1491 829 1462Z"},
+		'¨':      {1024, "M606 1552L809 1552L809 1350L606 1350L606 1552ZM215 1552L418 1552L418 1350L215 1350L215 1552Z"},
+		'©':      {2048, "M1024 1485Q1176 1485 1308 1430Q1439 1375 1548 1266Q1657 1157 1711 1026Q1765 895 1765 741Q1765 589 1711 459Q1657 328 1548 219Q1439 110 1308 55Q1176 0 1024 0Q872 0 741 55Q609 110 500 219Q391 328 337 459Q283 589 283 741Q283 895 337 1026Q391 1157 500 1266Q609 1375 741 1430Q872 1485 1024 1485ZM1024 1382Q893 1382 780 1335Q667 1288 573 1194Q479 1100 431 986Q383 871 383 741Q383 612 431 499Q479 385 573 291Q667 197 780 150Q893 102 1024 102Q1156 102 1270 150Q1383 197 1477 291Q1570 384 1617 497Q1663 610 1663 741Q1663 874 1616 988Q1569 1101 1477 1194Q1383 1288 1270 1335Q1156 1382 1024 1382ZM1323 1137L1323 1008Q1257 1041 1192 1057Q1127 1073 1061 1073Q912 1073 829 986Q745 898 745 741Q745 582 831 495Q916 408 1071 408Q1135 408 1196 424Q1257 439 1323 473L1323 346Q1256 317 1188 303Q1119 289 1049 289Q833 289 708 411Q582 533 582 741Q582 950 708 1071Q833 1192 1049 1192Q1122 1192 1190 1178Q1258 1164 1323 1137Z"},
+		'ª':      {965, "M139 592L827 592L827 469L139 469L139 592ZM825 1165L825 717L676 717L676 829Q632 766 560 732Q488 698 395 698Q267 698 191 766Q115 833 115 946Q115 1081 211 1150Q306 1219 494 1219L676 1219L676 1223Q676 1314 618 1360Q559 1405 442 1405Q380 1405 312 1388Q244 1371 176 1337L176 1464Q249 1492 322 1506Q394 1520 463 1520Q646 1520 736 1432Q825 1344 825 1165ZM549 1104Q388 1104 325 1071Q262 1037 262 958Q262 894 311 856Q360 817 442 817Q546 817 611 889Q676 961 676 1075L676 1104L549 1104Z"},
+		'«':      {1253, "M1061 1059L1061 868L760 600L1061 332L1061 141L592 559L592 641L1061 1059ZM627 1059L627 868L326 600L627 332L627 141L158 559L158 641L627 1059Z"},
+		'¬':      {1716, "M217 862L1499 862L1499 287L1331 287L1331 692L217 692L217 862Z"},
+		'\u00ad': {739, "M100 643L639 643L639 479L100 479L100 643Z"},
+		'®':      {2048, "M1024 1382Q893 1382 780 1335Q667 1288 573 1194Q479 1100 431 986Q383 871 383 741Q383 612 431 499Q479 385 573 291Q667 197 780 150Q893 102 1024 102Q1156 102 1270 150Q1383 197 1477 291Q1570 384 1617 497Q1663 610 1663 741Q1663 874 1616 988Q1569 1101 1477 1194Q1383 1288 1270 1335Q1156 1382 1024 1382ZM1024 1485Q1176 1485 1308 1430Q1439 1375 1548 1266Q1657 1157 1711 1026Q1765 895 1765 741Q1765 589 1711 459Q1657 328 1548 219Q1439 110 1308 55Q1176 0 1024 0Q872 0 741 55Q609 110 500 219Q391 328 337 459Q283 589 283 741Q283 895 337 1026Q391 1157 500 1266Q609 1375 741 1430Q872 1485 1024 1485ZM997 1071L874 1071L874 795L997 795Q1107 795 1151 826Q1194 857 1194 932Q1194 1008 1150 1040Q1106 1071 997 1071ZM1004 1174Q1180 1174 1267 1115Q1354 1055 1354 934Q1354 848 1302 792Q1249 736 1153 719Q1177 711 1211 673Q1244 634 1290 561L1427 338L1255 338L1126 547Q1067 643 1031 669Q994 694 940 694L874 694L874 338L719 338L719 1174L1004 1174Z"},
+		'¯':      {1024, "M213 1526L811 1526L811 1378L213 1378L213 1526Z"},
+		'°':      {1024, "M512 1391Q432 1391 377 1336Q322 1280 322 1200Q322 1121 377 1067Q432 1012 512 1012Q592 1012 647 1067Q702 1121 702 1200Q702 1279 647 1335Q591 1391 512 1391ZM512 1520Q576 1520 635 1496Q694 1471 737 1425Q783 1380 806 1323Q829 1266 829 1200Q829 1068 737 977Q644 885 510 885Q375 885 285 975Q195 1065 195 1200Q195 1334 287 1427Q379 1520 512 1520Z"},
+		'±':      {1716, "M942 1284L942 897L1499 897L1499 727L942 727L942 340L774 340L774 727L217 727L217 897L774 897L774 1284L942 1284ZM217 170L1499 170L1499 0L217 0L217 170Z"},
+		'²':      {821, "M268 782L692 782L692 668L94 668L94 778Q128 809 191 865Q535 1170 535 1264Q535 1330 483 1371Q431 1411 346 1411Q294 1411 233 1394Q172 1376 100 1341L100 1464Q177 1492 244 1506Q310 1520 367 1520Q512 1520 599 1454Q686 1388 686 1280Q686 1141 355 857Q299 809 268 782Z"},
+		'³':      {821, "M524 1120Q616 1102 667 1048Q717 993 717 911Q717 787 622 720Q527 653 350 653Q293 653 231 664Q168 674 98 694L98 815Q150 788 210 775Q269 762 336 762Q445 762 505 802Q565 841 565 911Q565 985 510 1023Q454 1061 346 1061L260 1061L260 1169L354 1169Q448 1169 497 1201Q545 1232 545 1292Q545 1350 495 1381Q445 1411 350 1411Q310 1411 259 1402Q208 1393 127 1370L127 1485Q200 1502 264 1511Q328 1520 383 1520Q527 1520 612 1461Q696 1402 696 1303Q696 1234 651 1186Q606 1138 524 1120Z"},
+		'´':      {1024, "M651 1638L850 1638L524 1262L371 1262L651 1638Z"},
+		'µ':      {1303, "M174 -426L174 1120L358 1120L358 424Q358 279 427 205Q496 131 631 131Q779 131 854 215Q928 299 928 467L928 1120L1112 1120L1112 258Q1112 198 1130 170Q1147 141 1184 141Q1193 141 1209 147Q1225 152 1253 164L1253 16Q1212 -7 1176 -18Q1139 -29 1104 -29Q1035 -29 994 10Q953 49 938 129Q888 50 816 11Q743 -29 645 -29Q543 -29 472 10Q400 49 358 127L358 -426L174 -426Z"},
+		'¶':      {1303, "M633 1493L1081 1493L1081 -197L940 -197L940 1370L750 1370L750 -197L608 -197L608 649Q393 666 276 777Q158 887 158 1071Q158 1261 288 1377Q418 1493 633 1493Z"},
+		'·':      {651, "M219 838L430 838L430 584L219 584L219 838Z"},
+		'¸':      {1024, "M596 0Q651 -62 678 -115Q705 -167 705 -215Q705 -304 645 -350Q585 -395 467 -395Q421 -395 378 -389Q334 -383 291 -371L291 -240Q325 -257 362 -265Q399 -272 446 -272Q505 -272 535 -248Q565 -224 565 -178Q565 -148 544 -105Q522 -61 477 0L596 0Z"},
+		'¹':      {821, "M156 778L360 778L360 1389L137 1348L137 1464L367 1503L504 1503L504 778L709 778L709 668L156 668L156 778Z"},
+		'º':      {965, "M139 592L827 592L827 469L139 469L139 592ZM483 1520Q662 1520 765 1410Q868 1299 868 1108Q868 917 765 808Q662 698 483 698Q304 698 200 808Q96 918 96 1108Q96 1299 200 1410Q304 1520 483 1520ZM483 1405Q378 1405 315 1325Q252 1244 252 1108Q252 975 316 895Q379 815 483 815Q588 815 651 895Q713 975 713 1108Q713 1245 651 1325Q589 1405 483 1405Z"},
+		'»':      {1253, "M193 1059L662 641L662 559L193 141L193 332L494 600L193 868L193 1059ZM627 1059L1096 641L1096 559L627 141L627 332L928 600L627 868L627 1059Z"},
+		'¼':      {1985, "M156 778L360 778L360 1389L137 1348L137 1464L367 1503L504 1503L504 778L709 778L709 668L156 668L156 778ZM1640 714L1331 295L1640 295L1640 714ZM1618 835L1784 835L1784 295L1919 295L1919 186L1784 186L1784 0L1640 0L1640 186L1226 186L1226 307L1618 835ZM1378 1520L1538 1520L606 -29L446 -29L1378 1520Z"},
+		'½':      {1985, "M156 778L360 778L360 1389L137 1348L137 1464L367 1503L504 1503L504 778L709 778L709 668L156 668L156 778ZM1431 114L1855 114L1855 0L1257 0L1257 110Q1291 141 1354 197Q1698 502 1698 596Q1698 662 1646 703Q1594 743 1509 743Q1457 743 1396 726Q1335 708 1263 673L1263 796Q1340 824 1407 838Q1473 852 1530 852Q1675 852 1762 786Q1849 720 1849 612Q1849 473 1518 189Q1462 141 1431 114ZM1378 1520L1538 1520L606 -29L446 -29L1378 1520Z"},
+		'¾':      {1985, "M524 1120Q616 1102 667 1048Q717 993 717 911Q717 787 622 720Q527 653 350 653Q293 653 231 664Q168 674 98 694L98 815Q150 788 210 775Q269 762 336 762Q445 762 505 802Q565 841 565 911Q565 985 510 1023Q454 1061 346 1061L260 1061L260 1169L354 1169Q448 1169 497 1201Q545 1232 545 1292Q545 1350 495 1381Q445 1411 350 1411Q310 1411 259 1402Q208 1393 127 1370L127 1485Q200 1502 264 1511Q328 1520 383 1520Q527 1520 612 1461Q696 1402 696 1303Q696 1234 651 1186Q606 1138 524 1120ZM1640 714L1331 295L1640 295L1640 714ZM1618 835L1784 835L1784 295L1919 295L1919 186L1784 186L1784 0L1640 0L1640 186L1226 186L1226 307L1618 835ZM1378 1520L1538 1520L606 -29L446 -29L1378 1520Z"},
+		'¿':      {1087, "M500 719L690 719L690 563Q690 462 663 397Q635 332 545 245L455 157Q397 104 372 57Q346 10 346 -39Q346 -128 412 -183Q477 -238 586 -238Q664 -238 754 -203Q844 -168 940 -101L940 -289Q846 -346 750 -374Q654 -402 551 -402Q367 -402 255 -305Q143 -208 143 -49Q143 27 180 96Q216 164 305 250L393 336Q441 383 460 410Q479 436 487 461Q494 482 497 512Q500 542 500 596L500 719ZM696 866L494 866L494 1120L696 1120L696 866Z"},
+		'À':      {1401, "M700 1294L426 551L975 551L700 1294ZM586 1493L815 1493L1384 0L1174 0L1038 383L365 383L229 0L16 0L586 1493ZM643 1899L839 1635L686 1635L456 1899L643 1899Z"},
+		'Á':      {1401, "M700 1294L426 551L975 551L700 1294ZM586 1493L815 1493L1384 0L1174 0L1038 383L365 383L229 0L16 0L586 1493ZM755 1899L940 1899L712 1635L559 1635L755 1899Z"},
+		'Â':      {1401, "M700 1294L426 551L975 551L700 1294ZM586 1493L815 1493L1384 0L1174 0L1038 383L365 383L229 0L16 0L586 1493ZM606 1901L794 1901L1005 1635L866 1635L700 1813L534 1635L395 1635L606 1901Z"},
+		'Ã':      {1401, "M700 1294L426 551L975 551L700 1294ZM586 1493L815 1493L1384 0L1174 0L1038 383L365 383L229 0L16 0L586 1493ZM696 1710L639 1743Q614 1757 599 1763Q583 1768 571 1768Q535 1768 515 1743Q495 1718 495 1673L495 1667L370 1667Q370 1768 422 1827Q473 1886 559 1886Q595 1886 626 1878Q656 1870 704 1843L761 1813Q784 1800 801 1794Q818 1788 833 1788Q865 1788 885 1814Q905 1839 905 1880L905 1886L1030 1886Q1028 1786 977 1727Q925 1667 841 1667Q807 1667 778 1675Q748 1683 696 1710Z"},
+		'Ä':      {1401, "M700 1294L426 551L975 551L700 1294ZM586 1493L815 1493L1384 0L1174 0L1038 383L365 383L229 0L16 0L586 1493ZM794 1870L997 1870L997 1667L794 1667L794 1870ZM403 1870L606 1870L606 1667L403 1667L403 1870Z"},
+		'Å':      {1401, "M852 1626Q852 1689 808 1734Q763 1778 700 1778Q636 1778 593 1735Q549 1691 549 1626Q549 1563 593 1519Q637 1475 700 1475Q763 1475 808 1519Q852 1563 852 1626ZM700 1294L428 551L973 551L700 1294ZM549 1397Q488 1438 457 1496Q426 1553 426 1626Q426 1741 506 1821Q585 1901 700 1901Q814 1901 895 1821Q975 1740 975 1626Q975 1556 944 1497Q912 1438 852 1397L1384 0L1174 0L1038 383L365 383L229 0L16 0L549 1397Z"},
+		'Æ':      {1995, "M1845 1493L1845 1323L1104 1323L1104 881L1815 881L1815 711L1104 711L1104 170L1864 170L1864 0L901 0L901 383L373 383L213 0L8 0L633 1493L1845 1493ZM772 1335L442 551L901 551L901 1335L772 1335Z"},
+		'Ç':      {1430, "M1319 1378L1319 1165Q1217 1260 1102 1307Q986 1354 856 1354Q600 1354 464 1198Q328 1041 328 745Q328 450 464 294Q600 137 856 137Q986 137 1102 184Q1217 231 1319 326L1319 115Q1213 43 1095 7Q976 -29 844 -29Q505 -29 310 179Q115 386 115 745Q115 1105 310 1313Q505 1520 844 1520Q978 1520 1097 1485Q1215 1449 1319 1378ZM897 0Q952 -62 979 -115Q1006 -167 1006 -215Q1006 -304 946 -350Q886 -395 768 -395Q722 -395 679 -389Q635 -383 592 -371L592 -240Q626 -257 663 -265Q700 -272 747 -272Q806 -272 836 -248Q866 -224 866 -178Q866 -148 845 -105Q823 -61 778 0L897 0Z"},
+		'È':      {1294, "M201 1493L1145 1493L1145 1323L403 1323L403 881L1114 881L1114 711L403 711L403 170L1163 170L1163 0L201 0L201 1493ZM613 1899L809 1635L656 1635L426 1899L613 1899Z"},
+		'É':      {1294, "M201 1493L1145 1493L1145 1323L403 1323L403 881L1114 881L1114 711L403 711L403 170L1163 170L1163 0L201 0L201 1493ZM725 1899L910 1899L682 1635L529 1635L725 1899Z"},
+		'Ê':      {1294, "M201 1493L1145 1493L1145 1323L403 1323L403 881L1114 881L1114 711L403 711L403 170L1163 170L1163 0L201 0L201 1493ZM576 1901L764 1901L975 1635L836 1635L670 1813L504 1635L365 1635L576 1901Z"},
+		'Ë':      {1294, "M201 1493L1145 1493L1145 1323L403 1323L403 881L1114 881L1114 711L403 711L403 170L1163 170L1163 0L201 0L201 1493ZM764 1870L967 1870L967 1667L764 1667L764 1870ZM373 1870L576 1870L576 1667L373 1667L373 1870Z"},
+		'Ì':      {604, "M201 1493L403 1493L403 0L201 0L201 1493ZM246 1899L442 1635L289 1635L59 1899L246 1899Z"},
+		'Í':      {604, "M201 1493L403 1493L403 0L201 0L201 1493ZM358 1899L543 1899L315 1635L162 1635L358 1899Z"},
+		'Î':      {604, "M201 1493L403 1493L403 0L201 0L201 1493ZM209 1901L397 1901L608 1635L469 1635L303 1813L137 1635L-2 1635L209 1901Z"},
+		'Ï':      {604, "M201 1493L403 1493L403 0L201 0L201 1493ZM397 1870L600 1870L600 1667L397 1667L397 1870ZM6 1870L209 1870L209 1667L6 1667L6 1870Z"},
+		'Ð':      {1587, "M211 1493L627 1493Q1060 1493 1263 1313Q1466 1132 1466 748Q1466 362 1263 181Q1059 0 627 0L211 0L211 700L10 700L10 844L211 844L211 1493ZM414 1327L414 844L750 844L750 700L414 700L414 166L657 166Q966 166 1110 306Q1253 446 1253 748Q1253 1048 1110 1188Q966 1327 657 1327L414 1327Z"},
+		'Ñ':      {1532, "M201 1493L473 1493L1135 244L1135 1493L1331 1493L1331 0L1059 0L397 1249L397 0L201 0L201 1493ZM762 1710L705 1743Q680 1757 665 1763Q649 1768 637 1768Q601 1768 581 1743Q561 1718 561 1673L561 1667L436 1667Q436 1768 488 1827Q539 1886 625 1886Q661 1886 692 1878Q722 1870 770 1843L827 1813Q850 1800 867 1794Q884 1788 899 1788Q931 1788 951 1814Q971 1839 971 1880L971 1886L1096 1886Q1094 1786 1043 1727Q991 1667 907 1667Q873 1667 844 1675Q814 1683 762 1710Z"},
+		'Ò':      {1612, "M807 1356Q587 1356 458 1192Q328 1028 328 745Q328 463 458 299Q587 135 807 135Q1027 135 1156 299Q1284 463 1284 745Q1284 1028 1156 1192Q1027 1356 807 1356ZM807 1520Q1121 1520 1309 1310Q1497 1099 1497 745Q1497 392 1309 182Q1121 -29 807 -29Q492 -29 304 181Q115 391 115 745Q115 1099 304 1310Q492 1520 807 1520ZM750 1899L946 1635L793 1635L563 1899L750 1899Z"},
+		'Ó':      {1612, "M807 1356Q587 1356 458 1192Q328 1028 328 745Q328 463 458 299Q587 135 807 135Q1027 135 1156 299Q1284 463 1284 745Q1284 1028 1156 1192Q1027 1356 807 1356ZM807 1520Q1121 1520 1309 1310Q1497 1099 1497 745Q1497 392 1309 182Q1121 -29 807 -29Q492 -29 304 181Q115 391 115 745Q115 1099 304 1310Q492 1520 807 1520ZM862 1899L1047 1899L819 1635L666 1635L862 1899Z"},
+		'Ô':      {1612, "M807 1356Q587 1356 458 1192Q328 1028 328 745Q328 463 458 299Q587 135 807 135Q1027 135 1156 299Q1284 463 1284 745Q1284 1028 1156 1192Q1027 1356 807 1356ZM807 1520Q1121 1520 1309 1310Q1497 1099 1497 745Q1497 392 1309 182Q1121 -29 807 -29Q492 -29 304 181Q115 391 115 745Q115 1099 304 1310Q492 1520 807 1520ZM713 1901L901 1901L1112 1635L973 1635L807 1813L641 1635L502 1635L713 1901Z"},
+		'Õ':      {1612, "M807 1356Q587 1356 458 1192Q328 1028 328 745Q328 463 458 299Q587 135 807 135Q1027 135 1156 299Q1284 463 1284 745Q1284 1028 1156 1192Q1027 1356 807 1356ZM807 1520Q1121 1520 1309 1310Q1497 1099 1497 745Q1497 392 1309 182Q1121 -29 807 -29Q492 -29 304 181Q115 391 115 745Q115 1099 304 1310Q492 1520 807 1520ZM803 1710L746 1743Q721 1757 706 1763Q690 1768 678 1768Q642 1768 622 1743Q602 1718 602 1673L602 1667L477 1667Q477 1768 529 1827Q580 1886 666 1886Q702 1886 733 1878Q763 1870 811 1843L868 1813Q891 1800 908 1794Q925 1788 940 1788Q972 1788 992 1814Q1012 1839 1012 1880L1012 1886L1137 1886Q1135 1786 1084 1727Q1032 1667 948 1667Q914 1667 885 1675Q855 1683 803 1710Z"},
+		'Ö':      {1612, "M807 1356Q587 1356 458 1192Q328 1028 328 745Q328 463 458 299Q587 135 807 135Q1027 135 1156 299Q1284 463 1284 745Q1284 1028 1156 1192Q1027 1356 807 1356ZM807 1520Q1121 1520 1309 1310Q1497 1099 1497 745Q1497 392 1309 182Q1121 -29 807 -29Q492 -29 304 181Q115 391 115 745Q115 1099 304 1310Q492 1520 807 1520ZM901 1870L1104 1870L1104 1667L901 1667L901 1870ZM510 1870L713 1870L713 1667L510 1667L510 1870Z"},
+		'×':      {1716, "M1436 1100L979 641L1436 184L1317 63L858 522L399 63L281 184L737 641L281 1100L399 1221L858 762L1317 1221L1436 1100Z"},
+		'Ø':      {1612, "M1206 1112L489 266Q551 202 632 169Q712 135 807 135Q1027 135 1156 299Q1284 463 1284 745Q1284 857 1265 949Q1245 1041 1206 1112ZM1124 1225Q1063 1289 983 1323Q902 1356 807 1356Q587 1356 458 1192Q328 1028 328 745Q328 633 348 539Q367 445 406 377L1124 1225ZM272 219Q194 321 155 453Q115 585 115 745Q115 1099 304 1310Q492 1520 807 1520Q937 1520 1048 1482Q1158 1443 1245 1368L1407 1559L1509 1470L1339 1272Q1417 1169 1457 1036Q1497 903 1497 745Q1497 392 1309 182Q1121 -29 807 -29Q679 -29 569 9Q458 46 367 121L205 -70L102 18L272 219Z"},
+		'Ù':      {1499, "M178 1493L381 1493L381 586Q381 346 468 241Q555 135 750 135Q944 135 1031 241Q1118 346 1118 586L1118 1493L1321 1493L1321 561Q1321 269 1177 120Q1032 -29 750 -29Q467 -29 323 120Q178 269 178 561L178 1493ZM693 1899L889 1635L736 1635L506 1899L693 1899Z"},
+		'Ú':      {1499, "M178 1493L381 1493L381 586Q381 346 468 241Q555 135 750 135Q944 135 1031 241Q1118 346 1118 586L1118 1493L1321 1493L1321 561Q1321 269 1177 120Q1032 -29 750 -29Q467 -29 323 120Q178 269 178 561L178 1493ZM805 1899L990 1899L762 1635L609 1635L805 1899Z"},
+		'Û':      {1499, "M178 1493L381 1493L381 586Q381 346 468 241Q555 135 750 135Q944 135 1031 241Q1118 346 1118 586L1118 1493L1321 1493L1321 561Q1321 269 1177 120Q1032 -29 750 -29Q467 -29 323 120Q178 269 178 561L178 1493ZM656 1901L844 1901L1055 1635L916 1635L750 1813L584 1635L445 1635L656 1901Z"},
+		'Ü':      {1499, "M178 1493L381 1493L381 586Q381 346 468 241Q555 135 750 135Q944 135 1031 241Q1118 346 1118 586L1118 1493L1321 1493L1321 561Q1321 269 1177 120Q1032 -29 750 -29Q467 -29 323 120Q178 269 178 561L178 1493ZM844 1870L1047 1870L1047 1667L844 1667L844 1870ZM453 1870L656 1870L656 1667L453 1667L453 1870Z"},
+		'Ý':      {1251, "M-4 1493L213 1493L627 879L1038 1493L1255 1493L727 711L727 0L524 0L524 711L-4 1493ZM682 1899L867 1899L639 1635L486 1635L682 1899Z"},
+		'Þ':      {1239, "M201 1493L403 1493L403 1229L657 1229Q908 1229 1037 1117Q1165 1004 1165 784Q1165 564 1037 451Q908 338 657 338L403 338L403 0L201 0L201 1493ZM403 1063L403 504L657 504Q798 504 875 577Q952 650 952 784Q952 918 876 991Q799 1063 657 1063L403 1063Z"},
+		'ß':      {1290, "M186 1137Q186 1337 306 1447Q425 1556 643 1556Q851 1556 961 1440Q1070 1324 1073 1100Q922 1092 838 1035Q754 977 754 881Q754 834 783 794Q812 753 877 711L934 674Q1100 568 1148 497Q1196 426 1196 326Q1196 154 1084 63Q971 -29 760 -29Q696 -29 628 -17Q560 -4 487 20L487 184Q567 154 637 140Q707 125 772 125Q888 125 948 173Q1008 220 1008 311Q1008 374 979 416Q949 458 848 520L756 575Q660 634 617 702Q573 769 573 860Q573 987 657 1073Q740 1159 891 1188Q883 1291 818 1347Q752 1403 639 1403Q509 1403 441 1334Q373 1264 373 1133L373 0L186 0L186 1137Z"},
+		'à':      {1255, "M702 563Q479 563 393 512Q307 461 307 338Q307 240 372 183Q436 125 547 125Q700 125 793 234Q885 342 885 522L885 563L702 563ZM1069 639L1069 0L885 0L885 170Q822 68 728 20Q634 -29 498 -29Q326 -29 225 68Q123 164 123 326Q123 515 250 611Q376 707 627 707L885 707L885 725Q885 852 802 922Q718 991 567 991Q471 991 380 968Q289 945 205 899L205 1069Q306 1108 401 1128Q496 1147 586 1147Q829 1147 949 1021Q1069 895 1069 639ZM449 1638L731 1264L578 1264L252 1638L449 1638Z"},
+		'á':      {1255, "M702 563Q479 563 393 512Q307 461 307 338Q307 240 372 183Q436 125 547 125Q700 125 793 234Q885 342 885 522L885 563L702 563ZM1069 639L1069 0L885 0L885 170Q822 68 728 20Q634 -29 498 -29Q326 -29 225 68Q123 164 123 326Q123 515 250 611Q376 707 627 707L885 707L885 725Q885 852 802 922Q718 991 567 991Q471 991 380 968Q289 945 205 899L205 1069Q306 1108 401 1128Q496 1147 586 1147Q829 1147 949 1021Q1069 895 1069 639ZM733 1638L932 1638L606 1262L453 1262L733 1638Z"},
+		'â':      {1255, "M702 563Q479 563 393 512Q307 461 307 338Q307 240 372 183Q436 125 547 125Q700 125 793 234Q885 342 885 522L885 563L702 563ZM1069 639L1069 0L885 0L885 170Q822 68 728 20Q634 -29 498 -29Q326 -29 225 68Q123 164 123 326Q123 515 250 611Q376 707 627 707L885 707L885 725Q885 852 802 922Q718 991 567 991Q471 991 380 968Q289 945 205 899L205 1069Q306 1108 401 1128Q496 1147 586 1147Q829 1147 949 1021Q1069 895 1069 639ZM520 1638L668 1638L913 1262L774 1262L594 1507L414 1262L275 1262L520 1638Z"},
+		'ã':      {1255, "M702 563Q479 563 393 512Q307 461 307 338Q307 240 372 183Q436 125 547 125Q700 125 793 234Q885 342 885 522L885 563L702 563ZM1069 639L1069 0L885 0L885 170Q822 68 728 20Q634 -29 498 -29Q326 -29 225 68Q123 164 123 326Q123 515 250 611Q376 707 627 707L885 707L885 725Q885 852 802 922Q718 991 567 991Q471 991 380 968Q289 945 205 899L205 1069Q306 1108 401 1128Q496 1147 586 1147Q829 1147 949 1021Q1069 895 1069 639ZM590 1370L533 1425Q511 1445 495 1455Q478 1464 465 1464Q427 1464 409 1428Q391 1391 389 1309L264 1309Q266 1444 317 1518Q368 1591 459 1591Q497 1591 529 1577Q561 1563 598 1530L655 1475Q677 1455 694 1446Q710 1436 723 1436Q761 1436 779 1473Q797 1509 799 1591L924 1591Q922 1456 871 1383Q820 1309 729 1309Q691 1309 659 1323Q627 1337 590 1370Z"},
+		'ä':      {1255, "M702 563Q479 563 393 512Q307 461 307 338Q307 240 372 183Q436 125 547 125Q700 125 793 234Q885 342 885 522L885 563L702 563ZM1069 639L1069 0L885 0L885 170Q822 68 728 20Q634 -29 498 -29Q326 -29 225 68Q123 164 123 326Q123 515 250 611Q376 707 627 707L885 707L885 725Q885 852 802 922Q718 991 567 991Q471 991 380 968Q289 945 205 899L205 1069Q306 1108 401 1128Q496 1147 586 1147Q829 1147 949 1021Q1069 895 1069 639ZM688 1552L891 1552L891 1350L688 1350L688 1552ZM297 1552L500 1552L500 1350L297 1350L297 1552Z"},
+		'å':      {1255, "M702 563Q479 563 393 512Q307 461 307 338Q307 240 372 183Q436 125 547 125Q700 125 793 234Q885 342 885 522L885 563L702 563ZM1069 639L1069 0L885 0L885 170Q822 68 728 20Q634 -29 498 -29Q326 -29 225 68Q123 164 123 326Q123 515 250 611Q376 707 627 707L885 707L885 725Q885 852 802 922Q718 991 567 991Q471 991 380 968Q289 945 205 899L205 1069Q306 1108 401 1128Q496 1147 586 1147Q829 1147 949 1021Q1069 895 1069 639ZM746 1524Q746 1587 702 1631Q658 1675 594 1675Q529 1675 486 1632Q442 1588 442 1524Q442 1459 486 1416Q529 1372 594 1372Q658 1372 702 1416Q746 1460 746 1524ZM868 1524Q868 1409 789 1329Q709 1249 594 1249Q479 1249 400 1329Q320 1409 320 1524Q320 1639 400 1719Q479 1798 594 1798Q709 1798 789 1719Q868 1639 868 1524Z"},
+		'æ':      {2011, "M1718 660Q1717 811 1635 901Q1552 991 1415 991Q1262 991 1170 904Q1077 817 1063 659L1718 660ZM995 963Q1069 1053 1175 1100Q1281 1147 1413 1147Q1639 1147 1771 1002Q1903 856 1903 606L1903 516L1057 516Q1069 325 1171 225Q1273 125 1456 125Q1560 125 1660 152Q1760 178 1860 231L1860 57Q1760 15 1656 -7Q1552 -29 1446 -29Q1279 -29 1155 32Q1031 92 954 211Q881 91 773 31Q665 -29 522 -29Q333 -29 228 65Q123 158 123 326Q123 515 250 611Q376 707 627 707L885 707L885 725Q885 852 802 922Q718 991 567 991Q471 991 380 968Q289 945 205 899L205 1069Q306 1108 401 1128Q496 1147 586 1147Q728 1147 835 1099Q941 1051 995 963ZM702 563Q479 563 393 512Q307 461 307 338Q307 240 372 183Q436 125 547 125Q700 125 793 234Q885 342 885 522L885 563L702 563Z"},
+		'ç':      {1126, "M999 1077L999 905Q921 948 843 970Q764 991 684 991Q505 991 406 878Q307 764 307 559Q307 354 406 241Q505 127 684 127Q764 127 843 149Q921 170 999 213L999 43Q922 7 840 -11Q757 -29 664 -29Q411 -29 262 130Q113 289 113 559Q113 833 264 990Q414 1147 676 1147Q761 1147 842 1130Q923 1112 999 1077ZM739 0Q794 -62 821 -115Q848 -167 848 -215Q848 -304 788 -350Q728 -395 610 -395Q564 -395 521 -389Q477 -383 434 -371L434 -240Q468 -257 505 -265Q542 -272 589 -272Q648 -272 678 -248Q708 -224 708 -178Q708 -148 687 -105Q665 -61 620 0L739 0Z"},
+		'è':      {1260, "M1151 606L1151 516L305 516Q317 326 420 227Q522 127 705 127Q811 127 911 153Q1010 179 1108 231L1108 57Q1009 15 905 -7Q801 -29 694 -29Q426 -29 270 127Q113 283 113 549Q113 824 262 986Q410 1147 662 1147Q888 1147 1020 1002Q1151 856 1151 606ZM967 660Q965 811 883 901Q800 991 664 991Q510 991 418 904Q325 817 311 659L967 660ZM506 1638L788 1264L635 1264L309 1638L506 1638Z"},
+		'é':      {1260, "M1151 606L1151 516L305 516Q317 326 420 227Q522 127 705 127Q811 127 911 153Q1010 179 1108 231L1108 57Q1009 15 905 -7Q801 -29 694 -29Q426 -29 270 127Q113 283 113 549Q113 824 262 986Q410 1147 662 1147Q888 1147 1020 1002Q1151 856 1151 606ZM967 660Q965 811 883 901Q800 991 664 991Q510 991 418 904Q325 817 311 659L967 660ZM790 1638L989 1638L663 1262L510 1262L790 1638Z"},
+		'ê':      {1260, "M1151 606L1151 516L305 516Q317 326 420 227Q522 127 705 127Q811 127 911 153Q1010 179 1108 231L1108 57Q1009 15 905 -7Q801 -29 694 -29Q426 -29 270 127Q113 283 113 549Q113 824 262 986Q410 1147 662 1147Q888 1147 1020 1002Q1151 856 1151 606ZM967 660Q965 811 883 901Q800 991 664 991Q510 991 418 904Q325 817 311 659L967 660ZM577 1638L725 1638L970 1262L831 1262L651 1507L471 1262L332 1262L577 1638Z"},
+		'ë':      {1260, "M1151 606L1151 516L305 516Q317 326 420 227Q522 127 705 127Q811 127 911 153Q1010 179 1108 231L1108 57Q1009 15 905 -7Q801 -29 694 -29Q426 -29 270 127Q113 283 113 549Q113 824 262 986Q410 1147 662 1147Q888 1147 1020 1002Q1151 856 1151 606ZM967 660Q965 811 883 901Q800 991 664 991Q510 991 418 904Q325 817 311 659L967 660ZM745 1552L948 1552L948 1350L745 1350L745 1552ZM354 1552L557 1552L557 1350L354 1350L354 1552Z"},
+		'ì':      {569, "M140 1638L422 1264L269 1264L-57 1638L140 1638ZM193 1120L377 1120L377 0L193 0L193 1120ZM285 1147L285 1147Z"},
+		'í':      {569, "M424 1638L623 1638L297 1262L144 1262L424 1638ZM193 1120L377 1120L377 0L193 0L193 1120ZM285 1147L285 1147Z"},
+		'î':      {569, "M193 1120L377 1120L377 0L193 0L193 1120ZM285 1147L285 1147ZM211 1638L359 1638L604 1262L465 1262L285 1507L105 1262L-34 1262L211 1638Z"},
+		'ï':      {569, "M193 1120L377 1120L377 0L193 0L193 1120ZM285 1147L285 1147ZM379 1552L582 1552L582 1350L379 1350L379 1552ZM-12 1552L191 1552L191 1350L-12 1350L-12 1552Z"},
+		'ð':      {1253, "M838 915Q788 932 744 940Q700 948 659 948Q492 948 400 840Q307 732 307 537Q307 349 394 238Q481 127 627 127Q772 127 859 238Q946 349 946 537Q946 659 919 753Q892 847 838 915ZM901 1141Q1027 998 1084 854Q1141 710 1141 537Q1141 282 999 127Q857 -29 627 -29Q396 -29 255 127Q113 282 113 537Q113 787 251 943Q389 1098 610 1098Q628 1098 654 1096Q680 1093 722 1088L563 1268L244 1161L211 1260L492 1352L311 1556L539 1556L666 1411L999 1522L1032 1425L737 1327L901 1141Z"},
+		'ñ':      {1298, "M1124 676L1124 0L940 0L940 670Q940 829 878 908Q816 987 692 987Q543 987 457 892Q371 797 371 633L371 0L186 0L186 1120L371 1120L371 946Q437 1047 527 1097Q616 1147 733 1147Q926 1147 1025 1028Q1124 908 1124 676ZM660 1370L603 1425Q581 1445 565 1455Q548 1464 535 1464Q497 1464 479 1428Q461 1391 459 1309L334 1309Q336 1444 387 1518Q438 1591 529 1591Q567 1591 599 1577Q631 1563 668 1530L725 1475Q747 1455 764 1446Q780 1436 793 1436Q831 1436 849 1473Q867 1509 869 1591L994 1591Q992 1456 941 1383Q890 1309 799 1309Q761 1309 729 1323Q697 1337 660 1370Z"},
+		'ò':      {1253, "M627 991Q479 991 393 876Q307 760 307 559Q307 358 393 243Q478 127 627 127Q774 127 860 243Q946 359 946 559Q946 758 860 875Q774 991 627 991ZM627 1147Q867 1147 1004 991Q1141 835 1141 559Q1141 284 1004 128Q867 -29 627 -29Q386 -29 250 128Q113 284 113 559Q113 835 250 991Q386 1147 627 1147ZM482 1638L764 1264L611 1264L285 1638L482 1638Z"},
+		'ó':      {1253, "M627 991Q479 991 393 876Q307 760 307 559Q307 358 393 243Q478 127 627 127Q774 127 860 243Q946 359 946 559Q946 758 860 875Q774 991 627 991ZM627 1147Q867 1147 1004 991Q1141 835 1141 559Q1141 284 1004 128Q867 -29 627 -29Q386 -29 250 128Q113 284 113 559Q113 835 250 991Q386 1147 627 1147ZM766 1638L965 1638L639 1262L486 1262L766 1638Z"},
+		'ô':      {1253, "M627 991Q479 991 393 876Q307 760 307 559Q307 358 393 243Q478 127 627 127Q774 127 860 243Q946 359 946 559Q946 758 860 875Q774 991 627 991ZM627 1147Q867 1147 1004 991Q1141 835 1141 559Q1141 284 1004 128Q867 -29 627 -29Q386 -29 250 128Q113 284 113 559Q113 835 250 991Q386 1147 627 1147ZM553 1638L701 1638L946 1262L807 1262L627 1507L447 1262L308 1262L553 1638Z"},
+		'õ':      {1253, "M627 991Q479 991 393 876Q307 760 307 559Q307 358 393 243Q478 127 627 127Q774 127 860 243Q946 359 946 559Q946 758 860 875Q774 991 627 991ZM627 1147Q867 1147 1004 991Q1141 835 1141 559Q1141 284 1004 128Q867 -29 627 -29Q386 -29 250 128Q113 284 113 559Q113 835 250 991Q386 1147 627 1147ZM623 1370L566 1425Q544 1445 528 1455Q511 1464 498 1464Q460 1464 442 1428Q424 1391 422 1309L297 1309Q299 1444 350 1518Q401 1591 492 1591Q530 1591 562 1577Q594 1563 631 1530L688 1475Q710 1455 727 1446Q743 1436 756 1436Q794 1436 812 1473Q830 1509 832 1591L957 1591Q955 1456 904 1383Q853 1309 762 1309Q724 1309 692 1323Q660 1337 623 1370Z"},
+		'ö':      {1253, "M627 991Q479 991 393 876Q307 760 307 559Q307 358 393 243Q478 127 627 127Q774 127 860 243Q946 359 946 559Q946 758 860 875Q774 991 627 991ZM627 1147Q867 1147 1004 991Q1141 835 1141 559Q1141 284 1004 128Q867 -29 627 -29Q386 -29 250 128Q113 284 113 559Q113 835 250 991Q386 1147 627 1147ZM721 1552L924 1552L924 1350L721 1350L721 1552ZM330 1552L533 1552L533 1350L330 1350L330 1552Z"},
+		'÷':      {1716, "M735 1135L981 1135L981 889L735 889L735 1135ZM735 395L981 395L981 150L735 150L735 395ZM217 727L1499 727L1499 557L217 557L217 727Z"},
+		'ø':      {1253, "M905 801L418 209Q459 167 511 147Q562 127 627 127Q774 127 860 243Q946 359 946 559Q946 638 936 697Q926 755 905 801ZM834 909Q792 950 741 971Q689 991 627 991Q476 991 392 874Q307 756 307 545Q307 473 317 418Q326 363 346 317L834 909ZM221 166Q167 243 140 342Q113 440 113 559Q113 835 250 991Q386 1147 627 1147Q720 1147 800 1122Q879 1096 946 1044L1085 1212L1180 1133L1034 954Q1087 877 1114 778Q1141 679 1141 559Q1141 284 1004 128Q867 -29 627 -29Q531 -29 451 -3Q370 23 307 74L168 -94L72 -16L221 166Z"},
+		'ù':      {1298, "M174 442L174 1120L358 1120L358 449Q358 290 420 211Q482 131 606 131Q755 131 842 226Q928 321 928 485L928 1120L1112 1120L1112 0L928 0L928 172Q861 70 773 21Q684 -29 567 -29Q374 -29 274 91Q174 211 174 442ZM637 1147L637 1147ZM490 1638L772 1264L619 1264L293 1638L490 1638Z"},
+		'ú':      {1298, "M174 442L174 1120L358 1120L358 449Q358 290 420 211Q482 131 606 131Q755 131 842 226Q928 321 928 485L928 1120L1112 1120L1112 0L928 0L928 172Q861 70 773 21Q684 -29 567 -29Q374 -29 274 91Q174 211 174 442ZM637 1147L637 1147ZM774 1638L973 1638L647 1262L494 1262L774 1638Z"},
+		'û':      {1298, "M174 442L174 1120L358 1120L358 449Q358 290 420 211Q482 131 606 131Q755 131 842 226Q928 321 928 485L928 1120L1112 1120L1112 0L928 0L928 172Q861 70 773 21Q684 -29 567 -29Q374 -29 274 91Q174 211 174 442ZM637 1147L637 1147ZM561 1638L709 1638L954 1262L815 1262L635 1507L455 1262L316 1262L561 1638Z"},
+		'ü':      {1298, "M174 442L174 1120L358 1120L358 449Q358 290 420 211Q482 131 606 131Q755 131 842 226Q928 321 928 485L928 1120L1112 1120L1112 0L928 0L928 172Q861 70 773 21Q684 -29 567 -29Q374 -29 274 91Q174 211 174 442ZM637 1147L637 1147ZM729 1552L932 1552L932 1350L729 1350L729 1552ZM338 1552L541 1552L541 1350L338 1350L338 1552Z"},
+		'ý':      {1212, "M659 -104Q581 -304 507 -365Q433 -426 309 -426L162 -426L162 -272L270 -272Q346 -272 388 -236Q430 -200 481 -66L514 18L61 1120L256 1120L606 244L956 1120L1151 1120L659 -104ZM745 1638L944 1638L618 1262L465 1262L745 1638Z"},
+		'þ':      {1300, "M371 168L371 -426L186 -426L186 1556L371 1556L371 950Q429 1050 518 1099Q606 1147 729 1147Q933 1147 1061 985Q1188 823 1188 559Q1188 295 1061 133Q933 -29 729 -29Q606 -29 518 20Q429 68 371 168ZM997 559Q997 762 914 878Q830 993 684 993Q538 993 455 878Q371 762 371 559Q371 356 455 241Q538 125 684 125Q830 125 914 241Q997 356 997 559Z"},
+		'ÿ':      {1212, "M659 -104Q581 -304 507 -365Q433 -426 309 -426L162 -426L162 -272L270 -272Q346 -272 388 -236Q430 -200 481 -66L514 18L61 1120L256 1120L606 244L956 1120L1151 1120L659 -104ZM700 1552L903 1552L903 1350L700 1350L700 1552ZM309 1552L512 1552L512 1350L309 1350L309 1552Z"},
+		'–':      {1024, "M100 633L924 633L924 489L100 489L100 633Z"},
+		'—':      {2048, "M100 633L1948 633L1948 489L100 489L100 633Z"},
+		'‘':      {651, "M385 1001L174 1001L174 1174L338 1493L467 1493L385 1174L385 1001Z"},
+		'’':      {651, "M260 1493L471 1493L471 1341L307 1022L178 1022L260 1341L260 1493Z"},
+		'“':      {1061, "M385 1001L174 1001L174 1174L338 1493L467 1493L385 1174L385 1001ZM795 1001L584 1001L584 1174L748 1493L877 1493L795 1174L795 1001Z"},
+		'”':      {1061, "M256 1493L467 1493L467 1321L303 1001L174 1001L256 1321L256 1493ZM666 1493L877 1493L877 1321L713 1001L584 1001L666 1321L666 1493Z"},
+		'•':      {1208, "M307 762Q307 886 394 972Q480 1057 606 1057Q730 1057 816 972Q901 886 901 762Q901 637 815 551Q729 465 604 465Q479 465 393 551Q307 637 307 762Z"},
+		'…':      {2048, "M918 254L1130 254L1130 0L918 0L918 254ZM1599 254L1812 254L1812 0L1599 0L1599 254ZM236 254L449 254L449 0L236 0L236 254Z"},
+		'→':      {1716, "M1616 687L1616 597L1223 204L1103 324L1336 557L117 557L117 727L1336 727L1103 960L1223 1080L1616 687Z"},
+		'←':      {1716, "M100 597L100 687L493 1080L613 960L380 727L1599 727L1599 557L380 557L613 324L493 204L100 597Z"},
+		'↑':      {1716, "M813 1500L903 1500L1295 1106L1175 986L943 1220L943 0L773 0L773 1220L539 986L419 1106L813 1500Z"},
+		'↓':      {1716, "M903 -7L813 -7L419 387L539 507L773 273L773 1493L943 1493L943 273L1175 507L1295 387L903 -7Z"},
+		'↔':      {1716, "M100 597L100 687L493 1080L613 960L380 727L1336 727L1103 960L1223 1080L1616 687L1616 597L1223 204L1103 324L1336 557L380 557L613 324L493 204L100 597Z"},
+		'⇒':      {1716, "M1316 537L1421 642L1316 747L117 747L117 867L1196 867L1103 960L1223 1080L1616 687L1616 597L1223 204L1103 324L1196 417L117 417L117 537L1316 537Z"},
+		'✓':      {1716, "M453 654Q492 654 512 590Q552 470 569 470Q582 470 596 490Q877 940 1116 1218Q1178 1290 1313 1290Q1345 1290 1356 1284Q1367 1278 1367 1269Q1367 1255 1334 1214Q948 750 618 234Q595 198 524 198Q452 198 439 204Q405 219 359 357Q307 510 307 549Q307 591 377 630Q420 654 453 654Z"},
+		'✗':      {1716, "M1272 1500Q1282 1500 1298 1484Q1315 1468 1333 1442Q1362 1493 1379 1493Q1394 1493 1420 1466Q1436 1449 1436 1428Q1436 1401 1414 1378Q1206 1152 1010 881Q1091 683 1234 444Q1246 424 1246 412Q1246 389 1216 364Q1216 364 1184 342Q1188 330 1188 310Q1188 280 1166 264Q1140 246 1128 246Q1102 246 1082 272Q962 426 834 634Q662 410 440 36Q408 -18 332 -18Q296 -18 292 24Q241 52 241 113Q241 193 252 224Q257 238 284 280Q488 598 716 864Q594 1154 554 1311Q545 1346 545 1356Q545 1370 563 1396Q582 1422 598 1422Q613 1422 636 1393Q645 1410 658 1422Q676 1438 704 1438Q736 1438 746 1408Q800 1240 888 1088Q1030 1272 1234 1476Q1258 1500 1272 1500Z"},
+	},
+}
+
+var boldFace = face{
+	unitsPerEm: 2048,
+	ascent:     1901,
+	descent:    -483,
+	glyphs: map[rune]glyph{
+		' ':      {713, ""},
+		'!':      {934, "M287 1493L647 1493L647 920L596 502L338 502L287 920L287 1493ZM287 356L647 356L647 0L287 0L287 356Z"},
+		'"':      {1067, "M872 1493L872 938L635 938L635 1493L872 1493ZM432 1493L432 938L195 938L195 1493L432 1493Z"},
+		'#':      {1716, "M911 1470L815 1085L1079 1085L1176 1470L1397 1470L1300 1085L1577 1085L1577 872L1247 872L1178 598L1462 598L1462 383L1126 383L1030 0L809 0L905 383L641 383L545 0L322 0L418 383L139 383L139 598L467 598L537 872L254 872L254 1085L592 1085L688 1470L911 1470ZM1024 872L760 872L690 598L954 598L1024 872Z"},
+		'$':      {1425, "M795 -301L633 -301L632 0Q507 5 390 28Q273 51 162 92L162 354Q277 295 395 264Q512 232 633 228L633 539L600 545Q361 587 261 677Q160 767 160 936Q160 1115 283 1216Q405 1316 632 1325L633 1556L795 1556L795 1329Q895 1321 995 1304Q1095 1287 1196 1260L1196 1006Q1096 1048 996 1072Q896 1095 795 1100L795 813L827 807Q1081 767 1184 674Q1286 580 1286 397Q1286 213 1164 115Q1042 16 795 2L795 -301ZM633 836L633 1097Q562 1093 520 1059Q477 1024 477 971Q477 912 516 879Q555 845 633 836ZM795 510L795 232Q882 233 926 266Q969 299 969 365Q969 433 929 467Q889 500 795 510Z"},
+		'%':      {2052, "M1587 616Q1516 616 1477 555Q1438 493 1438 379Q1438 264 1477 203Q1515 141 1587 141Q1659 141 1697 203Q1735 264 1735 379Q1735 493 1697 555Q1658 616 1587 616ZM1587 784Q1773 784 1880 676Q1987 568 1987 379Q1987 190 1880 81Q1773 -29 1587 -29Q1401 -29 1294 81Q1186 190 1186 379Q1186 567 1294 676Q1401 784 1587 784ZM670 -29L449 -29L1382 1520L1604 1520L670 -29ZM465 1520Q651 1520 758 1412Q864 1303 864 1114Q864 925 758 816Q651 707 465 707Q279 707 173 816Q66 925 66 1114Q66 1303 173 1412Q279 1520 465 1520ZM465 1352Q393 1352 354 1290Q315 1228 315 1114Q315 999 354 937Q393 874 465 874Q537 874 576 937Q614 999 614 1114Q614 1228 575 1290Q536 1352 465 1352Z"},
+		'&':      {1786, "M799 991L1208 541Q1261 611 1289 698Q1316 785 1321 895L1632 895Q1617 713 1562 571Q1506 429 1407 322L1700 0L1276 0L1178 109Q1073 39 957 5Q841 -29 711 -29Q448 -29 286 110Q123 248 123 467Q123 613 195 726Q266 838 428 944Q386 997 366 1050Q346 1103 346 1161Q346 1324 473 1422Q600 1520 811 1520Q902 1520 1001 1506Q1099 1491 1206 1462L1206 1184Q1112 1232 1028 1255Q944 1278 864 1278Q787 1278 745 1249Q702 1219 702 1165Q702 1131 727 1088Q751 1044 799 991ZM600 743Q535 696 502 637Q469 577 469 506Q469 391 554 310Q639 229 758 229Q825 229 883 250Q941 270 991 311L600 743Z"},
+		'\'':     {627, "M432 1493L432 938L195 938L195 1493L432 1493Z"},
+		'(':      {936, "M772 -270L475 -270Q322 -23 249 200Q176 422 176 641Q176 860 250 1085Q323 1309 475 1554L772 1554Q644 1317 580 1091Q516 864 516 643Q516 422 580 195Q643 -32 772 -270Z"},
+		')':      {936, "M164 -270Q292 -32 356 195Q420 422 420 643Q420 864 356 1091Q292 1317 164 1554L461 1554Q613 1309 687 1085Q760 860 760 641Q760 422 687 200Q614 -23 461 -270L164 -270Z"},
+		'*':      {1071, "M1030 1217L700 1044L1030 870L954 729L621 913L621 569L451 569L451 913L117 729L41 870L375 1044L41 1217L117 1358L451 1176L451 1520L621 1520L621 1176L954 1358L1030 1217Z"},
+		'+':      {1716, "M977 1284L977 760L1499 760L1499 524L977 524L977 0L739 0L739 524L217 524L217 760L739 760L739 1284L977 1284Z"},
+		',':      {778, "M209 387L569 387L569 82L322 -291L109 -291L209 82L209 387Z"},
+		'-':      {850, "M111 735L739 735L739 444L111 444L111 735Z"},
+		'.':      {778, "M209 387L569 387L569 0L209 0L209 387Z"},
+		'/':      {748, "M526 1493L748 1493L221 -190L0 -190L526 1493Z"},
+		'0':      {1425, "M942 748Q942 1028 890 1143Q837 1257 713 1257Q589 1257 536 1143Q483 1028 483 748Q483 465 536 349Q589 233 713 233Q836 233 889 349Q942 465 942 748ZM1327 745Q1327 374 1167 173Q1007 -29 713 -29Q418 -29 258 173Q98 374 98 745Q98 1117 258 1319Q418 1520 713 1520Q1007 1520 1167 1319Q1327 1117 1327 745Z"},
+		'1':      {1425, "M240 266L580 266L580 1231L231 1159L231 1421L578 1493L944 1493L944 266L1284 266L1284 0L240 0L240 266Z"},
+		'2':      {1425, "M590 283L1247 283L1247 0L162 0L162 283L707 764Q780 830 815 893Q850 956 850 1024Q850 1129 780 1193Q709 1257 592 1257Q502 1257 395 1219Q288 1180 166 1104L166 1432Q296 1475 423 1498Q550 1520 672 1520Q940 1520 1089 1402Q1237 1284 1237 1073Q1237 951 1174 846Q1111 740 909 563L590 283Z"},
+		'3':      {1425, "M954 805Q1105 766 1184 670Q1262 573 1262 424Q1262 202 1092 87Q922 -29 596 -29Q481 -29 366 -11Q250 8 137 45L137 342Q245 288 352 261Q458 233 561 233Q714 233 796 286Q877 339 877 438Q877 540 794 593Q710 645 547 645L393 645L393 893L555 893Q700 893 771 939Q842 984 842 1077Q842 1163 773 1210Q704 1257 578 1257Q485 1257 390 1236Q295 1215 201 1174L201 1456Q315 1488 427 1504Q539 1520 647 1520Q938 1520 1083 1425Q1227 1329 1227 1137Q1227 1006 1158 923Q1089 839 954 805Z"},
+		'4':      {1425, "M754 1176L332 551L754 551L754 1176ZM690 1493L1118 1493L1118 551L1331 551L1331 272L1118 272L1118 0L754 0L754 272L92 272L92 602L690 1493Z"},
+		'5':      {1425, "M217 1493L1174 1493L1174 1210L524 1210L524 979Q568 991 613 998Q657 1004 705 1004Q978 1004 1130 868Q1282 731 1282 487Q1282 245 1117 108Q951 -29 657 -29Q530 -29 406 -5Q281 20 158 70L158 373Q280 303 390 268Q499 233 596 233Q736 233 817 302Q897 370 897 487Q897 605 817 673Q736 741 596 741Q513 741 419 720Q325 698 217 653L217 1493Z"},
+		'6':      {1425, "M741 737Q640 737 590 672Q539 606 539 475Q539 344 590 279Q640 213 741 213Q843 213 894 279Q944 344 944 475Q944 606 894 672Q843 737 741 737ZM1217 1454L1217 1178Q1122 1223 1038 1245Q954 1266 874 1266Q702 1266 606 1171Q510 1075 494 887Q560 936 637 961Q714 985 805 985Q1034 985 1175 851Q1315 717 1315 500Q1315 260 1158 116Q1001 -29 737 -29Q446 -29 287 168Q127 364 127 725Q127 1095 314 1307Q500 1518 825 1518Q928 1518 1025 1502Q1122 1486 1217 1454Z"},
+		'7':      {1425, "M137 1493L1262 1493L1262 1276L680 0L305 0L856 1210L137 1210L137 1493Z"},
+		'8':      {1425, "M713 668Q605 668 547 609Q489 550 489 440Q489 330 547 272Q605 213 713 213Q820 213 877 272Q934 330 934 440Q934 551 877 610Q820 668 713 668ZM432 795Q296 836 227 921Q158 1006 158 1133Q158 1322 299 1421Q440 1520 713 1520Q984 1520 1125 1422Q1266 1323 1266 1133Q1266 1006 1197 921Q1127 836 991 795Q1143 753 1221 659Q1298 564 1298 420Q1298 198 1151 85Q1003 -29 713 -29Q422 -29 274 85Q125 198 125 420Q125 564 203 659Q280 753 432 795ZM522 1094Q522 1005 572 957Q621 909 713 909Q803 909 852 957Q901 1005 901 1094Q901 1183 852 1231Q803 1278 713 1278Q621 1278 572 1230Q522 1182 522 1094Z"},
+		'9':      {1425, "M205 33L205 309Q297 266 381 245Q465 223 547 223Q719 223 815 319Q911 414 928 602Q860 552 783 527Q706 502 616 502Q387 502 247 636Q106 769 106 987Q106 1228 263 1373Q419 1518 682 1518Q974 1518 1134 1321Q1294 1124 1294 764Q1294 394 1107 183Q920 -29 594 -29Q489 -29 393 -14Q297 2 205 33ZM680 752Q781 752 832 818Q883 883 883 1014Q883 1144 832 1210Q781 1276 680 1276Q579 1276 528 1210Q477 1144 477 1014Q477 883 528 818Q579 752 680 752Z"},
+		':':      {819, "M229 1120L590 1120L590 733L229 733L229 1120ZM229 387L590 387L590 0L229 0L229 387Z"},
+		';':      {819, "M229 387L590 387L590 82L342 -291L129 -291L229 82L229 387ZM229 1120L590 1120L590 733L229 733L229 1120Z"},
+		'<':      {1716, "M1499 973L535 641L1499 311L1499 61L217 524L217 760L1499 1223L1499 973Z"},
+		'=':      {1716, "M217 987L1499 987L1499 752L217 752L217 987ZM217 532L1499 532L1499 295L217 295L217 532Z"},
+		'>':      {1716, "M217 973L217 1223L1499 760L1499 524L217 61L217 311L1182 641L217 973Z"},
+		'?':      {1188, "M709 504L348 504L348 553Q348 635 381 699Q414 762 520 860L584 918Q641 970 668 1016Q694 1062 694 1108Q694 1178 646 1218Q598 1257 512 1257Q431 1257 337 1224Q243 1190 141 1124L141 1438Q262 1480 362 1500Q462 1520 555 1520Q799 1520 927 1421Q1055 1321 1055 1130Q1055 1032 1016 955Q977 877 883 788L819 731Q751 669 730 632Q709 594 709 549L709 504ZM348 356L709 356L709 0L348 0L348 356Z"},
+		'@':      {2048, "M831 539Q831 416 884 345Q936 274 1026 274Q1115 274 1168 346Q1221 417 1221 539Q1221 660 1168 731Q1114 801 1024 801Q936 801 884 731Q831 660 831 539ZM1241 238Q1211 167 1145 128Q1078 88 989 88Q817 88 710 213Q602 337 602 537Q602 737 710 862Q818 987 989 987Q1078 987 1145 947Q1211 907 1241 836L1241 967L1450 967L1450 274Q1574 293 1645 394Q1716 494 1716 651Q1716 751 1687 839Q1658 926 1599 999Q1504 1121 1362 1187Q1219 1253 1053 1253Q937 1253 831 1223Q725 1192 635 1133Q487 1035 405 880Q322 724 322 543Q322 394 376 264Q429 133 530 33Q630 -65 760 -117Q889 -168 1036 -168Q1162 -168 1288 -121Q1414 -74 1503 6L1610 -156Q1485 -253 1338 -305Q1190 -356 1038 -356Q853 -356 689 -291Q525 -225 397 -100Q269 25 202 190Q135 354 135 543Q135 725 203 890Q271 1055 397 1180Q523 1304 691 1372Q858 1440 1038 1440Q1262 1440 1445 1355Q1628 1269 1751 1108Q1826 1010 1865 896Q1903 781 1903 655Q1903 384 1740 234Q1577 84 1280 84L1241 84L1241 238Z"},
+		'A':      {1585, "M1094 272L492 272L397 0L10 0L563 1493L1022 1493L1575 0L1188 0L1094 272ZM588 549L997 549L793 1143L588 549Z"},
+		'B':      {1561, "M786 915Q877 915 924 955Q971 995 971 1073Q971 1150 924 1191Q877 1231 786 1231L573 1231L573 915L786 915ZM799 262Q915 262 974 311Q1032 360 1032 459Q1032 556 974 605Q916 653 799 653L573 653L573 262L799 262ZM1157 799Q1281 763 1349 666Q1417 569 1417 428Q1417 212 1271 106Q1125 0 827 0L188 0L188 1493L766 1493Q1077 1493 1217 1399Q1356 1305 1356 1098Q1356 989 1305 913Q1254 836 1157 799Z"},
+		'C':      {1503, "M1372 82Q1266 27 1151 -1Q1036 -29 911 -29Q538 -29 320 180Q102 388 102 745Q102 1103 320 1312Q538 1520 911 1520Q1036 1520 1151 1492Q1266 1464 1372 1409L1372 1100Q1265 1173 1161 1207Q1057 1241 942 1241Q736 1241 618 1109Q500 977 500 745Q500 514 618 382Q736 250 942 250Q1057 250 1161 284Q1265 318 1372 391L1372 82Z"},
+		'D':      {1700, "M573 1202L573 291L711 291Q947 291 1072 408Q1196 525 1196 748Q1196 970 1072 1086Q948 1202 711 1202L573 1202ZM188 1493L594 1493Q934 1493 1101 1445Q1267 1396 1386 1280Q1491 1179 1542 1047Q1593 915 1593 748Q1593 579 1542 447Q1491 314 1386 213Q1266 97 1098 49Q930 0 594 0L188 0L188 1493Z"},
+		'E':      {1399, "M188 1493L1227 1493L1227 1202L573 1202L573 924L1188 924L1188 633L573 633L573 291L1249 291L1249 0L188 0L188 1493Z"},
+		'F':      {1399, "M188 1493L1227 1493L1227 1202L573 1202L573 924L1188 924L1188 633L573 633L573 0L188 0L188 1493Z"},
+		'G':      {1681, "M1530 111Q1386 41 1231 6Q1076 -29 911 -29Q538 -29 320 180Q102 388 102 745Q102 1106 324 1313Q546 1520 932 1520Q1081 1520 1218 1492Q1354 1464 1475 1409L1475 1100Q1350 1171 1227 1206Q1103 1241 979 1241Q749 1241 625 1113Q500 984 500 745Q500 508 620 379Q740 250 961 250Q1021 250 1073 258Q1124 265 1165 281L1165 571L930 571L930 829L1530 829L1530 111Z"},
+		'H':      {1714, "M188 1493L573 1493L573 924L1141 924L1141 1493L1526 1493L1526 0L1141 0L1141 633L573 633L573 0L188 0L188 1493Z"},
+		'I':      {762, "M188 1493L573 1493L573 0L188 0L188 1493Z"},
+		'J':      {762, "M188 1493L573 1493L573 145Q573 -134 422 -272Q270 -410 -37 -410L-115 -410L-115 -119L-55 -119Q65 -119 127 -52Q188 15 188 145L188 1493Z"},
+		'K':      {1587, "M188 1493L573 1493L573 948L1128 1493L1575 1493L856 786L1649 0L1167 0L573 588L573 0L188 0L188 1493Z"},
+		'L':      {1305, "M188 1493L573 1493L573 291L1249 291L1249 0L188 0L188 1493Z"},
+		'M':      {2038, "M188 1493L678 1493L1018 694L1360 1493L1849 1493L1849 0L1485 0L1485 1092L1141 287L897 287L553 1092L553 0L188 0L188 1493Z"},
+		'N':      {1714, "M188 1493L618 1493L1161 469L1161 1493L1526 1493L1526 0L1096 0L553 1024L553 0L188 0L188 1493Z"},
+		'O':      {1741, "M870 1241Q694 1241 597 1111Q500 981 500 745Q500 510 597 380Q694 250 870 250Q1047 250 1144 380Q1241 510 1241 745Q1241 981 1144 1111Q1047 1241 870 1241ZM870 1520Q1230 1520 1434 1314Q1638 1108 1638 745Q1638 383 1434 177Q1230 -29 870 -29Q511 -29 307 177Q102 383 102 745Q102 1108 307 1314Q511 1520 870 1520Z"},
+		'P':      {1501, "M188 1493L827 1493Q1112 1493 1265 1367Q1417 1240 1417 1006Q1417 771 1265 645Q1112 518 827 518L573 518L573 0L188 0L188 1493ZM573 1214L573 797L786 797Q898 797 959 852Q1020 906 1020 1006Q1020 1106 959 1160Q898 1214 786 1214L573 1214Z"},
+		'Q':      {1741, "M911 -27L881 -27Q512 -27 307 177Q102 381 102 745Q102 1108 307 1314Q511 1520 870 1520Q1233 1520 1436 1316Q1638 1112 1638 745Q1638 493 1531 311Q1423 129 1221 37L1522 -299L1155 -299L911 -27ZM870 1241Q694 1241 597 1111Q500 981 500 745Q500 505 595 378Q690 250 870 250Q1047 250 1144 380Q1241 510 1241 745Q1241 981 1144 1111Q1047 1241 870 1241Z"},
+		'R':      {1577, "M735 831Q856 831 909 876Q961 921 961 1024Q961 1126 909 1170Q856 1214 735 1214L573 1214L573 831L735 831ZM573 565L573 0L188 0L188 1493L776 1493Q1071 1493 1209 1394Q1346 1295 1346 1081Q1346 933 1275 838Q1203 743 1059 698Q1138 680 1201 617Q1263 553 1327 424L1536 0L1126 0L944 371Q889 483 833 524Q776 565 682 565L573 565Z"},
+		'S':      {1475, "M1227 1446L1227 1130Q1104 1185 987 1213Q870 1241 766 1241Q628 1241 562 1203Q496 1165 496 1085Q496 1025 541 992Q585 958 702 934L866 901Q1115 851 1220 749Q1325 647 1325 459Q1325 212 1179 92Q1032 -29 731 -29Q589 -29 446 -2Q303 25 160 78L160 403Q303 327 437 289Q570 250 694 250Q820 250 887 292Q954 334 954 412Q954 482 909 520Q863 558 727 588L578 621Q354 669 251 774Q147 879 147 1057Q147 1280 291 1400Q435 1520 705 1520Q828 1520 958 1502Q1088 1483 1227 1446Z"},
+		'T':      {1397, "M10 1493L1386 1493L1386 1202L891 1202L891 0L506 0L506 1202L10 1202L10 1493Z"},
+		'U':      {1663, "M188 1493L573 1493L573 598Q573 413 634 334Q694 254 831 254Q969 254 1030 334Q1090 413 1090 598L1090 1493L1475 1493L1475 598Q1475 281 1316 126Q1157 -29 831 -29Q506 -29 347 126Q188 281 188 598L188 1493Z"},
+		'V':      {1585, "M10 1493L397 1493L793 391L1188 1493L1575 1493L1022 0L563 0L10 1493Z"},
+		'W':      {2259, "M61 1493L430 1493L688 408L944 1493L1315 1493L1571 408L1829 1493L2195 1493L1843 0L1399 0L1128 1135L860 0L416 0L61 1493Z"},
+		'X':      {1579, "M1020 762L1538 0L1137 0L788 510L442 0L39 0L557 762L59 1493L461 1493L788 1012L1114 1493L1518 1493L1020 762Z"},
+		'Y':      {1483, "M-20 1493L401 1493L741 961L1081 1493L1503 1493L934 629L934 0L549 0L549 629L-20 1493Z"},
+		'Z':      {1485, "M115 1493L1370 1493L1370 1260L569 291L1393 291L1393 0L92 0L92 233L893 1202L115 1202L115 1493Z"},
+		'[':      {936, "M176 1556L797 1556L797 1331L516 1331L516 -45L797 -45L797 -270L176 -270L176 1556Z"},
+		'\\':     {748, "M526 -190L0 1493L221 1493L748 -190L526 -190Z"},
+		']':      {936, "M760 -270L139 -270L139 -45L420 -45L420 1331L139 1331L139 1556L760 1556L760 -270Z"},
+		'^':      {1716, "M981 1493L1509 936L1268 936L858 1237L449 936L207 936L735 1493L981 1493Z"},
+		'_':      {1024, "M1024 -293L1024 -483L0 -483L0 -293L1024 -293Z"},
+		'`':      {1024, "M377 1638L659 1262L463 1262L94 1638L377 1638Z"},
+		'a':      {1382, "M674 504Q562 504 506 466Q449 428 449 354Q449 286 495 248Q540 209 621 209Q722 209 791 282Q860 354 860 463L860 504L674 504ZM1221 639L1221 0L860 0L860 166Q788 64 698 18Q608 -29 479 -29Q305 -29 197 73Q88 174 88 336Q88 533 224 625Q359 717 649 717L860 717L860 745Q860 830 793 870Q726 909 584 909Q469 909 370 886Q271 863 186 817L186 1090Q301 1118 417 1133Q533 1147 649 1147Q952 1147 1087 1028Q1221 908 1221 639Z"},
+		'b':      {1466, "M768 231Q883 231 944 315Q1004 399 1004 559Q1004 719 944 803Q883 887 768 887Q653 887 592 803Q530 718 530 559Q530 400 592 316Q653 231 768 231ZM530 956Q604 1054 694 1101Q784 1147 901 1147Q1108 1147 1241 983Q1374 818 1374 559Q1374 300 1241 136Q1108 -29 901 -29Q784 -29 694 18Q604 64 530 162L530 0L172 0L172 1556L530 1556L530 956Z"},
+		'c':      {1214, "M1077 1085L1077 793Q1004 843 931 867Q857 891 778 891Q628 891 545 804Q461 716 461 559Q461 402 545 315Q628 227 778 227Q862 227 938 252Q1013 277 1077 326L1077 33Q993 2 907 -14Q820 -29 733 -29Q430 -29 259 127Q88 282 88 559Q88 836 259 992Q430 1147 733 1147Q821 1147 907 1132Q992 1116 1077 1085Z"},
+		'd':      {1466, "M934 956L934 1556L1294 1556L1294 0L934 0L934 162Q860 63 771 17Q682 -29 565 -29Q358 -29 225 136Q92 300 92 559Q92 818 225 983Q358 1147 565 1147Q681 1147 771 1101Q860 1054 934 956ZM698 231Q813 231 874 315Q934 399 934 559Q934 719 874 803Q813 887 698 887Q584 887 524 803Q463 719 463 559Q463 399 524 315Q584 231 698 231Z"},
+		'e':      {1389, "M1290 563L1290 461L453 461Q466 335 544 272Q622 209 762 209Q875 209 994 243Q1112 276 1237 344L1237 68Q1110 20 983 -5Q856 -29 729 -29Q425 -29 257 126Q88 280 88 559Q88 833 254 990Q419 1147 709 1147Q973 1147 1132 988Q1290 829 1290 563ZM922 682Q922 784 863 847Q803 909 707 909Q603 909 538 851Q473 792 457 682L922 682Z"},
+		'f':      {891, "M909 1556L909 1321L711 1321Q635 1321 605 1294Q575 1266 575 1198L575 1120L881 1120L881 864L575 864L575 0L217 0L217 864L39 864L39 1120L217 1120L217 1198Q217 1381 319 1469Q421 1556 635 1556L909 1556Z"},
+		'g':      {1466, "M934 190Q860 92 771 46Q682 0 565 0Q360 0 226 162Q92 323 92 573Q92 824 226 985Q360 1145 565 1145Q682 1145 771 1099Q860 1053 934 954L934 1120L1294 1120L1294 113Q1294 -157 1124 -300Q953 -442 629 -442Q524 -442 426 -426Q328 -410 229 -377L229 -98Q323 -152 413 -179Q503 -205 594 -205Q770 -205 852 -128Q934 -51 934 113L934 190ZM698 887Q587 887 525 805Q463 723 463 573Q463 419 523 340Q583 260 698 260Q810 260 872 342Q934 424 934 573Q934 723 872 805Q810 887 698 887Z"},
+		'h':      {1458, "M1298 682L1298 0L938 0L938 111L938 520Q938 667 932 722Q925 777 909 803Q888 838 852 858Q816 877 770 877Q658 877 594 791Q530 704 530 551L530 0L172 0L172 1556L530 1556L530 956Q611 1054 702 1101Q793 1147 903 1147Q1097 1147 1198 1028Q1298 909 1298 682Z"},
+		'i':      {702, "M172 1120L530 1120L530 0L172 0L172 1120ZM172 1556L530 1556L530 1264L172 1264L172 1556Z"},
+		'j':      {702, "M172 1120L530 1120L530 20Q530 -205 422 -324Q314 -442 109 -442L-68 -442L-68 -207L-6 -207Q96 -207 134 -161Q172 -115 172 20L172 1120ZM172 1556L530 1556L530 1264L172 1264L172 1556Z"},
+		'k':      {1362, "M172 1556L530 1556L530 709L942 1120L1358 1120L811 606L1401 0L967 0L530 467L530 0L172 0L172 1556Z"},
+		'l':      {702, "M172 1556L530 1556L530 0L172 0L172 1556Z"},
+		'm':      {2134, "M1210 934Q1278 1038 1372 1093Q1465 1147 1577 1147Q1770 1147 1871 1028Q1972 909 1972 682L1972 0L1612 0L1612 584Q1613 597 1614 611Q1614 625 1614 651Q1614 770 1579 824Q1544 877 1466 877Q1364 877 1309 793Q1253 709 1251 550L1251 0L891 0L891 584Q891 770 859 824Q827 877 745 877Q642 877 586 793Q530 708 530 551L530 0L170 0L170 1120L530 1120L530 956Q596 1051 682 1099Q767 1147 870 1147Q986 1147 1075 1091Q1164 1035 1210 934Z"},
+		'n':      {1458, "M1298 682L1298 0L938 0L938 111L938 522Q938 667 932 722Q925 777 909 803Q888 838 852 858Q816 877 770 877Q658 877 594 791Q530 704 530 551L530 0L172 0L172 1120L530 1120L530 956Q611 1054 702 1101Q793 1147 903 1147Q1097 1147 1198 1028Q1298 909 1298 682Z"},
+		'o':      {1407, "M705 891Q586 891 524 806Q461 720 461 559Q461 398 524 313Q586 227 705 227Q822 227 884 313Q946 398 946 559Q946 720 884 806Q822 891 705 891ZM705 1147Q994 1147 1157 991Q1319 835 1319 559Q1319 283 1157 127Q994 -29 705 -29Q415 -29 252 127Q88 283 88 559Q88 835 252 991Q415 1147 705 1147Z"},
+		'p':      {1466, "M530 162L530 -426L172 -426L172 1120L530 1120L530 956Q604 1054 694 1101Q784 1147 901 1147Q1108 1147 1241 983Q1374 818 1374 559Q1374 300 1241 136Q1108 -29 901 -29Q784 -29 694 18Q604 64 530 162ZM768 887Q653 887 592 803Q530 718 530 559Q530 400 592 316Q653 231 768 231Q883 231 944 315Q1004 399 1004 559Q1004 719 944 803Q883 887 768 887Z"},
+		'q':      {1466, "M698 887Q584 887 524 803Q463 719 463 559Q463 399 524 315Q584 231 698 231Q813 231 874 315Q934 399 934 559Q934 719 874 803Q813 887 698 887ZM934 162Q860 63 771 17Q682 -29 565 -29Q358 -29 225 136Q92 300 92 559Q92 818 225 982Q358 1145 565 1145Q682 1145 771 1099Q860 1053 934 954L934 1120L1294 1120L1294 -426L934 -426L934 162Z"},
+		'r':      {1010, "M1004 815Q957 837 911 848Q864 858 817 858Q679 858 605 770Q530 681 530 516L530 0L172 0L172 1120L530 1120L530 936Q599 1046 689 1097Q778 1147 903 1147Q921 1147 942 1146Q963 1144 1003 1139L1004 815Z"},
+		's':      {1219, "M1047 1085L1047 813Q932 861 825 885Q718 909 623 909Q521 909 472 884Q422 858 422 805Q422 762 460 739Q497 716 594 705L657 696Q932 661 1027 581Q1122 501 1122 330Q1122 151 990 61Q858 -29 596 -29Q485 -29 367 -12Q248 6 123 41L123 313Q230 261 343 235Q455 209 571 209Q676 209 729 238Q782 267 782 324Q782 372 746 396Q709 419 600 432L537 440Q298 470 202 551Q106 632 106 797Q106 975 228 1061Q350 1147 602 1147Q701 1147 810 1132Q919 1117 1047 1085Z"},
+		't':      {979, "M563 1438L563 1120L932 1120L932 864L563 864L563 389Q563 311 594 284Q625 256 717 256L901 256L901 0L594 0Q382 0 294 89Q205 177 205 389L205 864L27 864L27 1120L205 1120L205 1438L563 1438Z"},
+		'u':      {1458, "M160 436L160 1120L520 1120L520 1008Q520 917 519 780Q518 642 518 596Q518 461 525 402Q532 342 549 315Q571 280 607 261Q642 242 688 242Q800 242 864 328Q928 414 928 567L928 1120L1286 1120L1286 0L928 0L928 162Q847 64 757 18Q666 -29 557 -29Q363 -29 262 90Q160 209 160 436Z"},
+		'v':      {1335, "M31 1120L389 1120L668 346L946 1120L1305 1120L864 0L471 0L31 1120Z"},
+		'w':      {1892, "M72 1120L420 1120L608 348L797 1120L1096 1120L1284 356L1473 1120L1821 1120L1526 0L1135 0L946 770L758 0L367 0L72 1120Z"},
+		'x':      {1321, "M455 573L51 1120L430 1120L659 788L891 1120L1270 1120L866 575L1290 0L911 0L659 354L410 0L31 0L455 573Z"},
+		'y':      {1335, "M25 1120L383 1120L684 360L940 1120L1298 1120L827 -106Q756 -293 662 -368Q567 -442 412 -442L205 -442L205 -207L317 -207Q408 -207 450 -178Q491 -149 514 -74L524 -43L25 1120Z"},
+		'z':      {1192, "M117 1120L1094 1120L1094 870L504 256L1094 256L1094 0L92 0L92 250L682 864L117 864L117 1120Z"},
+		'{':      {1458, "M1202 -109L1202 -334L985 -334Q767 -334 667 -246Q567 -158 567 35L567 227Q567 377 513 436Q459 494 317 494L256 494L256 717L317 717Q459 717 513 775Q567 833 567 983L567 1188Q567 1381 667 1469Q767 1556 985 1556L1202 1556L1202 1331L1133 1331Q992 1331 950 1288Q907 1244 907 1102L907 936Q907 779 862 708Q817 637 707 612Q818 585 863 514Q907 443 907 287L907 121Q907 -22 950 -66Q992 -109 1133 -109L1202 -109Z"},
+		'|':      {748, "M487 1565L487 -483L260 -483L260 1565L487 1565Z"},
+		'}':      {1458, "M256 -109L326 -109Q466 -109 509 -66Q551 -22 551 121L551 287Q551 443 596 514Q641 585 752 612Q641 637 596 708Q551 779 551 936L551 1102Q551 1244 509 1288Q466 1331 326 1331L256 1331L256 1556L473 1556Q691 1556 791 1469Q891 1381 891 1188L891 983Q891 833 945 775Q999 717 1141 717L1202 717L1202 494L1141 494Q999 494 945 436Q891 377 891 227L891 35Q891 -158 791 -246Q691 -334 473 -334L256 -334L256 -109Z"},
+		'~':      {1716, "M1499 850L1499 606Q1393 526 1304 492Q1214 457 1118 457Q1011 457 868 515Q854 521 846 524Q839 527 824 533Q669 594 575 594Q487 594 401 556Q315 517 217 434L217 678Q324 758 413 793Q502 827 598 827Q705 827 848 769Q863 763 870 760Q877 757 892 751Q1047 690 1141 690Q1227 690 1312 728Q1396 765 1499 850Z"},
+		'\u00a0': {713, ""},
+		'¡':      {934, "M287 -373L287 200L338 618L596 618L647 200L647 -373L287 -373ZM287 764L287 1120L647 1120L647 764L287 764Z"},
+		'¢':      {1425, "M702 858Q624 814 586 741Q547 667 547 559Q547 450 586 376Q624 302 702 260L702 858ZM1161 1085L1161 793Q1087 841 1016 866Q944 891 879 891L864 891L864 228Q953 229 1029 254Q1104 279 1161 326L1161 33Q1078 3 1005 -13Q932 -29 874 -29L864 -29L864 -313L702 -313L702 -25Q440 15 307 166Q174 317 174 575Q174 819 309 965Q444 1110 702 1145L702 1432L864 1432L865 1145Q936 1142 1010 1128Q1083 1113 1161 1085Z"},
+		'£':      {1425, "M1243 1466L1243 1180Q1173 1219 1099 1238Q1025 1257 948 1257Q830 1257 774 1195Q717 1132 717 1001L717 831L1090 831L1090 592L717 592L717 266L1255 266L1255 0L125 0L125 266L352 266L352 592L158 592L158 831L352 831L352 1001Q352 1272 479 1396Q606 1520 881 1520Q973 1520 1064 1507Q1154 1493 1243 1466Z"},
+		'¤':      {1303, "M434 268L227 61L74 215L281 422Q253 471 239 525Q225 578 225 641Q225 704 240 758Q255 812 285 858L76 1063L229 1217L436 1010Q484 1040 538 1055Q592 1069 653 1069Q707 1069 761 1056Q815 1042 872 1014L1079 1221L1231 1067L1024 860Q1053 805 1067 750Q1081 695 1081 641Q1081 578 1067 526Q1052 473 1022 426L1229 219L1075 66L868 272Q822 242 769 228Q716 213 653 213Q595 213 541 227Q487 240 434 268ZM653 422Q744 422 808 486Q872 549 872 641Q872 733 809 797Q745 860 653 860Q562 860 498 797Q434 733 434 641Q434 548 497 485Q560 422 653 422Z"},
+		'¥':      {1425, "M1358 416L903 416L903 0L522 0L522 416L68 416L68 610L522 610L522 676L473 762L68 762L68 954L360 954L25 1493L424 1493L713 1032L1001 1493L1401 1493L1065 954L1358 954L1358 762L952 762L903 676L903 610L1358 610L1358 416Z"},
+		'¦':      {748, "M487 1432L487 674L260 674L260 1432L487 1432ZM487 408L487 -350L260 -350L260 408L487 408Z"},
+		'§':      {1024, "M885 1462L885 1235Q786 1274 707 1294Q628 1313 571 1313Q496 1313 458 1289Q420 1264 420 1217Q420 1150 608 1071Q634 1060 647 1055Q857 966 937 880Q1016 793 1016 668Q1016 551 960 472Q903 392 786 344Q863 303 901 247Q938 190 938 117Q938 -28 817 -112Q696 -195 483 -195Q398 -195 308 -181Q217 -166 115 -137L115 100Q230 59 321 38Q412 16 469 16Q534 16 573 41Q612 66 612 106Q612 176 432 250Q396 264 377 272Q174 359 94 449Q14 538 14 668Q14 772 70 849Q125 926 238 977Q163 1028 131 1084Q98 1139 98 1214Q98 1358 213 1439Q327 1520 528 1520Q612 1520 702 1506Q792 1491 885 1462ZM434 856Q366 828 333 790Q299 752 299 702Q299 635 361 586Q422 537 604 471Q669 494 704 534Q739 573 739 625Q739 692 670 745Q601 798 434 856Z"},
+		'¨':      {1024, "M197 1585L432 1585L432 1339L197 1339L197 1585ZM592 1585L827 1585L827 1339L592 1339L592 1585Z"},
+		'©':      {2048, "M1323 1126L1323 911Q1266 948 1211 966Q1155 983 1098 983Q985 983 922 919Q858 855 858 741Q858 626 921 563Q984 500 1098 500Q1162 500 1220 518Q1277 536 1323 571L1323 358Q1258 336 1193 325Q1127 313 1065 313Q854 313 727 430Q600 547 600 741Q600 936 727 1053Q854 1169 1065 1169Q1134 1169 1198 1159Q1262 1148 1323 1126ZM1024 1331Q903 1331 799 1288Q695 1244 608 1157Q521 1070 478 967Q434 863 434 741Q434 620 478 517Q521 413 608 326Q694 240 799 197Q903 154 1024 154Q1147 154 1250 197Q1353 239 1440 326Q1527 413 1571 517Q1614 620 1614 741Q1614 863 1571 967Q1527 1070 1440 1157Q1352 1245 1249 1288Q1145 1331 1024 1331ZM1024 1485Q1176 1485 1308 1430Q1439 1375 1548 1266Q1657 1157 1711 1026Q1765 895 1765 741Q1765 589 1711 459Q1657 328 1548 219Q1439 110 1308 55Q1176 0 1024 0Q872 0 741 55Q609 110 500 219Q391 328 337 459Q283 589 283 741Q283 895 337 1026Q391 1157 500 1266Q609 1375 741 1430Q872 1485 1024 1485Z"},
+		'ª':      {1155, "M176 573L989 573L989 373L176 373L176 573ZM643 1081Q510 1081 458 1055Q406 1029 406 967Q406 916 439 887Q472 858 530 858Q619 858 676 915Q733 972 733 1059L733 1081L643 1081ZM1001 1165L1001 717L756 717L756 844Q701 768 631 732Q561 696 467 696Q322 696 240 763Q158 830 158 946Q158 1087 263 1153Q368 1219 594 1219L731 1219L731 1239Q731 1295 687 1325Q642 1354 557 1354Q470 1354 387 1337Q304 1319 225 1284L225 1464Q317 1492 402 1506Q486 1520 561 1520Q785 1520 893 1433Q1001 1345 1001 1165Z"},
+		'«':      {1323, "M651 1063L651 821L358 600L651 379L651 137L158 506L158 692L651 1063ZM1130 1063L1130 821L838 600L1130 379L1130 137L637 506L637 692L1130 1063Z"},
+		'¬':      {1716, "M217 909L1499 909L1499 287L1264 287L1264 672L217 672L217 909Z"},
+		'\u00ad': {850, "M111 735L739 735L739 444L111 444L111 735Z"},
+		'®':      {2048, "M1024 1331Q903 1331 799 1288Q695 1244 608 1157Q521 1070 478 967Q434 863 434 741Q434 620 478 517Q521 413 608 326Q694 240 799 197Q903 154 1024 154Q1147 154 1250 197Q1353 239 1440 326Q1527 413 1571 517Q1614 620 1614 741Q1614 863 1571 967Q1527 1070 1440 1157Q1352 1245 1249 1288Q1145 1331 1024 1331ZM967 1036L932 1036L932 829L967 829Q1045 829 1085 856Q1124 882 1124 934Q1124 986 1086 1011Q1047 1036 967 1036ZM1004 1174Q1180 1174 1267 1115Q1354 1055 1354 934Q1354 848 1302 792Q1249 736 1153 719Q1194 697 1230 657Q1265 617 1294 559L1405 338L1176 338L1069 551Q1031 629 1002 662Q973 694 944 694L932 694L932 338L719 338L719 1174L1004 1174ZM1024 1485Q1176 1485 1308 1430Q1439 1375 1548 1266Q1657 1157 1711 1026Q1765 895 1765 741Q1765 589 1711 459Q1657 328 1548 219Q1439 110 1308 55Q1176 0 1024 0Q872 0 741 55Q609 110 500 219Q391 328 337 459Q283 589 283 741Q283 895 337 1026Q391 1157 500 1266Q609 1375 741 1430Q872 1485 1024 1485Z"},
+		'¯':      {1024, "M197 1556L827 1556L827 1368L197 1368L197 1556Z"},
+		'°':      {1024, "M512 1372Q440 1372 390 1322Q340 1272 340 1200Q340 1128 390 1079Q439 1030 512 1030Q584 1030 634 1080Q684 1129 684 1200Q684 1272 634 1322Q583 1372 512 1372ZM512 1534Q578 1534 639 1509Q700 1483 748 1436Q795 1388 820 1328Q844 1268 844 1200Q844 1133 820 1073Q795 1012 750 967Q702 919 640 894Q578 868 510 868Q369 868 274 964Q178 1059 178 1200Q178 1341 275 1438Q371 1534 512 1534Z"},
+		'±':      {1716, "M977 1284L977 930L1499 930L1499 694L977 694L977 340L739 340L739 694L217 694L217 930L739 930L739 1284L977 1284ZM217 238L1499 238L1499 0L217 0L217 238Z"},
+		'²':      {897, "M412 836L782 836L782 668L109 668L109 821L422 1087Q483 1140 509 1180Q535 1220 535 1260Q535 1310 499 1341Q462 1372 403 1372Q341 1372 270 1350Q199 1327 115 1280L115 1466Q202 1493 284 1507Q365 1520 440 1520Q598 1520 688 1456Q778 1391 778 1280Q778 1208 743 1148Q707 1087 606 1001L412 836Z"},
+		'³':      {897, "M592 1120Q684 1102 735 1047Q786 992 786 911Q786 782 687 718Q588 653 387 653Q306 653 232 665Q158 676 90 698L90 872Q156 836 220 818Q284 799 344 799Q439 799 491 831Q543 863 543 922Q543 986 490 1017Q436 1047 322 1047L248 1047L248 1184L332 1184Q430 1184 475 1208Q520 1231 520 1282Q520 1327 481 1350Q442 1372 362 1372Q310 1372 249 1359Q187 1346 117 1319L117 1485Q182 1502 258 1511Q333 1520 420 1520Q587 1520 676 1464Q764 1408 764 1303Q764 1234 719 1186Q674 1138 592 1120Z"},
+		'´':      {1024, "M647 1638L930 1638L561 1262L365 1262L647 1638Z"},
+		'µ':      {1507, "M174 -428L174 1120L535 1120L535 469Q535 353 585 297Q635 240 737 240Q840 240 890 297Q940 353 940 469L940 1120L1300 1120L1300 371Q1300 300 1317 272Q1333 244 1372 244Q1390 244 1407 249Q1423 254 1442 266L1442 16Q1389 -7 1343 -18Q1296 -29 1251 -29Q1162 -29 1106 9Q1049 46 1014 129Q967 50 900 11Q832 -29 743 -29Q669 -29 617 -6Q565 18 535 66L535 -428L174 -428Z"},
+		'¶':      {1303, "M604 1493L1124 1493L1124 -197L934 -197L934 1346L745 1346L745 -197L555 -197L555 649Q351 674 240 784Q129 893 129 1071Q129 1261 259 1377Q389 1493 604 1493Z"},
+		'·':      {778, "M209 905L569 905L569 518L209 518L209 905Z"},
+		'¸':      {1024, "M602 0Q660 -62 688 -115Q715 -168 715 -215Q715 -310 654 -356Q592 -401 465 -401Q417 -401 366 -395Q315 -388 263 -375L262 -223Q312 -239 354 -247Q395 -254 428 -254Q486 -254 519 -231Q551 -208 551 -168Q551 -142 530 -101Q508 -60 463 0L602 0Z"},
+		'¹':      {897, "M141 825L348 825L348 1346L123 1294L123 1454L352 1503L578 1503L578 825L782 825L782 668L141 668L141 825Z"},
+		'º':      {1155, "M578 1520Q791 1520 915 1409Q1038 1298 1038 1108Q1038 918 915 808Q792 698 578 698Q364 698 241 808Q117 918 117 1108Q117 1298 241 1409Q364 1520 578 1520ZM166 573L989 573L989 373L166 373L166 573ZM578 1350Q494 1350 449 1287Q403 1224 403 1108Q403 992 449 930Q494 868 578 868Q661 868 707 930Q752 992 752 1108Q752 1224 707 1287Q661 1350 578 1350Z"},
+		'»':      {1323, "M672 1063L1165 692L1165 506L672 137L672 379L965 600L672 821L672 1063ZM193 1063L684 692L684 506L193 137L193 379L485 600L193 821L193 1063Z"},
+		'¼':      {2120, "M1593 640Q1593 640 1354 317L1593 317L1593 640ZM1575 835Q1575 835 1823 835L1823 317L1960 317L1960 162L1823 162L1823 0L1593 0L1593 162L1202 162L1202 330L1575 835ZM734 -29L510 -29L1444 1520L1668 1520L734 -29ZM118 825L325 825L325 1346L100 1294L100 1454L329 1503L555 1503L555 825L759 825L759 668L118 668L118 825Z"},
+		'½':      {2120, "M734 -29L510 -29L1444 1520L1668 1520L734 -29ZM1651 168L2021 168L2021 0L1348 0L1348 153L1661 419Q1722 472 1748 512Q1774 552 1774 592Q1774 642 1738 673Q1701 704 1642 704Q1580 704 1509 682Q1438 659 1354 612L1354 798Q1441 825 1523 839Q1604 852 1679 852Q1837 852 1927 788Q2017 723 2017 612Q2017 540 1982 480Q1946 419 1845 333L1651 168ZM118 825L325 825L325 1346L100 1294L100 1454L329 1503L555 1503L555 825L759 825L759 668L118 668L118 825Z"},
+		'¾':      {2120, "M1593 640Q1593 640 1354 317L1593 317L1593 640ZM1575 835Q1575 835 1823 835L1823 317L1960 317L1960 162L1823 162L1823 0L1593 0L1593 162L1202 162L1202 330L1575 835ZM734 -29L510 -29L1444 1520L1668 1520L734 -29ZM606 1120Q698 1102 749 1047Q800 992 800 911Q800 782 701 718Q602 653 401 653Q320 653 246 665Q172 676 104 698L104 872Q170 836 234 818Q298 799 358 799Q453 799 505 831Q557 863 557 922Q557 986 504 1017Q450 1047 336 1047L262 1047L262 1184L346 1184Q444 1184 489 1208Q534 1231 534 1282Q534 1327 495 1350Q456 1372 376 1372Q324 1372 263 1359Q201 1346 131 1319L131 1485Q196 1502 272 1511Q347 1520 434 1520Q601 1520 690 1464Q778 1408 778 1303Q778 1234 733 1186Q688 1138 606 1120Z"},
+		'¿':      {1188, "M487 614L848 614L848 565Q848 484 816 421Q783 358 674 258L610 200Q554 149 528 103Q502 57 502 10Q502 -60 550 -100Q598 -140 684 -140Q765 -140 860 -106Q954 -72 1055 -6L1055 -320Q936 -362 835 -382Q733 -402 641 -402Q397 -402 269 -303Q141 -203 141 -13Q141 86 180 164Q219 241 313 329L377 387Q445 448 466 486Q487 524 487 569L487 614ZM848 764L487 764L487 1120L848 1120L848 764Z"},
+		'À':      {1585, "M1094 272L492 272L397 0L10 0L563 1493L1022 1493L1575 0L1188 0L1094 272ZM588 549L997 549L793 1143L588 549ZM717 1899L915 1635L719 1635L434 1899L717 1899Z"},
+		'Á':      {1585, "M1094 272L492 272L397 0L10 0L563 1493L1022 1493L1575 0L1188 0L1094 272ZM588 549L997 549L793 1143L588 549ZM819 1899L1102 1899L817 1635L621 1635L819 1899Z"},
+		'Â':      {1585, "M1094 272L492 272L397 0L10 0L563 1493L1022 1493L1575 0L1188 0L1094 272ZM588 549L997 549L793 1143L588 549ZM638 1899L946 1899L1169 1635L991 1635L792 1796L593 1635L415 1635L638 1899Z"},
+		'Ã':      {1585, "M1094 272L492 272L397 0L10 0L563 1493L1022 1493L1575 0L1188 0L1094 272ZM588 549L997 549L793 1143L588 549ZM794 1690L738 1725Q735 1727 728 1731Q683 1757 655 1757Q623 1757 603 1727Q583 1697 583 1647L583 1641L444 1641Q444 1646 445 1656Q446 1666 446 1671Q446 1777 500 1842Q553 1907 640 1907Q677 1907 714 1895Q751 1882 790 1858L849 1819Q870 1805 890 1798Q909 1790 925 1790Q962 1790 982 1820Q1001 1850 1001 1907L1140 1907Q1140 1901 1139 1891Q1138 1881 1138 1876Q1138 1770 1085 1706Q1031 1641 944 1641Q906 1641 871 1652Q836 1663 794 1690Z"},
+		'Ä':      {1585, "M1094 272L492 272L397 0L10 0L563 1493L1022 1493L1575 0L1188 0L1094 272ZM588 549L997 549L793 1143L588 549ZM471 1899L706 1899L706 1653L471 1653L471 1899ZM866 1899L1101 1899L1101 1653L866 1653L866 1899Z"},
+		'Å':      {1585, "M1032 1464L1575 0L1188 0L1094 272L492 272L397 0L10 0L553 1464Q530 1498 519 1536Q508 1573 508 1616Q508 1733 592 1817Q675 1901 793 1901Q909 1901 993 1817Q1077 1733 1077 1616Q1077 1569 1066 1531Q1055 1493 1032 1464ZM662 1616Q662 1562 701 1524Q739 1485 793 1485Q847 1485 886 1524Q924 1562 924 1616Q924 1670 885 1709Q846 1747 793 1747Q739 1747 701 1709Q662 1670 662 1616ZM588 549L997 549L793 1143L588 549Z"},
+		'Æ':      {2222, "M891 1237L635 627L1012 627L1012 1237L891 1237ZM625 1493L2050 1493L2050 1202L1397 1202L1397 924L2011 924L2011 633L1397 633L1397 291L2073 291L2073 0L1012 0L1012 350L518 350L371 0L0 0L625 1493Z"},
+		'Ç':      {1503, "M1372 82Q1266 27 1151 -1Q1036 -29 911 -29Q538 -29 320 180Q102 388 102 745Q102 1103 320 1312Q538 1520 911 1520Q1036 1520 1151 1492Q1266 1464 1372 1409L1372 1100Q1265 1173 1161 1207Q1057 1241 942 1241Q736 1241 618 1109Q500 977 500 745Q500 514 618 382Q736 250 942 250Q1057 250 1161 284Q1265 318 1372 391L1372 82ZM973 0Q1031 -62 1059 -115Q1086 -168 1086 -215Q1086 -310 1025 -356Q963 -401 836 -401Q788 -401 737 -395Q686 -388 634 -375L633 -223Q683 -239 725 -247Q766 -254 799 -254Q857 -254 890 -231Q922 -208 922 -168Q922 -142 901 -101Q879 -60 834 0L973 0Z"},
+		'È':      {1399, "M188 1493L1227 1493L1227 1202L573 1202L573 924L1188 924L1188 633L573 633L573 291L1249 291L1249 0L188 0L188 1493ZM641 1899L839 1635L643 1635L358 1899L641 1899Z"},
+		'É':      {1399, "M188 1493L1227 1493L1227 1202L573 1202L573 924L1188 924L1188 633L573 633L573 291L1249 291L1249 0L188 0L188 1493ZM743 1899L1026 1899L741 1635L545 1635L743 1899Z"},
+		'Ê':      {1399, "M188 1493L1227 1493L1227 1202L573 1202L573 924L1188 924L1188 633L573 633L573 291L1249 291L1249 0L188 0L188 1493ZM538 1899L846 1899L1069 1635L891 1635L692 1796L493 1635L315 1635L538 1899Z"},
+		'Ë':      {1399, "M188 1493L1227 1493L1227 1202L573 1202L573 924L1188 924L1188 633L573 633L573 291L1249 291L1249 0L188 0L188 1493ZM377 1899L612 1899L612 1653L377 1653L377 1899ZM772 1899L1007 1899L1007 1653L772 1653L772 1899Z"},
+		'Ì':      {762, "M188 1493L573 1493L573 0L188 0L188 1493ZM305 1899L503 1635L307 1635L22 1899L305 1899Z"},
+		'Í':      {762, "M188 1493L573 1493L573 0L188 0L188 1493ZM407 1899L690 1899L405 1635L209 1635L407 1899Z"},
+		'Î':      {762, "M188 1493L573 1493L573 0L188 0L188 1493ZM226 1899L534 1899L757 1635L579 1635L380 1796L181 1635L3 1635L226 1899Z"},
+		'Ï':      {762, "M188 1493L573 1493L573 0L188 0L188 1493ZM65 1899L300 1899L300 1653L65 1653L65 1899ZM460 1899L695 1899L695 1653L460 1653L460 1899Z"},
+		'Ð':      {1716, "M592 1202L592 881L827 881L827 621L592 621L592 291L729 291Q965 291 1090 408Q1214 525 1214 748Q1214 970 1090 1086Q966 1202 729 1202L592 1202ZM207 1493L612 1493Q953 1493 1119 1445Q1285 1396 1405 1280Q1509 1179 1561 1047Q1612 915 1612 748Q1612 579 1561 447Q1509 314 1405 213Q1284 97 1116 49Q948 0 612 0L207 0L207 621L33 621L33 881L207 881L207 1493Z"},
+		'Ñ':      {1714, "M188 1493L618 1493L1161 469L1161 1493L1526 1493L1526 0L1096 0L553 1024L553 0L188 0L188 1493ZM823 1684L767 1719Q764 1721 757 1725Q712 1751 684 1751Q652 1751 632 1721Q612 1691 612 1641L612 1635L473 1635Q473 1640 474 1650Q475 1660 475 1665Q475 1771 529 1836Q582 1901 669 1901Q706 1901 743 1889Q780 1876 819 1852L878 1813Q899 1799 919 1792Q938 1784 954 1784Q991 1784 1011 1814Q1030 1844 1030 1901L1169 1901Q1169 1895 1168 1885Q1167 1875 1167 1870Q1167 1764 1114 1700Q1060 1635 973 1635Q935 1635 900 1646Q865 1657 823 1684Z"},
+		'Ò':      {1741, "M870 1241Q694 1241 597 1111Q500 981 500 745Q500 510 597 380Q694 250 870 250Q1047 250 1144 380Q1241 510 1241 745Q1241 981 1144 1111Q1047 1241 870 1241ZM870 1520Q1230 1520 1434 1314Q1638 1108 1638 745Q1638 383 1434 177Q1230 -29 870 -29Q511 -29 307 177Q102 383 102 745Q102 1108 307 1314Q511 1520 870 1520ZM795 1899L993 1635L797 1635L512 1899L795 1899Z"},
+		'Ó':      {1741, "M870 1241Q694 1241 597 1111Q500 981 500 745Q500 510 597 380Q694 250 870 250Q1047 250 1144 380Q1241 510 1241 745Q1241 981 1144 1111Q1047 1241 870 1241ZM870 1520Q1230 1520 1434 1314Q1638 1108 1638 745Q1638 383 1434 177Q1230 -29 870 -29Q511 -29 307 177Q102 383 102 745Q102 1108 307 1314Q511 1520 870 1520ZM897 1899L1180 1899L895 1635L699 1635L897 1899Z"},
+		'Ô':      {1741, "M870 1241Q694 1241 597 1111Q500 981 500 745Q500 510 597 380Q694 250 870 250Q1047 250 1144 380Q1241 510 1241 745Q1241 981 1144 1111Q1047 1241 870 1241ZM870 1520Q1230 1520 1434 1314Q1638 1108 1638 745Q1638 383 1434 177Q1230 -29 870 -29Q511 -29 307 177Q102 383 102 745Q102 1108 307 1314Q511 1520 870 1520ZM692 1899L1000 1899L1223 1635L1045 1635L846 1796L647 1635L469 1635L692 1899Z"},
+		'Õ':      {1741, "M870 1241Q694 1241 597 1111Q500 981 500 745Q500 510 597 380Q694 250 870 250Q1047 250 1144 380Q1241 510 1241 745Q1241 981 1144 1111Q1047 1241 870 1241ZM870 1520Q1230 1520 1434 1314Q1638 1108 1638 745Q1638 383 1434 177Q1230 -29 870 -29Q511 -29 307 177Q102 383 102 745Q102 1108 307 1314Q511 1520 870 1520ZM873 1684L817 1719Q814 1721 807 1725Q762 1751 734 1751Q702 1751 682 1721Q662 1691 662 1641L662 1635L523 1635Q523 1640 524 1650Q525 1660 525 1665Q525 1771 579 1836Q632 1901 719 1901Q756 1901 793 1889Q830 1876 869 1852L928 1813Q949 1799 969 1792Q988 1784 1004 1784Q1041 1784 1061 1814Q1080 1844 1080 1901L1219 1901Q1219 1895 1218 1885Q1217 1875 1217 1870Q1217 1764 1164 1700Q1110 1635 1023 1635Q985 1635 950 1646Q915 1657 873 1684Z"},
+		'Ö':      {1741, "M870 1241Q694 1241 597 1111Q500 981 500 745Q500 510 597 380Q694 250 870 250Q1047 250 1144 380Q1241 510 1241 745Q1241 981 1144 1111Q1047 1241 870 1241ZM870 1520Q1230 1520 1434 1314Q1638 1108 1638 745Q1638 383 1434 177Q1230 -29 870 -29Q511 -29 307 177Q102 383 102 745Q102 1108 307 1314Q511 1520 870 1520ZM555 1899L790 1899L790 1653L555 1653L555 1899ZM950 1899L1185 1899L1185 1653L950 1653L950 1899Z"},
+		'×':      {1716, "M1460 1075L1026 641L1460 209L1292 41L858 473L424 41L256 209L690 641L256 1075L424 1243L858 809L1292 1243L1460 1075Z"},
+		'Ø':      {1741, "M604 371Q656 309 722 280Q787 250 870 250Q1047 250 1144 380Q1241 510 1241 745Q1241 813 1234 872Q1226 930 1210 979L604 371ZM1133 1126Q1082 1184 1017 1213Q952 1241 870 1241Q694 1241 597 1111Q500 981 500 745Q500 681 507 625Q514 568 528 522L1133 1126ZM250 244Q176 344 139 469Q102 594 102 745Q102 1108 307 1314Q511 1520 870 1520Q1024 1520 1148 1483Q1272 1445 1374 1368L1573 1567L1686 1452L1485 1253Q1562 1154 1600 1027Q1638 899 1638 745Q1638 383 1434 177Q1230 -29 870 -29Q717 -29 590 11Q462 50 360 129L158 -74L45 39L250 244Z"},
+		'Ù':      {1663, "M188 1493L573 1493L573 598Q573 413 634 334Q694 254 831 254Q969 254 1030 334Q1090 413 1090 598L1090 1493L1475 1493L1475 598Q1475 281 1316 126Q1157 -29 831 -29Q506 -29 347 126Q188 281 188 598L188 1493ZM756 1899L954 1635L758 1635L473 1899L756 1899Z"},
+		'Ú':      {1663, "M188 1493L573 1493L573 598Q573 413 634 334Q694 254 831 254Q969 254 1030 334Q1090 413 1090 598L1090 1493L1475 1493L1475 598Q1475 281 1316 126Q1157 -29 831 -29Q506 -29 347 126Q188 281 188 598L188 1493ZM858 1899L1141 1899L856 1635L660 1635L858 1899Z"},
+		'Û':      {1663, "M188 1493L573 1493L573 598Q573 413 634 334Q694 254 831 254Q969 254 1030 334Q1090 413 1090 598L1090 1493L1475 1493L1475 598Q1475 281 1316 126Q1157 -29 831 -29Q506 -29 347 126Q188 281 188 598L188 1493ZM678 1899L986 1899L1209 1635L1031 1635L832 1796L633 1635L455 1635L678 1899Z"},
+		'Ü':      {1663, "M188 1493L573 1493L573 598Q573 413 634 334Q694 254 831 254Q969 254 1030 334Q1090 413 1090 598L1090 1493L1475 1493L1475 598Q1475 281 1316 126Q1157 -29 831 -29Q506 -29 347 126Q188 281 188 598L188 1493ZM517 1899L752 1899L752 1653L517 1653L517 1899ZM912 1899L1147 1899L1147 1653L912 1653L912 1899Z"},
+		'Ý':      {1483, "M-20 1493L401 1493L741 961L1081 1493L1503 1493L934 629L934 0L549 0L549 629L-20 1493ZM768 1899L1051 1899L766 1635L570 1635L768 1899Z"},
+		'Þ':      {1511, "M573 258L573 0L188 0L188 1493L573 1493L573 1233L827 1233Q1112 1233 1265 1107Q1417 980 1417 745Q1417 511 1265 385Q1112 258 827 258L573 258ZM573 956L573 537L786 537Q898 537 959 592Q1020 646 1020 745Q1020 846 959 901Q898 956 786 956L573 956Z"},
+		'ß':      {1473, "M172 1114Q172 1336 307 1446Q442 1556 715 1556Q977 1556 1111 1444Q1245 1332 1245 1114L1245 1043Q1094 1033 1022 994Q950 955 950 881Q950 844 975 816Q999 787 1092 735L1161 698Q1277 634 1331 550Q1384 465 1384 348Q1384 159 1270 65Q1155 -29 924 -29Q859 -29 790 -17Q721 -4 647 20L647 264Q703 237 761 223Q818 209 872 209Q944 209 988 245Q1032 281 1032 338Q1032 385 1005 419Q977 453 879 508L809 547Q721 596 679 664Q637 731 637 821Q637 937 707 1016Q776 1095 921 1145Q920 1230 872 1275Q824 1319 733 1319Q632 1319 581 1264Q530 1209 530 1100L530 0L172 0L172 1114Z"},
+		'à':      {1382, "M674 504Q562 504 506 466Q449 428 449 354Q449 286 495 248Q540 209 621 209Q722 209 791 282Q860 354 860 463L860 504L674 504ZM1221 639L1221 0L860 0L860 166Q788 64 698 18Q608 -29 479 -29Q305 -29 197 73Q88 174 88 336Q88 533 224 625Q359 717 649 717L860 717L860 745Q860 830 793 870Q726 909 584 909Q469 909 370 886Q271 863 186 817L186 1090Q301 1118 417 1133Q533 1147 649 1147Q952 1147 1087 1028Q1221 908 1221 639ZM563 1638L845 1262L649 1262L280 1638L563 1638Z"},
+		'á':      {1382, "M674 504Q562 504 506 466Q449 428 449 354Q449 286 495 248Q540 209 621 209Q722 209 791 282Q860 354 860 463L860 504L674 504ZM1221 639L1221 0L860 0L860 166Q788 64 698 18Q608 -29 479 -29Q305 -29 197 73Q88 174 88 336Q88 533 224 625Q359 717 649 717L860 717L860 745Q860 830 793 870Q726 909 584 909Q469 909 370 886Q271 863 186 817L186 1090Q301 1118 417 1133Q533 1147 649 1147Q952 1147 1087 1028Q1221 908 1221 639ZM833 1638L1116 1638L747 1262L551 1262L833 1638Z"},
+		'â':      {1382, "M674 504Q562 504 506 466Q449 428 449 354Q449 286 495 248Q540 209 621 209Q722 209 791 282Q860 354 860 463L860 504L674 504ZM1221 639L1221 0L860 0L860 166Q788 64 698 18Q608 -29 479 -29Q305 -29 197 73Q88 174 88 336Q88 533 224 625Q359 717 649 717L860 717L860 745Q860 830 793 870Q726 909 584 909Q469 909 370 886Q271 863 186 817L186 1090Q301 1118 417 1133Q533 1147 649 1147Q952 1147 1087 1028Q1221 908 1221 639ZM577 1638L819 1638L1075 1262L897 1262L698 1487L499 1262L321 1262L577 1638Z"},
+		'ã':      {1382, "M674 504Q562 504 506 466Q449 428 449 354Q449 286 495 248Q540 209 621 209Q722 209 791 282Q860 354 860 463L860 504L674 504ZM1221 639L1221 0L860 0L860 166Q788 64 698 18Q608 -29 479 -29Q305 -29 197 73Q88 174 88 336Q88 533 224 625Q359 717 649 717L860 717L860 745Q860 830 793 870Q726 909 584 909Q469 909 370 886Q271 863 186 817L186 1090Q301 1118 417 1133Q533 1147 649 1147Q952 1147 1087 1028Q1221 908 1221 639ZM700 1364L645 1401Q641 1403 635 1407Q588 1438 563 1438Q527 1438 508 1407Q489 1376 489 1317L489 1309L350 1309Q350 1445 402 1519Q453 1593 546 1593Q582 1593 619 1580Q655 1566 696 1536L757 1493Q779 1478 798 1470Q816 1462 831 1462Q867 1462 887 1494Q907 1526 907 1583L907 1591L1046 1591Q1046 1455 995 1381Q943 1307 850 1307Q814 1307 781 1319Q747 1331 700 1364Z"},
+		'ä':      {1382, "M674 504Q562 504 506 466Q449 428 449 354Q449 286 495 248Q540 209 621 209Q722 209 791 282Q860 354 860 463L860 504L674 504ZM1221 639L1221 0L860 0L860 166Q788 64 698 18Q608 -29 479 -29Q305 -29 197 73Q88 174 88 336Q88 533 224 625Q359 717 649 717L860 717L860 745Q860 830 793 870Q726 909 584 909Q469 909 370 886Q271 863 186 817L186 1090Q301 1118 417 1133Q533 1147 649 1147Q952 1147 1087 1028Q1221 908 1221 639ZM383 1585L618 1585L618 1339L383 1339L383 1585ZM778 1585L1013 1585L1013 1339L778 1339L778 1585Z"},
+		'å':      {1382, "M674 504Q562 504 506 466Q449 428 449 354Q449 286 495 248Q540 209 621 209Q722 209 791 282Q860 354 860 463L860 504L674 504ZM1221 639L1221 0L860 0L860 166Q788 64 698 18Q608 -29 479 -29Q305 -29 197 73Q88 174 88 336Q88 533 224 625Q359 717 649 717L860 717L860 745Q860 830 793 870Q726 909 584 909Q469 909 370 886Q271 863 186 817L186 1090Q301 1118 417 1133Q533 1147 649 1147Q952 1147 1087 1028Q1221 908 1221 639ZM567 1534Q567 1479 606 1441Q644 1403 698 1403Q753 1403 791 1442Q829 1480 829 1534Q829 1588 791 1627Q752 1665 698 1665Q643 1665 605 1627Q567 1588 567 1534ZM413 1534Q413 1652 497 1736Q580 1819 698 1819Q816 1819 900 1736Q983 1652 983 1534Q983 1416 900 1333Q816 1249 698 1249Q580 1249 497 1333Q413 1416 413 1534Z"},
+		'æ':      {2146, "M1679 682Q1679 784 1620 847Q1560 909 1464 909Q1361 909 1297 851Q1233 792 1217 682L1679 682ZM674 504Q562 504 506 466Q449 428 449 354Q449 286 495 248Q540 209 621 209Q722 209 791 282Q860 354 860 463L860 504L674 504ZM186 1090Q305 1118 417 1133Q528 1147 625 1147Q775 1147 884 1109Q992 1070 1063 991Q1140 1068 1242 1108Q1344 1147 1466 1147Q1731 1147 1890 988Q2048 829 2048 563L2048 461L1210 461Q1224 335 1302 272Q1379 209 1520 209Q1633 209 1752 243Q1870 276 1995 344L1995 68Q1868 20 1741 -5Q1613 -29 1487 -29Q1308 -29 1176 25Q1043 78 971 178Q870 71 759 21Q647 -29 508 -29Q314 -29 201 70Q88 168 88 336Q88 533 224 625Q359 717 649 717L860 717L860 745Q860 830 793 870Q726 909 584 909Q469 909 370 886Q271 863 186 817L186 1090Z"},
+		'ç':      {1214, "M1077 1085L1077 793Q1004 843 931 867Q857 891 778 891Q628 891 545 804Q461 716 461 559Q461 402 545 315Q628 227 778 227Q862 227 938 252Q1013 277 1077 326L1077 33Q993 2 907 -14Q820 -29 733 -29Q430 -29 259 127Q88 282 88 559Q88 836 259 992Q430 1147 733 1147Q821 1147 907 1132Q992 1116 1077 1085ZM786 0Q844 -62 872 -115Q899 -168 899 -215Q899 -310 838 -356Q776 -401 649 -401Q601 -401 550 -395Q499 -388 447 -375L446 -223Q496 -239 538 -247Q579 -254 612 -254Q670 -254 703 -231Q735 -208 735 -168Q735 -142 714 -101Q692 -60 647 0L786 0Z"},
+		'è':      {1389, "M1290 563L1290 461L453 461Q466 335 544 272Q622 209 762 209Q875 209 994 243Q1112 276 1237 344L1237 68Q1110 20 983 -5Q856 -29 729 -29Q425 -29 257 126Q88 280 88 559Q88 833 254 990Q419 1147 709 1147Q973 1147 1132 988Q1290 829 1290 563ZM922 682Q922 784 863 847Q803 909 707 909Q603 909 538 851Q473 792 457 682L922 682ZM594 1638L876 1262L680 1262L311 1638L594 1638Z"},
+		'é':      {1389, "M1290 563L1290 461L453 461Q466 335 544 272Q622 209 762 209Q875 209 994 243Q1112 276 1237 344L1237 68Q1110 20 983 -5Q856 -29 729 -29Q425 -29 257 126Q88 280 88 559Q88 833 254 990Q419 1147 709 1147Q973 1147 1132 988Q1290 829 1290 563ZM922 682Q922 784 863 847Q803 909 707 909Q603 909 538 851Q473 792 457 682L922 682ZM864 1638L1147 1638L778 1262L582 1262L864 1638Z"},
+		'ê':      {1389, "M1290 563L1290 461L453 461Q466 335 544 272Q622 209 762 209Q875 209 994 243Q1112 276 1237 344L1237 68Q1110 20 983 -5Q856 -29 729 -29Q425 -29 257 126Q88 280 88 559Q88 833 254 990Q419 1147 709 1147Q973 1147 1132 988Q1290 829 1290 563ZM922 682Q922 784 863 847Q803 909 707 909Q603 909 538 851Q473 792 457 682L922 682ZM608 1638L850 1638L1106 1262L928 1262L729 1487L530 1262L352 1262L608 1638Z"},
+		'ë':      {1389, "M1290 563L1290 461L453 461Q466 335 544 272Q622 209 762 209Q875 209 994 243Q1112 276 1237 344L1237 68Q1110 20 983 -5Q856 -29 729 -29Q425 -29 257 126Q88 280 88 559Q88 833 254 990Q419 1147 709 1147Q973 1147 1132 988Q1290 829 1290 563ZM922 682Q922 784 863 847Q803 909 707 909Q603 909 538 851Q473 792 457 682L922 682ZM414 1585L649 1585L649 1339L414 1339L414 1585ZM809 1585L1044 1585L1044 1339L809 1339L809 1585Z"},
+		'ì':      {702, "M172 1120L530 1120L530 0L172 0L172 1120ZM240 1638L522 1262L326 1262L-43 1638L240 1638Z"},
+		'í':      {702, "M172 1120L530 1120L530 0L172 0L172 1120ZM510 1638L793 1638L424 1262L228 1262L510 1638Z"},
+		'î':      {702, "M172 1120L530 1120L530 0L172 0L172 1120ZM229 1638L471 1638L727 1262L549 1262L350 1487L151 1262L-27 1262L229 1638Z"},
+		'ï':      {702, "M172 1120L530 1120L530 0L172 0L172 1120ZM35 1585L270 1585L270 1339L35 1339L35 1585ZM430 1585L665 1585L665 1339L430 1339L430 1585Z"},
+		'ð':      {1407, "M920 743Q865 770 811 784Q757 797 705 797Q588 797 525 731Q461 664 461 543Q461 395 526 311Q591 227 705 227Q822 227 884 313Q946 398 946 559Q946 604 940 650Q933 696 920 743ZM1096 1100Q1213 964 1266 836Q1319 707 1319 559Q1319 283 1157 127Q994 -29 705 -29Q415 -29 252 127Q88 283 88 559Q88 790 239 923Q389 1055 653 1055Q699 1055 738 1049Q777 1042 813 1028L623 1247L250 1128L213 1257L520 1354L332 1556L684 1556L795 1440L1171 1554L1206 1425L891 1329L1096 1100Z"},
+		'ñ':      {1458, "M1298 682L1298 0L938 0L938 111L938 522Q938 667 932 722Q925 777 909 803Q888 838 852 858Q816 877 770 877Q658 877 594 791Q530 704 530 551L530 0L172 0L172 1120L530 1120L530 956Q611 1054 702 1101Q793 1147 903 1147Q1097 1147 1198 1028Q1298 909 1298 682ZM756 1364L701 1401Q697 1403 691 1407Q644 1438 619 1438Q583 1438 564 1407Q545 1376 545 1317L545 1309L406 1309Q406 1445 458 1519Q509 1593 602 1593Q638 1593 675 1580Q711 1566 752 1536L813 1493Q835 1478 854 1470Q872 1462 887 1462Q923 1462 943 1494Q963 1526 963 1583L963 1591L1102 1591Q1102 1455 1051 1381Q999 1307 906 1307Q870 1307 837 1319Q803 1331 756 1364Z"},
+		'ò':      {1407, "M705 891Q586 891 524 806Q461 720 461 559Q461 398 524 313Q586 227 705 227Q822 227 884 313Q946 398 946 559Q946 720 884 806Q822 891 705 891ZM705 1147Q994 1147 1157 991Q1319 835 1319 559Q1319 283 1157 127Q994 -29 705 -29Q415 -29 252 127Q88 283 88 559Q88 835 252 991Q415 1147 705 1147ZM592 1638L874 1262L678 1262L309 1638L592 1638Z"},
+		'ó':      {1407, "M705 891Q586 891 524 806Q461 720 461 559Q461 398 524 313Q586 227 705 227Q822 227 884 313Q946 398 946 559Q946 720 884 806Q822 891 705 891ZM705 1147Q994 1147 1157 991Q1319 835 1319 559Q1319 283 1157 127Q994 -29 705 -29Q415 -29 252 127Q88 283 88 559Q88 835 252 991Q415 1147 705 1147ZM862 1638L1145 1638L776 1262L580 1262L862 1638Z"},
+		'ô':      {1407, "M705 891Q586 891 524 806Q461 720 461 559Q461 398 524 313Q586 227 705 227Q822 227 884 313Q946 398 946 559Q946 720 884 806Q822 891 705 891ZM705 1147Q994 1147 1157 991Q1319 835 1319 559Q1319 283 1157 127Q994 -29 705 -29Q415 -29 252 127Q88 283 88 559Q88 835 252 991Q415 1147 705 1147ZM582 1638L824 1638L1080 1262L902 1262L703 1487L504 1262L326 1262L582 1638Z"},
+		'õ':      {1407, "M705 891Q586 891 524 806Q461 720 461 559Q461 398 524 313Q586 227 705 227Q822 227 884 313Q946 398 946 559Q946 720 884 806Q822 891 705 891ZM705 1147Q994 1147 1157 991Q1319 835 1319 559Q1319 283 1157 127Q994 -29 705 -29Q415 -29 252 127Q88 283 88 559Q88 835 252 991Q415 1147 705 1147ZM704 1364L649 1401Q645 1403 639 1407Q592 1438 567 1438Q531 1438 512 1407Q493 1376 493 1317L493 1309L354 1309Q354 1445 406 1519Q457 1593 550 1593Q586 1593 623 1580Q659 1566 700 1536L761 1493Q783 1478 802 1470Q820 1462 835 1462Q871 1462 891 1494Q911 1526 911 1583L911 1591L1050 1591Q1050 1455 999 1381Q947 1307 854 1307Q818 1307 785 1319Q751 1331 704 1364Z"},
+		'ö':      {1407, "M705 891Q586 891 524 806Q461 720 461 559Q461 398 524 313Q586 227 705 227Q822 227 884 313Q946 398 946 559Q946 720 884 806Q822 891 705 891ZM705 1147Q994 1147 1157 991Q1319 835 1319 559Q1319 283 1157 127Q994 -29 705 -29Q415 -29 252 127Q88 283 88 559Q88 835 252 991Q415 1147 705 1147ZM387 1585L622 1585L622 1339L387 1339L387 1585ZM782 1585L1017 1585L1017 1339L782 1339L782 1585Z"},
+		'÷':      {1716, "M705 395L1012 395L1012 86L705 86L705 395ZM705 1198L1012 1198L1012 889L705 889L705 1198ZM217 760L1499 760L1499 524L217 524L217 760Z"},
+		'ø':      {1407, "M856 836Q827 864 790 878Q752 891 705 891Q586 891 524 806Q461 720 461 559Q461 518 465 486Q468 453 475 426L856 836ZM547 287Q578 257 618 242Q657 227 705 227Q822 227 884 313Q946 398 946 559Q946 602 943 636Q939 669 932 698L547 287ZM223 158Q156 236 122 336Q88 436 88 559Q88 835 252 991Q415 1147 705 1147Q811 1147 901 1125Q990 1103 1065 1059L1212 1217L1321 1116L1180 967Q1250 887 1285 786Q1319 685 1319 559Q1319 283 1157 127Q994 -29 705 -29Q597 -29 506 -7Q415 16 338 61L190 -94L78 0L223 158Z"},
+		'ù':      {1458, "M160 436L160 1120L520 1120L520 1008Q520 917 519 780Q518 642 518 596Q518 461 525 402Q532 342 549 315Q571 280 607 261Q642 242 688 242Q800 242 864 328Q928 414 928 567L928 1120L1286 1120L1286 0L928 0L928 162Q847 64 757 18Q666 -29 557 -29Q363 -29 262 90Q160 209 160 436ZM619 1638L901 1262L705 1262L336 1638L619 1638Z"},
+		'ú':      {1458, "M160 436L160 1120L520 1120L520 1008Q520 917 519 780Q518 642 518 596Q518 461 525 402Q532 342 549 315Q571 280 607 261Q642 242 688 242Q800 242 864 328Q928 414 928 567L928 1120L1286 1120L1286 0L928 0L928 162Q847 64 757 18Q666 -29 557 -29Q363 -29 262 90Q160 209 160 436ZM889 1638L1172 1638L803 1262L607 1262L889 1638Z"},
+		'û':      {1458, "M160 436L160 1120L520 1120L520 1008Q520 917 519 780Q518 642 518 596Q518 461 525 402Q532 342 549 315Q571 280 607 261Q642 242 688 242Q800 242 864 328Q928 414 928 567L928 1120L1286 1120L1286 0L928 0L928 162Q847 64 757 18Q666 -29 557 -29Q363 -29 262 90Q160 209 160 436ZM603 1638L845 1638L1101 1262L923 1262L724 1487L525 1262L347 1262L603 1638Z"},
+		'ü':      {1458, "M160 436L160 1120L520 1120L520 1008Q520 917 519 780Q518 642 518 596Q518 461 525 402Q532 342 549 315Q571 280 607 261Q642 242 688 242Q800 242 864 328Q928 414 928 567L928 1120L1286 1120L1286 0L928 0L928 162Q847 64 757 18Q666 -29 557 -29Q363 -29 262 90Q160 209 160 436ZM409 1585L644 1585L644 1339L409 1339L409 1585ZM804 1585L1039 1585L1039 1339L804 1339L804 1585Z"},
+		'ý':      {1335, "M25 1120L383 1120L684 360L940 1120L1298 1120L827 -106Q756 -293 662 -368Q567 -442 412 -442L205 -442L205 -207L317 -207Q408 -207 450 -178Q491 -149 514 -74L524 -43L25 1120ZM803 1638L1086 1638L717 1262L521 1262L803 1638Z"},
+		'þ':      {1466, "M530 162L530 -426L172 -426L172 1556L530 1556L530 956Q604 1054 694 1101Q784 1147 901 1147Q1108 1147 1241 983Q1374 818 1374 559Q1374 300 1241 136Q1108 -29 901 -29Q784 -29 694 18Q604 64 530 162ZM768 887Q653 887 592 803Q530 718 530 559Q530 400 592 316Q653 231 768 231Q883 231 944 315Q1004 399 1004 559Q1004 719 944 803Q883 887 768 887Z"},
+		'ÿ':      {1335, "M25 1120L383 1120L684 360L940 1120L1298 1120L827 -106Q756 -293 662 -368Q567 -442 412 -442L205 -442L205 -207L317 -207Q408 -207 450 -178Q491 -149 514 -74L524 -43L25 1120ZM353 1585L588 1585L588 1339L353 1339L353 1585ZM748 1585L983 1585L983 1339L748 1339L748 1585Z"},
+		'–':      {1024, "M110 690L914 690L914 432L110 432L110 690Z"},
+		'—':      {2048, "M110 690L1938 690L1938 432L110 432L110 690Z"},
+		'‘':      {778, "M551 856L211 856L211 1141L438 1493L651 1493L551 1141L551 856Z"},
+		'’':      {778, "M229 1493L569 1493L569 1208L342 856L129 856L229 1208L229 1493Z"},
+		'“':      {1346, "M1057 856L717 856L717 1139L944 1493L1157 1493L1057 1139L1057 856ZM551 856L211 856L211 1141L438 1493L651 1493L551 1141L551 856Z"},
+		'”':      {1346, "M289 1493L629 1493L629 1208L401 856L188 856L289 1208L289 1493ZM795 1493L1135 1493L1135 1206L907 856L694 856L795 1206L795 1493Z"},
+		'•':      {1309, "M295 762Q295 836 322 901Q348 966 399 1016Q452 1067 517 1094Q582 1120 655 1120Q728 1120 794 1093Q859 1066 909 1016Q961 964 988 900Q1014 835 1014 762Q1014 688 987 623Q960 557 909 506Q858 455 793 428Q727 401 653 401Q580 401 515 428Q450 455 399 506Q349 557 322 623Q295 688 295 762Z"},
+		'…':      {2048, "M1526 387L1886 387L1886 0L1526 0L1526 387ZM162 387L522 387L522 0L162 0L162 387ZM844 387L1204 387L1204 0L844 0L844 387Z"},
+		'→':      {1716, "M1616 712L1616 572L1223 179L1078 324L1276 522L117 522L117 762L1276 762L1078 960L1223 1105L1616 712Z"},
+		'←':      {1716, "M100 572L100 712L493 1105L638 960L440 762L1599 762L1599 522L440 522L638 324L493 179L100 572Z"},
+		'↑':      {1716, "M789 1500L929 1500L1322 1107L1177 962L979 1160L979 0L739 0L739 1160L541 962L396 1107L789 1500Z"},
+		'↓':      {1716, "M929 -7L789 -7L396 386L541 531L739 333L739 1493L979 1493L979 333L1177 531L1322 386L929 -7Z"},
+		'↔':      {1716, "M100 572L100 712L493 1105L638 960L440 762L1276 762L1078 960L1223 1105L1616 712L1616 572L1223 179L1078 324L1276 522L440 522L638 324L493 179L100 572Z"},
+		'⇒':      {1716, "M1321 567L1396 642L1321 717L117 717L117 877L1161 877L1078 960L1223 1105L1616 712L1616 572L1223 179L1078 324L1161 407L117 407L117 567L1321 567Z"},
+		'✓':      {1716, "M453 654Q492 654 512 590Q552 470 569 470Q582 470 596 490Q877 940 1116 1218Q1178 1290 1313 1290Q1345 1290 1356 1284Q1367 1278 1367 1269Q1367 1255 1334 1214Q948 750 618 234Q595 198 524 198Q452 198 439 204Q405 219 359 357Q307 510 307 549Q307 591 377 630Q420 654 453 654Z"},
+		'✗':      {1716, "M1272 1500Q1282 1500 1298 1484Q1315 1468 1333 1442Q1362 1493 1379 1493Q1394 1493 1420 1466Q1436 1449 1436 1428Q1436 1401 1414 1378Q1206 1152 1010 881Q1091 683 1234 444Q1246 424 1246 412Q1246 389 1216 364Q1216 364 1184 342Q1188 330 1188 310Q1188 280 1166 264Q1140 246 1128 246Q1102 246 1082 272Q962 426 834 634Q662 410 440 36Q408 -18 332 -18Q296 -18 292 24Q241 52 241 113Q241 193 252 224Q257 238 284 280Q488 598 716 864Q594 1154 554 1311Q545 1346 545 1356Q545 1370 563 1396Q582 1422 598 1422Q613 1422 636 1393Q645 1410 658 1422Q676 1438 704 1438Q736 1438 746 1408Q800 1240 888 1088Q1030 1272 1234 1476Q1258 1500 1272 1500Z"},
+	},
+}
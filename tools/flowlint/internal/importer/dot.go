@@ -0,0 +1,388 @@
+// Package importer converts diagrams written in other languages into
+// style-guide Mermaid flowcharts. Node types are inferred from shapes,
+// attributes and labels, then drawn with the shape and class the style
+// guide gives each type.
+package importer
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/user/flowlint/internal/dot"
+	"github.com/user/flowlint/internal/parser"
+	"github.com/user/flowlint/internal/styles"
+)
+
+// Options controls the generated flowchart
+type Options struct {
+	Guide *styles.Guide // classDefs, shapes and arrows; the built-in guide if nil
+}
+
+// dotDirections maps Graphviz rankdir values to flowchart directions,
+// for style guides that set no direction
+var dotDirections = map[string]string{
+	"TB": "TD",
+	"LR": "LR",
+	"BT": "BT",
+	"RL": "RL",
+}
+
+// dotShapes maps Graphviz shapes to the flowchart shape drawn like them,
+// whose node type the style guide then tells
+var dotShapes = map[string]string{
+	"cylinder":      "cylinder",
+	"doubleoctagon": "double_rectangle",
+	"box3d":         "double_rectangle",
+	"component":     "double_rectangle",
+	"diamond":       "diamond",
+	"hexagon":       "hexagon",
+	"invhouse":      "stadium",
+	"house":         "stadium",
+}
+
+// classTypes maps class names that are not type names to their type
+var classTypes = map[string]string{
+	"kafka": "kafka_topic",
+}
+
+// Keywords in labels that hint at a node type
+var (
+	databaseRe = regexp.MustCompile(`(?i)\b(db|database|postgres(ql)?|mysql|sql|mongo(db)?|dynamo(db)?|cassandra|store)\b`)
+	cacheRe    = regexp.MustCompile(`(?i)\b(cache|redis|memcached?)\b`)
+	kafkaRe    = regexp.MustCompile(`(?i)\b(kafka|topic|queue|sqs|rabbitmq|pubsub)\b`)
+	externalRe = regexp.MustCompile(`(?i)\b(external|third[- ]party|3rd[- ]party|vendor)\b`)
+	entryRe    = regexp.MustCompile(`(?i)(\b(endpoint|gateway|client|cron)\b|^(GET|POST|PUT|PATCH|DELETE) )`)
+	topicRe    = regexp.MustCompile(`^[a-z0-9_-]+(\.[a-z0-9_-]+)+$`)
+)
+
+// idRe matches the characters kept in node IDs; subgraph IDs may also
+// contain dashes, as in kafka-in
+var (
+	idRe         = regexp.MustCompile(`[^A-Za-z0-9_]+`)
+	subgraphIDRe = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+	plainLabelRe = regexp.MustCompile(`^[A-Za-z0-9 _.,:/'-]+$`)
+	htmlTagRe    = regexp.MustCompile(`<[^>]*>`)
+)
+
+// FromDOT translates a DOT graph into flowchart code. Clusters become
+// subgraphs, node types are inferred from shapes, attributes and labels,
+// and edges are drawn as sync (==>), async (-.->) or internal (-->)
+// links. The flowchart runs in the style guide's direction; rankdir is
+// only used when the guide sets none. The result is in canonical form.
+func FromDOT(g *dot.Graph, opts Options) (string, error) {
+	guide := opts.Guide
+	if guide == nil {
+		guide = styles.DefaultGuide()
+	}
+	c := &converter{g: g, guide: guide, ids: make(map[string]string), used: make(map[string]bool), types: make(map[string]string), fills: fillTypes(guide.ClassDefLines())}
+	code := c.convert()
+
+	d, err := parser.ParseMermaid(code)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse converted diagram: %w", err)
+	}
+	return parser.Format(d)
+}
+
+type converter struct {
+	g     *dot.Graph
+	guide *styles.Guide
+	ids   map[string]string // DOT node and cluster IDs to mermaid IDs
+	used  map[string]bool
+	types map[string]string // node types by DOT ID
+	fills map[string]string // node types by classDef fill color
+	b     strings.Builder
+}
+
+func (c *converter) convert() string {
+	// The style guide's direction wins over rankdir, so the result
+	// follows the guide
+	direction := strings.ToUpper(c.guide.Layout.Direction)
+	if direction == "" {
+		var ok bool
+		if direction, ok = dotDirections[strings.ToUpper(c.g.Attrs["rankdir"])]; !ok {
+			direction = "TD"
+		}
+	}
+	fmt.Fprintf(&c.b, "flowchart %s\n", direction)
+	for _, def := range c.guide.ClassDefLines() {
+		fmt.Fprintf(&c.b, "    %s\n", def)
+	}
+
+	// Clusters first, so a node named like a cluster gets the suffix
+	for _, cl := range c.g.Clusters {
+		c.ids[cl.ID] = c.assign(clusterID(cl), subgraphIDRe)
+	}
+	for _, n := range c.g.Nodes {
+		c.ids[n.ID] = c.assign(n.ID, idRe)
+		c.types[n.ID] = c.inferType(n)
+	}
+
+	c.writeLevel("", 1)
+	for _, e := range c.g.Edges {
+		c.writeEdge(e)
+	}
+	c.writeClasses()
+	return c.b.String()
+}
+
+// assign turns a DOT ID into a unique mermaid ID
+func (c *converter) assign(id string, invalid *regexp.Regexp) string {
+	base := strings.Trim(invalid.ReplaceAllString(id, "_"), "_")
+	if base == "" {
+		base = "N"
+	}
+	if parser.IsReservedID(base) {
+		base += "_"
+	}
+	mermaidID := base
+	for n := 2; c.used[mermaidID]; n++ {
+		mermaidID = fmt.Sprintf("%s_%d", base, n)
+	}
+	c.used[mermaidID] = true
+	return mermaidID
+}
+
+// clusterID drops the "cluster" prefix Graphviz requires. Clusters
+// named only by a number, like cluster0, are named after their label.
+func clusterID(cl *dot.Cluster) string {
+	id := strings.TrimLeft(strings.TrimPrefix(cl.ID, "cluster"), "_-")
+	if strings.Trim(id, "0123456789") != "" {
+		return id
+	}
+	if label := cleanLabel(cl.Attrs["label"], cl.ID); label != "" {
+		return strings.ToLower(label)
+	}
+	return "group" + id
+}
+
+// writeLevel writes the clusters and nodes directly inside a cluster, ""
+// for the top level
+func (c *converter) writeLevel(parent string, depth int) {
+	indent := strings.Repeat("    ", depth)
+	for _, cl := range c.g.Clusters {
+		if cl.Parent != parent {
+			continue
+		}
+		title := cleanLabel(cl.Attrs["label"], cl.ID)
+		if title == "" {
+			title = c.ids[cl.ID]
+		}
+		fmt.Fprintf(&c.b, "%ssubgraph %s [\"%s\"]\n", indent, c.ids[cl.ID], quoteSafe(title))
+		c.writeLevel(cl.ID, depth+1)
+		fmt.Fprintf(&c.b, "%send\n", indent)
+	}
+	for _, n := range c.g.Nodes {
+		if n.Cluster != parent {
+			continue
+		}
+		shape := styles.Shapes[c.shapeOf(c.types[n.ID])]
+		fmt.Fprintf(&c.b, "%s%s%s%s%s\n", indent, c.ids[n.ID], shape.Open, nodeLabel(n), shape.Close)
+	}
+}
+
+// writeEdge writes an edge, pointing it at a subgraph when Graphviz clips
+// it to a cluster with lhead or ltail
+func (c *converter) writeEdge(e *dot.Edge) {
+	from, to := c.ids[e.From], c.ids[e.To]
+	if id, ok := c.ids[e.Attrs["ltail"]]; ok {
+		from = id
+	}
+	if id, ok := c.ids[e.Attrs["lhead"]]; ok {
+		to = id
+	}
+	if e.Attrs["dir"] == "back" {
+		from, to = to, from
+	}
+
+	arrow := c.guide.ArrowOf(c.edgeKind(e))
+	if strings.Contains(e.Attrs["style"], "invis") {
+		arrow = "~~~"
+	}
+	label := ""
+	if l := cleanLabel(e.Attrs["label"], ""); l != "" {
+		label = "|" + l + "|"
+		if !plainLabelRe.MatchString(l) {
+			label = "|\"" + quoteSafe(l) + "\"|"
+		}
+	}
+	fmt.Fprintf(&c.b, "    %s %s%s %s\n", from, arrow, label, to)
+}
+
+// edgeKind classifies an edge as sync, async or internal. An explicit
+// dashed or bold line decides; otherwise edges touching a topic or with
+// a messaging label are async, edges within one cluster internal and the
+// rest sync.
+func (c *converter) edgeKind(e *dot.Edge) string {
+	style := e.Attrs["style"]
+	switch {
+	case strings.Contains(style, "dashed") || strings.Contains(style, "dotted"):
+		return "async"
+	case strings.Contains(style, "bold"):
+		return "sync"
+	}
+	if width, err := strconv.ParseFloat(e.Attrs["penwidth"], 64); err == nil && width >= 2 {
+		return "sync"
+	}
+	if c.types[e.From] == "kafka_topic" || c.types[e.To] == "kafka_topic" || kafkaRe.MatchString(e.Attrs["label"]) {
+		return "async"
+	}
+	from, to := c.g.Node(e.From), c.g.Node(e.To)
+	if from != nil && to != nil && from.Cluster != "" && from.Cluster == to.Cluster && e.Attrs["lhead"] == "" && e.Attrs["ltail"] == "" {
+		return "internal"
+	}
+	return "sync"
+}
+
+// writeClasses assigns each node the class of its type, grouped by class
+func (c *converter) writeClasses() {
+	members := make(map[string][]string)
+	for _, n := range c.g.Nodes {
+		if class, ok := styles.NodeTypeToClass[c.types[n.ID]]; ok {
+			members[class] = append(members[class], c.ids[n.ID])
+		}
+	}
+	classes := make([]string, 0, len(members))
+	for class := range members {
+		classes = append(classes, class)
+	}
+	sort.Strings(classes)
+	for _, class := range classes {
+		fmt.Fprintf(&c.b, "    class %s %s\n", strings.Join(members[class], ","), class)
+	}
+}
+
+// inferType decides what a node stands for. A class or type attribute
+// naming a node type wins, then a fill color from the palette, then the
+// type the style guide draws with the flowchart shape closest to the DOT
+// one, then keywords in the label. Nodes nothing else matches are
+// services.
+func (c *converter) inferType(n *dot.Node) string {
+	for _, key := range []string{"type", "class"} {
+		for _, value := range strings.Fields(strings.ToLower(n.Attrs[key])) {
+			if t := typeNamed(value); t != "" {
+				return t
+			}
+		}
+	}
+
+	fill := n.Attrs["fillcolor"]
+	if fill == "" && strings.Contains(n.Attrs["style"], "filled") {
+		fill = n.Attrs["color"]
+	}
+	if t, ok := c.fills[parser.NormalizeColor(fill)]; ok {
+		return t
+	}
+
+	label := cleanLabel(n.Label(), n.ID)
+	shape := dotShapes[strings.ToLower(n.Attrs["shape"])]
+	if peripheries, _ := strconv.Atoi(n.Attrs["peripheries"]); peripheries >= 2 {
+		shape = "double_rectangle"
+	}
+	switch strings.ToLower(n.Attrs["shape"]) {
+	case "cds", "rarrow", "larrow":
+		return "kafka_topic"
+	case "cylinder":
+		if kafkaRe.MatchString(label) {
+			return "kafka_topic"
+		}
+	}
+	if t := c.guide.TypeOfShape(shape, label, c.hasIncoming(n.ID)); t != "" {
+		return t
+	}
+	if strings.Contains(strings.ToLower(n.Attrs["style"]), "rounded") && cacheRe.MatchString(label) {
+		return "cache"
+	}
+
+	switch {
+	case cacheRe.MatchString(label):
+		return "cache"
+	case kafkaRe.MatchString(label) || topicRe.MatchString(label):
+		return "kafka_topic"
+	case databaseRe.MatchString(label):
+		return "database"
+	case externalRe.MatchString(label):
+		return "external"
+	case entryRe.MatchString(label) && !c.hasIncoming(n.ID):
+		return "entry"
+	}
+	return "service"
+}
+
+// typeNamed returns the node type a type or class name refers to
+func typeNamed(name string) string {
+	name = strings.ReplaceAll(name, "-", "_")
+	if _, ok := styles.NodeTypeToShape[name]; ok {
+		return name
+	}
+	if _, ok := styles.NodeTypeToClass[name]; ok {
+		return name
+	}
+	return classTypes[name]
+}
+
+// fillTypes maps the fill color of each classDef to the type its class
+// stands for, so nodes exported with palette colors keep their type
+func fillTypes(classDefs []string) map[string]string {
+	fills := make(map[string]string)
+	for _, def := range classDefs {
+		fields := strings.Fields(def)
+		if len(fields) < 3 {
+			continue
+		}
+		t := typeNamed(fields[1])
+		fill := parser.ParseStyleProps(styles.ClassDefStyles(def))["fill"]
+		if t != "" && fill != "" {
+			fills[parser.NormalizeColor(fill)] = t
+		}
+	}
+	return fills
+}
+
+func (c *converter) hasIncoming(id string) bool {
+	for _, e := range c.g.Edges {
+		if e.To == id {
+			return true
+		}
+	}
+	return false
+}
+
+// shapeOf returns the shape the style guide draws a node type with
+func (c *converter) shapeOf(t string) string {
+	if shape := c.guide.ShapeOf(t); shape != "" {
+		if _, known := styles.Shapes[shape]; known {
+			return shape
+		}
+	}
+	return "rectangle"
+}
+
+// nodeLabel writes a node's label, quoted unless it is plain text
+func nodeLabel(n *dot.Node) string {
+	label := cleanLabel(n.Label(), n.ID)
+	if label == "" {
+		label = n.ID
+	}
+	if plainLabelRe.MatchString(label) {
+		return label
+	}
+	return `"` + quoteSafe(label) + `"`
+}
+
+// cleanLabel turns a DOT label into single-line text: escapes and HTML
+// tags are dropped, record fields joined and \N replaced by the node ID
+func cleanLabel(label, id string) string {
+	label = strings.NewReplacer(`\N`, id, `\n`, " ", `\l`, " ", `\r`, " ", `\G`, "", `\E`, "", `\T`, "", `\H`, "").Replace(label)
+	label = htmlTagRe.ReplaceAllString(label, " ")
+	label = strings.NewReplacer("{", " ", "}", " ", "|", " ", `\`, "").Replace(label)
+	return strings.Join(strings.Fields(label), " ")
+}
+
+// quoteSafe replaces double quotes, which mermaid strings cannot hold
+func quoteSafe(s string) string {
+	return strings.ReplaceAll(s, `"`, "'")
+}
@@ -0,0 +1,78 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/user/flowlint/internal/dot"
+	"github.com/user/flowlint/internal/styles"
+)
+
+// convert parses DOT source and converts it with the given guide
+func convert(t *testing.T, source string, guide *styles.Guide) string {
+	t.Helper()
+	g, err := dot.Parse(source)
+	if err != nil {
+		t.Fatalf("dot.Parse: %v", err)
+	}
+	code, err := FromDOT(g, Options{Guide: guide})
+	if err != nil {
+		t.Fatalf("FromDOT: %v", err)
+	}
+	return code
+}
+
+func TestFromDOTDirection(t *testing.T) {
+	noDirection := styles.DefaultGuide()
+	noDirection.Layout.Direction = ""
+	lr := styles.DefaultGuide()
+	lr.Layout.Direction = "LR"
+
+	tests := []struct {
+		name   string
+		source string
+		guide  *styles.Guide
+		want   string
+	}{
+		{"guide direction wins over rankdir", "digraph { rankdir=LR; a -> b }", nil, "flowchart TD"},
+		{"guide direction without rankdir", "digraph { a -> b }", lr, "flowchart LR"},
+		{"rankdir without a guide direction", "digraph { rankdir=BT; a -> b }", noDirection, "flowchart BT"},
+		{"neither", "digraph { a -> b }", noDirection, "flowchart TD"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code := convert(t, tt.source, tt.guide)
+			if header, _, _ := strings.Cut(code, "\n"); header != tt.want {
+				t.Errorf("header = %q, want %q", header, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromDOTShapeTypes(t *testing.T) {
+	code := convert(t, `digraph {
+		api [shape=invhouse label="POST /pay"]
+		group [shape=house label="pay-consumers"]
+		db [shape=cylinder label="payments"]
+		topic [shape=cylinder label="pay.done"]
+		stripe [shape=doubleoctagon label="Stripe"]
+		api -> db
+		topic -> group
+	}`, nil)
+
+	for _, want := range []string{
+		"api([POST /pay])",
+		"group([pay-consumers])",
+		"db[(payments)]",
+		"topic[(pay.done)]",
+		"stripe[[Stripe]]",
+		"class api entry",
+		"class db database",
+		"class group,topic kafka",
+		"class stripe external",
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("converted code lacks %q:\n%s", want, code)
+		}
+	}
+}
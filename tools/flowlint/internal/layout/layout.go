@@ -0,0 +1,457 @@
+// Package layout places the nodes, subgraphs and edges of a flowchart with
+// a layered (Sugiyama-style) algorithm:
+//
+//  1. Ranking: nodes are assigned to layers along the diagram direction by
+//     longest path, after reversing the edges that close cycles. An edge
+//     to or from a subgraph constrains every node inside it.
+//  2. Ordering: within each layer, nodes and subgraphs are ordered by the
+//     barycenter of their neighbors in the layers above, then of those
+//     below, in alternating sweeps to reduce edge crossings. A subgraph's
+//     members are always kept together.
+//  3. Placement: nodes and subgraph boxes are packed along the cross axis
+//     so that no two boxes overlap.
+//  4. Routing: edges are drawn as straight lines between box borders.
+//
+// Direction statements inside subgraphs are not honored: every layer
+// follows the diagram direction. The result is deterministic. Besides coordinates it reports layout
+// metrics such as edge crossings, which lint rules can reuse.
+package layout
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/user/flowlint/internal/font"
+	"github.com/user/flowlint/internal/parser"
+)
+
+// Label text metrics shared with renderers, in pixels
+const (
+	FontSize   = 14.0
+	LineHeight = 18.0 // height of a label line
+)
+
+// Geometry defaults, in pixels
+const (
+	nodePadX    = 16.0
+	nodePadY    = 12.0
+	minNodeW    = 80.0
+	nodeGap     = 40.0 // between boxes along the cross axis
+	rankGap     = 50.0 // between layers
+	clusterPad  = 16.0
+	clusterGap  = 20.0 // between subgraph boxes along the rank axis
+	titleHeight = 24.0
+	margin      = 20.0
+)
+
+// orderPasses is the number of barycenter passes used to reduce crossings
+const orderPasses = 8
+
+// Point is a position in the drawing
+type Point struct {
+	X, Y float64
+}
+
+// Rect is an axis-aligned box
+type Rect struct {
+	X, Y, W, H float64
+}
+
+// Center returns the center of the box
+func (r Rect) Center() Point {
+	return Point{X: r.X + r.W/2, Y: r.Y + r.H/2}
+}
+
+// Contains reports whether p lies inside the box
+func (r Rect) Contains(p Point) bool {
+	return p.X >= r.X && p.X <= r.X+r.W && p.Y >= r.Y && p.Y <= r.Y+r.H
+}
+
+// Node is a placed node
+type Node struct {
+	ID    string
+	Rect  Rect
+	Rank  int
+	Lines []string // label lines
+	Node  *parser.Node
+}
+
+// Cluster is a placed subgraph
+type Cluster struct {
+	ID       string
+	Title    string
+	Rect     Rect
+	Depth    int // 0 for top-level subgraphs
+	Subgraph *parser.Subgraph
+}
+
+// Edge is a routed edge
+type Edge struct {
+	Edge      *parser.Edge
+	Points    []Point
+	Label     Point    // center of the label
+	RankSpan  int      // layers between the endpoints; more than 1 is a long edge
+	Crossings int      // other edges this one crosses
+	Pierces   []string // subgraphs the edge passes through without an endpoint inside
+}
+
+// Visible reports whether the edge is drawn: ~~~ links only affect layout
+func (e *Edge) Visible() bool {
+	return e.Edge.Stroke != parser.StrokeInvisible
+}
+
+// Metrics summarizes the quality of a layout
+type Metrics struct {
+	Ranks     int // number of layers
+	Crossings int // pairs of edges that cross
+	LongEdges int // edges spanning more than one layer
+	Piercings int // edge and subgraph pairs where the edge cuts through the subgraph
+}
+
+// Layout is a placed diagram
+type Layout struct {
+	Direction string // TB, BT, LR or RL
+	Width     float64
+	Height    float64
+	Nodes     []*Node
+	Clusters  []*Cluster // outermost first
+	Edges     []*Edge
+	Metrics   Metrics
+	Diagram   *parser.Diagram
+
+	nodes    map[string]*Node
+	clusters map[string]*Cluster
+}
+
+// Node returns the placed node with the given ID, or nil
+func (l *Layout) Node(id string) *Node {
+	return l.nodes[id]
+}
+
+// Cluster returns the placed subgraph with the given ID, or nil
+func (l *Layout) Cluster(id string) *Cluster {
+	return l.clusters[id]
+}
+
+// Compute lays out a diagram
+func Compute(d *parser.Diagram) *Layout {
+	g := newGraph(d)
+	g.rank()
+
+	root := g.blocks()
+	l := g.place(root)
+	best := l
+	for pass := 0; pass < orderPasses; pass++ {
+		l = g.reorder(root, root, l, pass%2 == 0)
+		if better(l.Metrics, best.Metrics) {
+			best = l
+		}
+	}
+	return best
+}
+
+// Declared lays out a diagram keeping nodes and subgraphs in source
+// order, as a renderer that does not reorder them would. Comparing it
+// with Compute shows what reordering the declarations gains.
+func Declared(d *parser.Diagram) *Layout {
+	g := newGraph(d)
+	g.rank()
+	return g.place(g.blocks())
+}
+
+// Order returns the nodes directly inside a subgraph, or at the top level
+// for "", in the order the layout places them across the diagram
+// direction
+func (l *Layout) Order(subgraphID string) []string {
+	nodes := []*Node{}
+	for _, n := range l.Nodes {
+		if n.Node.Subgraph == subgraphID {
+			nodes = append(nodes, n)
+		}
+	}
+	horizontal := l.Direction == "LR" || l.Direction == "RL"
+	sort.SliceStable(nodes, func(i, j int) bool {
+		a, b := nodes[i].Rect.Center(), nodes[j].Rect.Center()
+		if horizontal {
+			return a.Y < b.Y
+		}
+		return a.X < b.X
+	})
+	ids := make([]string, len(nodes))
+	for i, n := range nodes {
+		ids[i] = n.ID
+	}
+	return ids
+}
+
+// better reports whether metrics a describe a better layout than b
+func better(a, b Metrics) bool {
+	if a.Crossings != b.Crossings {
+		return a.Crossings < b.Crossings
+	}
+	return a.Piercings < b.Piercings
+}
+
+// graph is the working state of a layout
+type graph struct {
+	d          *parser.Diagram
+	dir        string
+	horizontal bool
+	ids        []string            // node IDs in source order
+	succ       map[string][]string // ranking constraints
+	pred       map[string][]string
+	ranks      map[string]int
+	maxRank    int
+	size       map[string]Point // node width and height
+	depth      int              // deepest subgraph nesting
+}
+
+func newGraph(d *parser.Diagram) *graph {
+	g := &graph{
+		d:     d,
+		dir:   direction(d.Direction),
+		succ:  make(map[string][]string),
+		pred:  make(map[string][]string),
+		ranks: make(map[string]int),
+		size:  make(map[string]Point),
+	}
+	g.horizontal = g.dir == "LR" || g.dir == "RL"
+
+	for id := range d.Nodes {
+		g.ids = append(g.ids, id)
+	}
+	sort.Slice(g.ids, func(i, j int) bool {
+		a, b := d.Nodes[g.ids[i]].Loc, d.Nodes[g.ids[j]].Loc
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		if a.Col != b.Col {
+			return a.Col < b.Col
+		}
+		return g.ids[i] < g.ids[j]
+	})
+
+	for _, id := range g.ids {
+		g.size[id] = nodeSize(d.Nodes[id])
+	}
+	for _, sg := range d.Subgraphs {
+		if n := len(d.Ancestors(sg.ID)) + 1; n > g.depth {
+			g.depth = n
+		}
+	}
+	return g
+}
+
+// direction normalizes a diagram direction; mermaid defaults to TB
+func direction(dir string) string {
+	switch dir {
+	case "LR", "RL", "BT":
+		return dir
+	}
+	return "TB"
+}
+
+// nodeSize returns the width and height of a node's shape
+func nodeSize(node *parser.Node) Point {
+	lines := strings.Split(node.Label, "\n")
+	longest := 0.0
+	for _, line := range lines {
+		longest = math.Max(longest, TextWidth(strings.TrimSpace(line)))
+	}
+	w := math.Max(minNodeW, longest+2*nodePadX)
+	h := float64(len(lines))*LineHeight + 2*nodePadY
+
+	switch node.Shape {
+	case "circle", "double_circle":
+		side := math.Max(w, h)
+		w, h = side, side
+	case "diamond":
+		w, h = w*1.4, h*1.6
+	case "hexagon":
+		w += h * 0.6
+	case "parallelogram", "parallelogram_alt", "trapezoid", "trapezoid_alt":
+		w += h * 0.8
+	case "cylinder":
+		h += 16
+	case "stadium", "asymmetric":
+		w += h / 2
+	}
+	return Point{X: w, Y: h}
+}
+
+// TextWidth returns the width of a line of label text
+func TextWidth(text string) float64 {
+	return font.Width(text, FontSize, false)
+}
+
+// TitleWidth returns the width of a subgraph title, which is bold
+func TitleWidth(text string) float64 {
+	return font.Width(text, FontSize, true)
+}
+
+// extent returns a node's size along the cross axis and the rank axis
+func (g *graph) extent(id string) (cross, along float64) {
+	s := g.size[id]
+	if g.horizontal {
+		return s.Y, s.X
+	}
+	return s.X, s.Y
+}
+
+// members returns the nodes an edge endpoint stands for: the node itself,
+// or every node inside a subgraph
+func (g *graph) members(id string) []string {
+	if _, ok := g.d.Nodes[id]; ok {
+		return []string{id}
+	}
+	sg := g.d.FindSubgraph(id)
+	if sg == nil {
+		return nil
+	}
+	ids := append([]string{}, sg.Nodes...)
+	for _, child := range sg.Children {
+		ids = append(ids, g.members(child)...)
+	}
+	return ids
+}
+
+// inside reports whether the node lies in the subgraph or one nested in it
+func (g *graph) inside(nodeID, subgraphID string) bool {
+	node, ok := g.d.Nodes[nodeID]
+	if !ok || node.Subgraph == "" {
+		return false
+	}
+	if node.Subgraph == subgraphID {
+		return true
+	}
+	for _, id := range g.d.Ancestors(node.Subgraph) {
+		if id == subgraphID {
+			return true
+		}
+	}
+	return false
+}
+
+// rank assigns layers. Edges closing a cycle are reversed, then each node
+// is placed one layer below its lowest predecessor. Nodes without
+// predecessors are finally moved down next to their successors.
+func (g *graph) rank() {
+	seen := make(map[[2]string]bool)
+	addEdge := func(from, to string) {
+		if from == to || seen[[2]string{from, to}] {
+			return
+		}
+		seen[[2]string{from, to}] = true
+		g.succ[from] = append(g.succ[from], to)
+	}
+	for _, e := range g.d.Edges {
+		for _, from := range g.members(e.From) {
+			for _, to := range g.members(e.To) {
+				// Skip edges between a subgraph and its own members
+				if g.inside(from, e.To) || g.inside(to, e.From) {
+					continue
+				}
+				addEdge(from, to)
+			}
+		}
+	}
+
+	// Reverse back edges found by a depth-first search in source order
+	state := make(map[string]int) // 0 new, 1 on stack, 2 done
+	reversed := [][2]string{}
+	var visit func(id string)
+	visit = func(id string) {
+		state[id] = 1
+		kept := []string{}
+		for _, to := range g.succ[id] {
+			switch state[to] {
+			case 1:
+				reversed = append(reversed, [2]string{to, id})
+				continue
+			case 0:
+				visit(to)
+			}
+			kept = append(kept, to)
+		}
+		g.succ[id] = kept
+		state[id] = 2
+	}
+	for _, id := range g.ids {
+		if state[id] == 0 {
+			visit(id)
+		}
+	}
+	for _, e := range reversed {
+		addEdge(e[0], e[1])
+	}
+	for _, id := range g.ids {
+		for _, to := range g.succ[id] {
+			g.pred[to] = append(g.pred[to], id)
+		}
+	}
+
+	// Longest path in topological order
+	order := g.topoOrder()
+	for _, id := range order {
+		r := 0
+		for _, p := range g.pred[id] {
+			if g.ranks[p]+1 > r {
+				r = g.ranks[p] + 1
+			}
+		}
+		g.ranks[id] = r
+	}
+
+	// Pull sources down next to their nearest successor
+	for i := len(order) - 1; i >= 0; i-- {
+		id := order[i]
+		if len(g.pred[id]) > 0 || len(g.succ[id]) == 0 {
+			continue
+		}
+		r := math.MaxInt
+		for _, s := range g.succ[id] {
+			if g.ranks[s]-1 < r {
+				r = g.ranks[s] - 1
+			}
+		}
+		if r > g.ranks[id] {
+			g.ranks[id] = r
+		}
+	}
+
+	for _, id := range g.ids {
+		if g.ranks[id] > g.maxRank {
+			g.maxRank = g.ranks[id]
+		}
+	}
+}
+
+// topoOrder returns the node IDs in topological order, ties broken by
+// source order
+func (g *graph) topoOrder() []string {
+	indegree := make(map[string]int)
+	for _, id := range g.ids {
+		indegree[id] = len(g.pred[id])
+	}
+	order := []string{}
+	done := make(map[string]bool)
+	for len(order) < len(g.ids) {
+		progressed := false
+		for _, id := range g.ids {
+			if done[id] || indegree[id] > 0 {
+				continue
+			}
+			done[id] = true
+			order = append(order, id)
+			for _, s := range g.succ[id] {
+				indegree[s]--
+			}
+			progressed = true
+		}
+		if !progressed {
+			break
+		}
+	}
+	return order
+}
@@ -0,0 +1,84 @@
+package layout
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/user/flowlint/internal/parser"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// dump writes a layout as text, one line per element, with coordinates
+// rounded to a tenth of a pixel
+func dump(l *Layout) string {
+	var b strings.Builder
+	rect := func(r Rect) string {
+		return fmt.Sprintf("%.1f,%.1f %.1fx%.1f", r.X, r.Y, r.W, r.H)
+	}
+	fmt.Fprintf(&b, "direction %s size %.1fx%.1f\n", l.Direction, l.Width, l.Height)
+	fmt.Fprintf(&b, "metrics ranks=%d crossings=%d long=%d piercings=%d\n",
+		l.Metrics.Ranks, l.Metrics.Crossings, l.Metrics.LongEdges, l.Metrics.Piercings)
+	for _, c := range l.Clusters {
+		fmt.Fprintf(&b, "cluster %s depth %d at %s %q\n", c.ID, c.Depth, rect(c.Rect), c.Title)
+	}
+	for _, n := range l.Nodes {
+		fmt.Fprintf(&b, "node %s rank %d at %s %q\n", n.ID, n.Rank, rect(n.Rect), n.Lines)
+	}
+	for _, e := range l.Edges {
+		points := make([]string, len(e.Points))
+		for i, p := range e.Points {
+			points[i] = fmt.Sprintf("%.1f,%.1f", p.X, p.Y)
+		}
+		fmt.Fprintf(&b, "edge %s %s %s span %d crossings %d via %s label %.1f,%.1f",
+			e.Edge.From, e.Edge.RawArrow, e.Edge.To, e.RankSpan, e.Crossings,
+			strings.Join(points, " "), e.Label.X, e.Label.Y)
+		if len(e.Pierces) > 0 {
+			fmt.Fprintf(&b, " pierces %s", strings.Join(e.Pierces, ","))
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+func TestComputeGolden(t *testing.T) {
+	inputs, err := filepath.Glob(filepath.Join("testdata", "*.mmd"))
+	if err != nil || len(inputs) == 0 {
+		t.Fatalf("no inputs in testdata: %v", err)
+	}
+	for _, input := range inputs {
+		name := strings.TrimSuffix(filepath.Base(input), ".mmd")
+		t.Run(name, func(t *testing.T) {
+			code, err := os.ReadFile(input)
+			if err != nil {
+				t.Fatal(err)
+			}
+			d, err := parser.ParseMermaid(string(code))
+			if err != nil {
+				t.Fatalf("ParseMermaid: %v", err)
+			}
+			got := dump(Compute(d))
+			if again := dump(Compute(d)); again != got {
+				t.Errorf("second layout differs:\n%s\nfirst:\n%s", again, got)
+			}
+
+			golden := filepath.Join("testdata", name+".golden")
+			if *update {
+				if err := os.WriteFile(golden, []byte(got), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatalf("%v (run go test -update to create it)", err)
+			}
+			if got != string(want) {
+				t.Errorf("layout differs from %s:\n%s\nwant:\n%s", golden, got, want)
+			}
+		})
+	}
+}
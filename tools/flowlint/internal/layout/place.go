@@ -0,0 +1,364 @@
+package layout
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/user/flowlint/internal/parser"
+)
+
+// block is a node or a subgraph being placed. Subgraph blocks hold their
+// member nodes and nested subgraphs; the root block holds the top level.
+type block struct {
+	node     string           // node ID of a leaf
+	sg       *parser.Subgraph // subgraph of a cluster, nil for leaves and the root
+	children []*block
+	line     int // source line, for the initial order
+
+	// Set by pack
+	offset      float64 // cross-axis offset within the parent's content
+	cross       float64 // size along the cross axis
+	top, bottom float64 // interval along the rank axis
+	inset       float64 // cross-axis offset of the content within the box
+}
+
+// blocks builds the block tree of the diagram, children in source order.
+// A subgraph ID is placed once: a later subgraph reusing it, which could
+// otherwise nest the tree inside itself, adds its nodes to the first.
+func (g *graph) blocks() *block {
+	byParent := make(map[string][]*block)
+	for _, id := range g.ids {
+		node := g.d.Nodes[id]
+		byParent[node.Subgraph] = append(byParent[node.Subgraph], &block{node: id, line: node.Line})
+	}
+	for _, sg := range g.d.Subgraphs {
+		byParent[sg.Parent] = append(byParent[sg.Parent], &block{sg: sg, line: sg.Line})
+	}
+
+	placed := make(map[string]bool)
+	var build func(b *block, id string)
+	build = func(b *block, id string) {
+		for _, child := range byParent[id] {
+			if child.sg != nil {
+				if placed[child.sg.ID] {
+					continue
+				}
+				placed[child.sg.ID] = true
+			}
+			b.children = append(b.children, child)
+		}
+		sort.SliceStable(b.children, func(i, j int) bool {
+			return b.children[i].line < b.children[j].line
+		})
+		for _, child := range b.children {
+			if child.sg != nil {
+				build(child, child.sg.ID)
+			}
+		}
+	}
+	root := &block{}
+	build(root, "")
+	return root
+}
+
+// nodeIDs returns the IDs of the nodes in a block
+func (b *block) nodeIDs() []string {
+	if b.node != "" {
+		return []string{b.node}
+	}
+	ids := []string{}
+	for _, child := range b.children {
+		ids = append(ids, child.nodeIDs()...)
+	}
+	return ids
+}
+
+// rankPositions returns where each layer starts along the rank axis and
+// how deep it is. Layers are spaced so that nested subgraph borders and
+// titles fit between them.
+func (g *graph) rankPositions() ([]float64, []float64) {
+	depth := make([]float64, g.maxRank+1)
+	for _, id := range g.ids {
+		_, along := g.extent(id)
+		if r := g.ranks[id]; along > depth[r] {
+			depth[r] = along
+		}
+	}
+
+	// Between two layers a node may leave every subgraph it is in and
+	// the next one enter as many
+	gap := float64(g.depth) * 2 * clusterPad
+	if !g.horizontal {
+		gap += float64(g.depth) * titleHeight
+	}
+	gap = math.Max(rankGap, gap+clusterGap)
+
+	pos := make([]float64, g.maxRank+1)
+	for r := 1; r <= g.maxRank; r++ {
+		pos[r] = pos[r-1] + depth[r-1] + gap
+	}
+	return pos, depth
+}
+
+// pack sizes a block and places its children along the cross axis. Each
+// child goes at the smallest offset where it overlaps no child already
+// placed that shares part of its rank-axis interval.
+func (g *graph) pack(b *block, pos, depth []float64) {
+	if b.node != "" {
+		cross, along := g.extent(b.node)
+		r := g.ranks[b.node]
+		b.cross = cross
+		b.top = pos[r] + (depth[r]-along)/2
+		b.bottom = b.top + along
+		return
+	}
+
+	placed := []*block{}
+	content := 0.0
+	top, bottom := math.Inf(1), math.Inf(-1)
+	for _, child := range b.children {
+		g.pack(child, pos, depth)
+
+		candidates := []float64{0}
+		for _, p := range placed {
+			candidates = append(candidates, p.offset+p.cross+nodeGap)
+		}
+		sort.Float64s(candidates)
+		for _, c := range candidates {
+			if !collides(child, c, placed) {
+				child.offset = c
+				break
+			}
+		}
+		placed = append(placed, child)
+
+		content = math.Max(content, child.offset+child.cross)
+		top = math.Min(top, child.top)
+		bottom = math.Max(bottom, child.bottom)
+	}
+
+	// Center children in the column of wider ones packed at the same
+	// offset, where that does not make them overlap another child
+	width := make(map[float64]float64)
+	for _, child := range b.children {
+		width[child.offset] = math.Max(width[child.offset], child.cross)
+	}
+	for i, child := range b.children {
+		shift := (width[child.offset] - child.cross) / 2
+		if shift <= 0 {
+			continue
+		}
+		others := append(append([]*block{}, b.children[:i]...), b.children[i+1:]...)
+		if !collides(child, child.offset+shift, others) {
+			child.offset += shift
+		}
+	}
+
+	if b.sg == nil {
+		b.cross, b.top, b.bottom = content, top, bottom
+		if len(b.children) == 0 {
+			b.top, b.bottom = 0, 0
+		}
+		return
+	}
+
+	// Subgraph border, with the title above the content
+	if len(b.children) == 0 {
+		content, top, bottom = minNodeW, pos[0], pos[0]+LineHeight
+	}
+	padCross, padAlong := clusterPad, clusterPad+titleHeight
+	if g.horizontal {
+		padCross, padAlong = clusterPad+titleHeight, clusterPad
+	}
+	b.inset = padCross
+	b.cross = content + padCross + clusterPad
+	b.top = top - padAlong
+	b.bottom = bottom + clusterPad
+	if !g.horizontal {
+		titleW := TitleWidth(b.sg.Title) + 2*clusterPad
+		b.cross = math.Max(b.cross, titleW)
+	}
+}
+
+// collides reports whether b at cross offset c would overlap a placed block
+func collides(b *block, c float64, placed []*block) bool {
+	for _, p := range placed {
+		if b.top >= p.bottom+clusterGap || p.top >= b.bottom+clusterGap {
+			continue
+		}
+		if c >= p.offset+p.cross+nodeGap || p.offset >= c+b.cross+nodeGap {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+// place computes coordinates for the current block order
+func (g *graph) place(root *block) *Layout {
+	pos, depth := g.rankPositions()
+	g.pack(root, pos, depth)
+
+	l := &Layout{
+		Direction: g.dir,
+		Diagram:   g.d,
+		nodes:     make(map[string]*Node),
+		clusters:  make(map[string]*Cluster),
+	}
+
+	crossTotal := root.cross
+	alongTotal := root.bottom - root.top
+	alongShift := -root.top
+
+	// toRect maps cross/rank-axis coordinates to the drawing
+	toRect := func(cross, along, crossSize, alongSize float64) Rect {
+		along += alongShift
+		switch g.dir {
+		case "BT":
+			along = alongTotal - along - alongSize
+		case "RL":
+			along = alongTotal - along - alongSize
+		}
+		if g.horizontal {
+			return Rect{X: along + margin, Y: cross + margin, W: alongSize, H: crossSize}
+		}
+		return Rect{X: cross + margin, Y: along + margin, W: crossSize, H: alongSize}
+	}
+
+	var assign func(b *block, origin float64, depth int)
+	assign = func(b *block, origin float64, depth int) {
+		for _, child := range b.children {
+			at := origin + b.inset + child.offset
+			if child.node != "" {
+				cross, along := g.extent(child.node)
+				node := &Node{
+					ID:    child.node,
+					Rect:  toRect(at, child.top, cross, along),
+					Rank:  g.ranks[child.node],
+					Lines: labelLines(g.d.Nodes[child.node].Label),
+					Node:  g.d.Nodes[child.node],
+				}
+				l.nodes[child.node] = node
+				continue
+			}
+			cluster := &Cluster{
+				ID:       child.sg.ID,
+				Title:    child.sg.Title,
+				Rect:     toRect(at, child.top, child.cross, child.bottom-child.top),
+				Depth:    depth,
+				Subgraph: child.sg,
+			}
+			l.clusters[child.sg.ID] = cluster
+			assign(child, at, depth+1)
+		}
+	}
+	assign(root, 0, 0)
+
+	for _, id := range g.ids {
+		l.Nodes = append(l.Nodes, l.nodes[id])
+	}
+	for _, sg := range g.d.Subgraphs {
+		if c := l.clusters[sg.ID]; c != nil {
+			l.Clusters = append(l.Clusters, c)
+		}
+	}
+	sort.SliceStable(l.Clusters, func(i, j int) bool {
+		return l.Clusters[i].Depth < l.Clusters[j].Depth
+	})
+
+	if g.horizontal {
+		l.Width, l.Height = alongTotal+2*margin, crossTotal+2*margin
+	} else {
+		l.Width, l.Height = crossTotal+2*margin, alongTotal+2*margin
+	}
+
+	g.route(l)
+	g.measure(l)
+	return l
+}
+
+// labelLines splits a label into trimmed display lines
+func labelLines(label string) []string {
+	lines := strings.Split(label, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+	return lines
+}
+
+// reorder sorts the children of every block by the barycenter of their
+// neighbors outside the child, measured along the cross axis in l:
+// predecessors when sweeping down, successors when sweeping up. Looking
+// one way keeps the layers from swapping past each other. The
+// layout is placed again after each block that changes, so blocks sorted
+// later see the new positions. It returns the layout of the new order.
+func (g *graph) reorder(b, root *block, l *Layout, down bool) *Layout {
+	if len(b.children) > 1 {
+		keys := make(map[*block]float64)
+		before := append([]*block(nil), b.children...)
+		for _, child := range b.children {
+			keys[child] = g.barycenter(child, l, down)
+		}
+		sort.SliceStable(b.children, func(i, j int) bool {
+			return keys[b.children[i]] < keys[b.children[j]]
+		})
+		for i := range before {
+			if before[i] != b.children[i] {
+				l = g.place(root)
+				break
+			}
+		}
+	}
+	for _, child := range b.children {
+		l = g.reorder(child, root, l, down)
+	}
+	return l
+}
+
+// barycenter returns the mean cross-axis position of the predecessors of
+// a block, or its successors unless down, that lie outside it, or the
+// block's own position if it has none
+func (g *graph) barycenter(b *block, l *Layout, down bool) float64 {
+	ids := b.nodeIDs()
+	inBlock := make(map[string]bool)
+	for _, id := range ids {
+		inBlock[id] = true
+	}
+
+	sum, n := 0.0, 0
+	own, owned := 0.0, 0
+	for _, id := range ids {
+		own += g.crossCenter(l.Node(id))
+		owned++
+		neighbors := g.succ[id]
+		if down {
+			neighbors = g.pred[id]
+		}
+		for _, nb := range neighbors {
+			if !inBlock[nb] {
+				sum += g.crossCenter(l.Node(nb))
+				n++
+			}
+		}
+	}
+	if n == 0 {
+		if owned == 0 {
+			return 0
+		}
+		return own / float64(owned)
+	}
+	return sum / float64(n)
+}
+
+// crossCenter returns the center of a node along the cross axis
+func (g *graph) crossCenter(n *Node) float64 {
+	if n == nil {
+		return 0
+	}
+	c := n.Rect.Center()
+	if g.horizontal {
+		return c.Y
+	}
+	return c.X
+}
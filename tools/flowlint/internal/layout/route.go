@@ -0,0 +1,366 @@
+package layout
+
+import "math"
+
+// selfLoop is how far the loop of an edge from a node to itself extends
+const selfLoop = 16.0
+
+// laneGap is the clearance between a detouring edge and the boxes it avoids
+const laneGap = 12.0
+
+// route draws every edge as a straight line between the borders of its
+// endpoints. An endpoint may be a node or a subgraph. An edge whose line
+// would run through other boxes takes a detour beside them instead.
+func (g *graph) route(l *Layout) {
+	for _, e := range g.d.Edges {
+		from, okFrom := l.box(e.From)
+		to, okTo := l.box(e.To)
+		edge := &Edge{Edge: e}
+		l.Edges = append(l.Edges, edge)
+		if !okFrom || !okTo {
+			continue
+		}
+
+		if e.From == e.To {
+			// Loop out of the right side and back into it
+			x := from.X + from.W
+			top, bottom := from.Y+from.H/4, from.Y+from.H*3/4
+			edge.Points = []Point{{X: x, Y: top}, {X: x + selfLoop, Y: top}, {X: x + selfLoop, Y: bottom}, {X: x, Y: bottom}}
+			edge.Label = Point{X: x + selfLoop, Y: from.Center().Y}
+			continue
+		}
+
+		a, b := from.Center(), to.Center()
+		edge.Points = []Point{clip(from, a, b), clip(to, b, a)}
+		if points := g.detour(l, e.From, e.To, from, to); points != nil {
+			edge.Points = points
+		}
+		n := len(edge.Points)
+		p, q := edge.Points[(n-1)/2], edge.Points[n/2]
+		if n%2 == 0 {
+			p, q = edge.Points[n/2-1], edge.Points[n/2]
+		}
+		edge.Label = Point{X: (p.X + q.X) / 2, Y: (p.Y + q.Y) / 2}
+	}
+}
+
+// detour routes an edge around the boxes its straight line would cross:
+// out of the source, along a lane beside the obstacles, and into the
+// target. It returns nil if the straight line is clear or no lane fits.
+func (g *graph) detour(l *Layout, fromID, toID string, from, to Rect) []Point {
+	obstacles := g.obstacles(l, fromID, toID)
+	a, b := from.Center(), to.Center()
+	straight := []Point{clip(from, a, b), clip(to, b, a)}
+	blocked := false
+	for _, r := range obstacles {
+		if pathEnters(straight, r) {
+			blocked = true
+			break
+		}
+	}
+	if !blocked {
+		return nil
+	}
+
+	// Work along the rank axis from whichever endpoint comes first
+	reversed := g.along(a) > g.along(b)
+	if reversed {
+		from, to, a, b = to, from, b, a
+	}
+	_, fromEnd := g.alongSpan(from)
+	toStart, _ := g.alongSpan(to)
+	lo, hi := fromEnd+laneGap, toStart-laneGap
+	if hi <= lo {
+		return nil
+	}
+
+	// Obstacles in the band between the endpoints, as cross-axis spans
+	type span struct{ lo, hi float64 }
+	band := []span{}
+	for _, r := range obstacles {
+		if r0, r1 := g.alongSpan(r); r1 > lo && r0 < hi {
+			c0, c1 := g.crossSpan(r)
+			band = append(band, span{c0, c1})
+		}
+	}
+	// Slide a lane off the straight line to each side until it is clear
+	mid := (g.cross(a) + g.cross(b)) / 2
+	lane := func(dir float64) float64 {
+		x := mid
+		for moved := true; moved; {
+			moved = false
+			for _, s := range band {
+				if x > s.lo-laneGap && x < s.hi+laneGap {
+					if dir > 0 {
+						x = s.hi + laneGap
+					} else {
+						x = s.lo - laneGap
+					}
+					moved = true
+				}
+			}
+		}
+		return x
+	}
+	limit := l.Width
+	if g.horizontal {
+		limit = l.Height
+	}
+	best, found := 0.0, false
+	for _, x := range []float64{lane(1), lane(-1)} {
+		if x < margin/2 || x > limit-margin/2 {
+			continue
+		}
+		if !found || math.Abs(x-mid) < math.Abs(best-mid) {
+			best, found = x, true
+		}
+	}
+	if !found {
+		return nil
+	}
+
+	bend1, bend2 := g.point(best, lo), g.point(best, hi)
+	points := []Point{clip(from, a, bend1), bend1, bend2, clip(to, b, bend2)}
+	if reversed {
+		for i, j := 0, len(points)-1; i < j; i, j = i+1, j-1 {
+			points[i], points[j] = points[j], points[i]
+		}
+	}
+	return points
+}
+
+// obstacles returns the boxes an edge must not cross: nodes other than
+// its endpoints, and subgraphs unrelated to both endpoints
+func (g *graph) obstacles(l *Layout, fromID, toID string) []Rect {
+	rects := []Rect{}
+	for _, n := range l.Nodes {
+		if n.ID == fromID || n.ID == toID || g.inside(n.ID, fromID) || g.inside(n.ID, toID) {
+			continue
+		}
+		rects = append(rects, n.Rect)
+	}
+	for _, c := range l.Clusters {
+		if g.related(fromID, c.ID) || g.related(toID, c.ID) {
+			continue
+		}
+		rects = append(rects, c.Rect)
+	}
+	return rects
+}
+
+// cross and along return a point's coordinates on the two layout axes
+func (g *graph) cross(p Point) float64 {
+	if g.horizontal {
+		return p.Y
+	}
+	return p.X
+}
+
+func (g *graph) along(p Point) float64 {
+	if g.horizontal {
+		return p.X
+	}
+	return p.Y
+}
+
+// point returns the drawing point at the given axis coordinates
+func (g *graph) point(cross, along float64) Point {
+	if g.horizontal {
+		return Point{X: along, Y: cross}
+	}
+	return Point{X: cross, Y: along}
+}
+
+// crossSpan and alongSpan return the extent of a box on each axis
+func (g *graph) crossSpan(r Rect) (float64, float64) {
+	if g.horizontal {
+		return r.Y, r.Y + r.H
+	}
+	return r.X, r.X + r.W
+}
+
+func (g *graph) alongSpan(r Rect) (float64, float64) {
+	if g.horizontal {
+		return r.X, r.X + r.W
+	}
+	return r.Y, r.Y + r.H
+}
+
+// box returns the box of an edge endpoint: a node or a subgraph
+func (l *Layout) box(id string) (Rect, bool) {
+	if n := l.nodes[id]; n != nil {
+		return n.Rect, true
+	}
+	if c := l.clusters[id]; c != nil {
+		return c.Rect, true
+	}
+	return Rect{}, false
+}
+
+// clip returns where the line from the center of r towards p leaves r
+func clip(r Rect, center, p Point) Point {
+	dx, dy := p.X-center.X, p.Y-center.Y
+	t := math.Inf(1)
+	if dx != 0 {
+		t = math.Min(t, r.W/2/math.Abs(dx))
+	}
+	if dy != 0 {
+		t = math.Min(t, r.H/2/math.Abs(dy))
+	}
+	if t >= 1 || math.IsInf(t, 1) {
+		return center
+	}
+	return Point{X: center.X + dx*t, Y: center.Y + dy*t}
+}
+
+// measure fills in the per-edge and overall layout metrics
+func (g *graph) measure(l *Layout) {
+	m := Metrics{}
+	if len(g.ids) > 0 {
+		m.Ranks = g.maxRank + 1
+	}
+
+	for _, e := range l.Edges {
+		e.RankSpan = g.rankSpan(e.Edge.From, e.Edge.To)
+		if e.RankSpan > 1 {
+			m.LongEdges++
+		}
+	}
+
+	for i, a := range l.Edges {
+		if !a.Visible() || len(a.Points) < 2 {
+			continue
+		}
+		for _, b := range l.Edges[i+1:] {
+			if !b.Visible() || len(b.Points) < 2 || shareEndpoint(a, b) {
+				continue
+			}
+			if pathsCross(a.Points, b.Points) {
+				a.Crossings++
+				b.Crossings++
+				m.Crossings++
+			}
+		}
+	}
+
+	for _, e := range l.Edges {
+		if !e.Visible() || len(e.Points) < 2 {
+			continue
+		}
+		for _, c := range l.Clusters {
+			if g.related(e.Edge.From, c.ID) || g.related(e.Edge.To, c.ID) {
+				continue
+			}
+			if pathEnters(e.Points, c.Rect) {
+				e.Pierces = append(e.Pierces, c.ID)
+				m.Piercings++
+			}
+		}
+	}
+
+	l.Metrics = m
+}
+
+// rankSpan returns the fewest layers between the nodes two endpoints stand for
+func (g *graph) rankSpan(from, to string) int {
+	span := -1
+	for _, a := range g.members(from) {
+		for _, b := range g.members(to) {
+			d := g.ranks[a] - g.ranks[b]
+			if d < 0 {
+				d = -d
+			}
+			if span < 0 || d < span {
+				span = d
+			}
+		}
+	}
+	if span < 0 {
+		return 0
+	}
+	return span
+}
+
+// related reports whether an edge endpoint is the subgraph, lies inside
+// it, or contains it
+func (g *graph) related(endpoint, subgraphID string) bool {
+	if endpoint == subgraphID || g.inside(endpoint, subgraphID) {
+		return true
+	}
+	if sg := g.d.FindSubgraph(endpoint); sg != nil {
+		for _, id := range g.d.Ancestors(endpoint) {
+			if id == subgraphID {
+				return true
+			}
+		}
+		for _, id := range g.d.Ancestors(subgraphID) {
+			if id == endpoint {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// shareEndpoint reports whether two edges meet at a node or subgraph
+func shareEndpoint(a, b *Edge) bool {
+	return a.Edge.From == b.Edge.From || a.Edge.From == b.Edge.To ||
+		a.Edge.To == b.Edge.From || a.Edge.To == b.Edge.To
+}
+
+// pathsCross reports whether two polylines properly intersect
+func pathsCross(a, b []Point) bool {
+	for i := 0; i+1 < len(a); i++ {
+		for j := 0; j+1 < len(b); j++ {
+			if segmentsCross(a[i], a[i+1], b[j], b[j+1]) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// segmentsCross reports whether segments pq and rs cross at a point
+// interior to both
+func segmentsCross(p, q, r, s Point) bool {
+	d1 := orient(r, s, p)
+	d2 := orient(r, s, q)
+	d3 := orient(p, q, r)
+	d4 := orient(p, q, s)
+	return d1*d2 < 0 && d3*d4 < 0
+}
+
+// orient returns the sign of the turn a, b, c
+func orient(a, b, c Point) float64 {
+	v := (b.X-a.X)*(c.Y-a.Y) - (b.Y-a.Y)*(c.X-a.X)
+	switch {
+	case v > 1e-9:
+		return 1
+	case v < -1e-9:
+		return -1
+	}
+	return 0
+}
+
+// pathEnters reports whether a polyline passes through the inside of r
+func pathEnters(points []Point, r Rect) bool {
+	corners := []Point{
+		{X: r.X, Y: r.Y}, {X: r.X + r.W, Y: r.Y},
+		{X: r.X + r.W, Y: r.Y + r.H}, {X: r.X, Y: r.Y + r.H},
+	}
+	inside := func(p Point) bool {
+		return p.X > r.X && p.X < r.X+r.W && p.Y > r.Y && p.Y < r.Y+r.H
+	}
+	for i := 0; i+1 < len(points); i++ {
+		p, q := points[i], points[i+1]
+		if inside(p) || inside(q) {
+			return true
+		}
+		for k := range corners {
+			if segmentsCross(p, q, corners[k], corners[(k+1)%4]) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,250 @@
+package linter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/user/flowlint/internal/parser"
+)
+
+// checkBudget holds a diagram to the size limits of the style guide: the
+// node and edge caps, and the dependency and topic counts beyond which
+// the grouped layout stops working. An oversized diagram gets a proposed
+// split into an overview and detail diagrams.
+func checkBudget(diagram *parser.Diagram, cfg *Config) []Issue {
+	issues := []Issue{}
+	limits := cfg.Guide.LayoutStrategies.Thresholds
+
+	nodes, edges := len(diagram.Nodes), len(diagram.Edges)
+	if nodes > limits.MaxNodes || edges > limits.MaxEdges {
+		over := []string{}
+		if nodes > limits.MaxNodes {
+			over = append(over, fmt.Sprintf("%d nodes (max %d)", nodes, limits.MaxNodes))
+		}
+		if edges > limits.MaxEdges {
+			over = append(over, fmt.Sprintf("%d edges (max %d)", edges, limits.MaxEdges))
+		}
+		issues = append(issues, Issue{
+			Severity:   SeverityWarning,
+			Message:    fmt.Sprintf("Diagram is over the style guide budget: %s", strings.Join(over, ", ")),
+			Suggestion: proposeSplit(diagram, limits.MaxNodes, limits.MaxEdges).String(),
+		})
+	}
+
+	deps, topics := 0, 0
+	for _, node := range diagram.Nodes {
+		switch nodeClass(diagram, node, cfg) {
+		case "service", "database", "cache", "external":
+			if !inSubgraph(diagram, node, "entry", "target") {
+				deps++
+			}
+		case "kafka":
+			topics++
+		}
+	}
+	if deps > limits.UseGroupedMaxDeps {
+		issues = append(issues, Issue{
+			Severity:   SeverityWarning,
+			Message:    fmt.Sprintf("Diagram has %d dependencies; the grouped layout works up to %d", deps, limits.UseGroupedMaxDeps),
+			Suggestion: "Use the linear pipeline layout: the target service as hub with one arrow per dependency",
+		})
+	}
+	if topics > limits.UseGroupedMaxKafka {
+		issues = append(issues, Issue{
+			Severity:   SeverityWarning,
+			Message:    fmt.Sprintf("Diagram has %d Kafka topics; the grouped layout works up to %d", topics, limits.UseGroupedMaxKafka),
+			Suggestion: "Use the linear pipeline layout, or move the topics into a detail diagram",
+		})
+	}
+
+	return issues
+}
+
+// nodeClass returns the class a node has, or the one it would be given
+func nodeClass(diagram *parser.Diagram, node *parser.Node, cfg *Config) string {
+	if len(node.Classes) > 0 {
+		return node.Classes[0]
+	}
+	class, _ := inferClass(diagram, node, cfg)
+	return class
+}
+
+// inSubgraph reports whether a node sits in one of the given subgraphs,
+// directly or nested
+func inSubgraph(diagram *parser.Diagram, node *parser.Node, ids ...string) bool {
+	for _, sg := range append([]string{node.Subgraph}, diagram.Ancestors(node.Subgraph)...) {
+		for _, id := range ids {
+			if sg == id {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// split is a proposal to break a diagram into an overview, in which some
+// top-level subgraphs are collapsed to a single node, and one detail
+// diagram per collapsed subgraph
+type split struct {
+	kept          []string // subgraphs the overview shows in full
+	details       []detail
+	nodes, edges  int      // size of the overview
+	cut           []string // edges that cross into a detail diagram
+	overviewLarge bool     // the overview is over budget even so
+}
+
+// detail is one detail diagram of a split
+type detail struct {
+	subgraph     string
+	nodes, edges int
+	large        bool // over budget itself; split it further
+}
+
+// proposeSplit collapses the largest top-level subgraphs, one at a time,
+// until the overview fits the budget
+func proposeSplit(diagram *parser.Diagram, maxNodes, maxEdges int) split {
+	// unitOf maps nodes and subgraphs to their top-level subgraph; nodes
+	// outside any subgraph are their own unit and always stay
+	unitOf := func(id string) string {
+		sg := id
+		if node, ok := diagram.Nodes[id]; ok {
+			if node.Subgraph == "" {
+				return ""
+			}
+			sg = node.Subgraph
+		}
+		if ancestors := diagram.Ancestors(sg); len(ancestors) > 0 {
+			return ancestors[len(ancestors)-1]
+		}
+		return sg
+	}
+
+	units := []string{}
+	size := make(map[string]int)
+	internal := make(map[string]int)
+	for _, sg := range diagram.Subgraphs {
+		if sg.Parent == "" {
+			units = append(units, sg.ID)
+		}
+	}
+	for id := range diagram.Nodes {
+		size[unitOf(id)]++
+	}
+	for _, edge := range diagram.Edges {
+		if from := unitOf(edge.From); from != "" && from == unitOf(edge.To) {
+			internal[from]++
+		}
+	}
+	sort.SliceStable(units, func(i, j int) bool {
+		if size[units[i]] != size[units[j]] {
+			return size[units[i]] > size[units[j]]
+		}
+		return internal[units[i]] > internal[units[j]]
+	})
+
+	collapsed := make(map[string]bool)
+	// overview returns the size of the overview with the current units
+	// collapsed; parallel edges to a collapsed unit merge into one
+	overview := func() (int, int) {
+		nodes := size[""]
+		for _, unit := range units {
+			if collapsed[unit] {
+				nodes++
+			} else {
+				nodes += size[unit]
+			}
+		}
+		edges := 0
+		seen := make(map[string]bool)
+		for _, edge := range diagram.Edges {
+			from, to := edge.From, edge.To
+			if u := unitOf(from); collapsed[u] {
+				from = u
+			}
+			if u := unitOf(to); collapsed[u] {
+				to = u
+			}
+			if from == to {
+				continue
+			}
+			if key := from + " " + to; !seen[key] {
+				seen[key] = true
+				edges++
+			}
+		}
+		return nodes, edges
+	}
+
+	s := split{}
+	s.nodes, s.edges = overview()
+	for _, unit := range units {
+		if s.nodes <= maxNodes && s.edges <= maxEdges {
+			break
+		}
+		collapsed[unit] = true
+		s.nodes, s.edges = overview()
+	}
+	s.overviewLarge = s.nodes > maxNodes || s.edges > maxEdges
+
+	for _, sg := range diagram.Subgraphs {
+		if sg.Parent != "" {
+			continue
+		}
+		if !collapsed[sg.ID] {
+			s.kept = append(s.kept, sg.ID)
+			continue
+		}
+		d := detail{subgraph: sg.ID, nodes: size[sg.ID], edges: internal[sg.ID]}
+		d.large = d.nodes > maxNodes || d.edges > maxEdges
+		s.details = append(s.details, d)
+	}
+	for _, edge := range diagram.Edges {
+		from, to := unitOf(edge.From), unitOf(edge.To)
+		if from != to && (collapsed[from] || collapsed[to]) {
+			s.cut = append(s.cut, edgeName(edge))
+		}
+	}
+	return s
+}
+
+// String describes the split for a suggestion, one part per line
+func (s split) String() string {
+	if len(s.details) == 0 {
+		return "Group the nodes into subgraphs so the diagram can be split into an overview and detail diagrams"
+	}
+
+	collapsed := make([]string, len(s.details))
+	for i, d := range s.details {
+		collapsed[i] = d.subgraph
+	}
+	diagrams := "a detail diagram"
+	if len(s.details) > 1 {
+		diagrams = fmt.Sprintf("%d detail diagrams", len(s.details))
+	}
+	lines := []string{fmt.Sprintf("Split into an overview and %s:", diagrams)}
+	each := " as one node"
+	if len(collapsed) > 1 {
+		each += " each"
+	}
+	overview := "overview: " + strings.Join(collapsed, ", ") + each
+	if len(s.kept) > 0 {
+		overview = fmt.Sprintf("overview: %s in full; %s%s", strings.Join(s.kept, ", "), strings.Join(collapsed, ", "), each)
+	}
+	overview += fmt.Sprintf(" (%d nodes, %d edges)", s.nodes, s.edges)
+	if s.overviewLarge {
+		overview += " - still over budget, group the remaining nodes"
+	}
+	lines = append(lines, overview)
+	for _, d := range s.details {
+		line := fmt.Sprintf("detail %s: %d nodes, %d edges", d.subgraph, d.nodes, d.edges)
+		if d.large {
+			line += " - still over budget, split it by its nested subgraphs"
+		}
+		lines = append(lines, line)
+	}
+	if len(s.cut) > 0 {
+		lines = append(lines, "cut edges: "+strings.Join(s.cut, ", "))
+	}
+	return strings.Join(lines, "\n- ")
+}
@@ -0,0 +1,111 @@
+package linter
+
+import (
+	"github.com/user/flowlint/internal/parser"
+	"github.com/user/flowlint/internal/styles"
+)
+
+// Config selects the rules Lint runs and tunes them. Rules are referred
+// to by ID or name, and "all" stands for every rule. Disable is applied
+// before Enable, so "--disable all --enable FL001" runs only FL001. A
+// severity of "off" disables the rule.
+type Config struct {
+	Enable   []string
+	Disable  []string
+	Severity map[string]string // rule to error, warning or off
+
+	RequiredClasses []string          // classDefs every diagram defines
+	AsyncKeywords   []string          // edge label words that mean an async call
+	SyncKeywords    []string          // edge label words that mean a sync call
+	Abbreviations   []Abbreviation    // abbreviations to flag in node labels
+	Thresholds      *Thresholds       // complexity limits; the defaults if nil
+	Layout          *LayoutThresholds // layout flaws allowed; the defaults if nil
+	Guide           *styles.Guide     // palette and classDefs; the built-in guide if nil
+	Deps            *parser.DepsFile  // dependencies the diagram documents, if known
+}
+
+// Abbreviation is a short form that should be written out in labels
+type Abbreviation struct {
+	Short string `yaml:"short"`
+	Full  string `yaml:"full"`
+}
+
+// Thresholds tune the complexity rule. A diagram counts as spaghetti
+// when any of them is exceeded. Zero is a limit like any other.
+type Thresholds struct {
+	UngroupedNodes int     `yaml:"ungrouped_nodes"` // nodes allowed with fewer than two subgraphs
+	EdgesPerNode   float64 `yaml:"edges_per_node"`
+	FanOut         int     `yaml:"fan_out"`         // outgoing edges before a node fans out
+	FanIn          int     `yaml:"fan_in"`          // incoming edges before a node fans in
+	FanNodes       int     `yaml:"fan_nodes"`       // fan-out or fan-in nodes allowed
+	HubNodes       int     `yaml:"hub_nodes"`       // nodes allowed without a clear hub
+	HubConnections int     `yaml:"hub_connections"` // connections before a node is suggested as hub
+}
+
+// LayoutThresholds tune the layout rule: how many of each layout flaw a
+// diagram may have, as estimated by a layered layout. Zero allows none.
+type LayoutThresholds struct {
+	Crossings int `yaml:"crossings"`  // edge crossings, when the style guide does not force the linear layout
+	LongEdges int `yaml:"long_edges"` // edges spanning more than one layer
+	Piercings int `yaml:"piercings"`  // edges cutting through a subgraph they do not touch
+}
+
+// DefaultConfig returns the settings used when nothing is configured
+func DefaultConfig() Config {
+	return Config{
+		RequiredClasses: []string{"service", "kafka", "database", "external"},
+		AsyncKeywords:   []string{"kafka", "publish", "consume", "queue", "rabbitmq", "sqs", "pubsub"},
+		SyncKeywords:    []string{"grpc", "http", "rest", "sql", "cache", "redis"},
+		Abbreviations: []Abbreviation{
+			{Short: "svc", Full: "Service"},
+			{Short: "srv", Full: "Server"},
+			{Short: "msg", Full: "Message"},
+			{Short: "req", Full: "Request"},
+			{Short: "res", Full: "Response"},
+			{Short: "cfg", Full: "Config"},
+			{Short: "db", Full: "Database"},
+		},
+		Thresholds: &Thresholds{
+			UngroupedNodes: 10,
+			EdgesPerNode:   2.0,
+			FanOut:         3,
+			FanIn:          3,
+			FanNodes:       2,
+			HubNodes:       8,
+			HubConnections: 3,
+		},
+		Layout: &LayoutThresholds{
+			Crossings: 2,
+			LongEdges: 3,
+			Piercings: 2,
+		},
+	}
+}
+
+// withDefaults fills the settings c leaves unset, the nil ones. An empty
+// but non-nil list stays empty, so a keyword list can be switched off.
+func (c Config) withDefaults() Config {
+	d := DefaultConfig()
+	if c.Guide == nil {
+		c.Guide = styles.DefaultGuide()
+	}
+	if c.RequiredClasses == nil {
+		c.RequiredClasses = d.RequiredClasses
+	}
+	if c.AsyncKeywords == nil {
+		c.AsyncKeywords = d.AsyncKeywords
+	}
+	if c.SyncKeywords == nil {
+		c.SyncKeywords = d.SyncKeywords
+	}
+	if c.Abbreviations == nil {
+		c.Abbreviations = d.Abbreviations
+	}
+	if c.Thresholds == nil {
+		c.Thresholds = d.Thresholds
+	}
+	if c.Layout == nil {
+		c.Layout = d.Layout
+	}
+	return c
+}
@@ -0,0 +1,40 @@
+package linter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWithDefaultsThresholds(t *testing.T) {
+	if got := (Config{}).withDefaults().Thresholds; got == nil || *got != *DefaultConfig().Thresholds {
+		t.Errorf("unset thresholds = %+v, want the defaults", got)
+	}
+
+	zero := Config{Thresholds: &Thresholds{}}.withDefaults()
+	if *zero.Thresholds != (Thresholds{}) {
+		t.Errorf("zero thresholds became %+v; zero is a limit, not unset", *zero.Thresholds)
+	}
+}
+
+func TestWithDefaultsLayout(t *testing.T) {
+	if got := (Config{}).withDefaults().Layout; got == nil || *got != *DefaultConfig().Layout {
+		t.Errorf("unset layout thresholds = %+v, want the defaults", got)
+	}
+
+	zero := Config{Layout: &LayoutThresholds{}}.withDefaults()
+	if *zero.Layout != (LayoutThresholds{}) {
+		t.Errorf("zero layout thresholds became %+v; zero allows no flaws, it is not unset", *zero.Layout)
+	}
+}
+
+func TestLayoutZeroLongEdges(t *testing.T) {
+	code := "flowchart TD\n    A --> B --> C\n    A --> C"
+	want := []string{"Edges spanning several layers: 1 (0 allowed)"}
+
+	if msgs := messages(lintRule(t, code, "layout", Config{Layout: &LayoutThresholds{}})); !reflect.DeepEqual(msgs, want) {
+		t.Errorf("messages = %q, want %q", msgs, want)
+	}
+	if msgs := messages(lintRule(t, code, "layout", Config{})); len(msgs) != 0 {
+		t.Errorf("default thresholds: %q, want no issues", msgs)
+	}
+}
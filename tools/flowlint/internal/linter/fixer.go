@@ -3,73 +3,34 @@ package linter
 import (
 	"fmt"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
-)
 
-// Default class definitions to inject if missing (muted professional colors)
-var defaultClassDefs = map[string]string{
-	"service":  "classDef service fill:#a5d8ff,stroke:#339af0,color:#1864ab",
-	"entry":    "classDef entry fill:#b2f2bb,stroke:#51cf66,color:#2b8a3e",
-	"kafka":    "classDef kafka fill:#96f2d7,stroke:#38d9a9,color:#087f5b",
-	"database": "classDef database fill:#ffec99,stroke:#fcc419,color:#e67700",
-	"cache":    "classDef cache fill:#d0bfff,stroke:#9775fa,color:#6741d9",
-	"external": "classDef external fill:#dee2e6,stroke:#adb5bd,color:#495057",
-}
+	"github.com/user/flowlint/internal/parser"
+)
 
-// Fix applies automatic fixes to the mermaid code based on issues
+// Fix applies automatic fixes to the mermaid code based on issues. The
+// code must be the code the issues were found in.
 func Fix(code string, issues []Issue) (string, int) {
-	fixCount := 0
+	// Fixes at a span of the code go first, while the offsets the parser
+	// recorded still hold
+	code, fixCount := fixSpans(code, issues)
 	lines := strings.Split(code, "\n")
 
+	// Nodes missing a class are collected and given grouped class
+	// statements at the end
+	classMembers := make(map[string][]string)
+	classOrder := []string{}
+
 	for _, issue := range issues {
 		if !issue.Fixable {
 			continue
 		}
 
 		switch issue.FixType {
-		case "quote_subgraph":
-			id := issue.FixData["id"]
-			title := issue.FixData["title"]
-			// Find and fix the subgraph line
-			for i, line := range lines {
-				if strings.Contains(line, "subgraph "+id) && !strings.Contains(line, `"`) {
-					// Replace unquoted with quoted
-					oldPattern := fmt.Sprintf(`subgraph\s+%s\s*\[([^\]"]+)\]`, regexp.QuoteMeta(id))
-					re := regexp.MustCompile(oldPattern)
-					if re.MatchString(line) {
-						lines[i] = re.ReplaceAllString(line, fmt.Sprintf(`subgraph %s ["%s"]`, id, title))
-						fixCount++
-					} else {
-						// Try without brackets
-						oldPattern2 := fmt.Sprintf(`subgraph\s+%s\s+([^"\[\]]+)$`, regexp.QuoteMeta(id))
-						re2 := regexp.MustCompile(oldPattern2)
-						if re2.MatchString(line) {
-							lines[i] = re2.ReplaceAllString(line, fmt.Sprintf(`subgraph %s ["%s"]`, id, title))
-							fixCount++
-						}
-					}
-					break
-				}
-			}
-
-		case "fix_arrow":
-			from := issue.FixData["from"]
-			to := issue.FixData["to"]
-			oldArrow := issue.FixData["old"]
-			newArrow := issue.FixData["new"]
-
-			// Find and fix the edge line
-			for i, line := range lines {
-				if strings.Contains(line, from) && strings.Contains(line, to) && strings.Contains(line, oldArrow) {
-					lines[i] = strings.Replace(line, oldArrow, newArrow, 1)
-					fixCount++
-					break
-				}
-			}
-
 		case "add_classdef":
-			className := issue.FixData["class"]
-			if def, ok := defaultClassDefs[className]; ok {
+			if def := issue.FixData["def"]; def != "" {
 				// Find where to insert (after flowchart declaration or other classDefs)
 				insertIdx := -1
 				for i, line := range lines {
@@ -91,80 +52,201 @@ func Fix(code string, issues []Issue) (string, int) {
 				}
 			}
 
-		case "fix_newline":
-			// Fix newlines in node labels
-			nodeID := issue.FixData["id"]
-			newLabel := issue.FixData["new"]
-			code = fixNodeLabel(code, nodeID, newLabel)
-			lines = strings.Split(code, "\n")
-			fixCount++
-
-		case "fix_edge_newline":
-			// Fix newlines in edge labels
-			oldLabel := issue.FixData["old"]
-			newLabel := issue.FixData["new"]
-			// Replace the multi-line label with single-line
-			escapedOld := regexp.QuoteMeta(oldLabel)
-			// Handle the label possibly spanning multiple lines
-			re := regexp.MustCompile(`\|` + escapedOld + `\|`)
-			code = re.ReplaceAllString(code, "|"+newLabel+"|")
-			lines = strings.Split(code, "\n")
-			fixCount++
-
-		case "fix_subgraph_newline":
-			// Fix newlines in subgraph titles
-			sgID := issue.FixData["id"]
-			newTitle := issue.FixData["new"]
-			// Find and fix the subgraph line
+		case "fix_classdef":
+			// Rewrite the properties of the class's definition
+			re := regexp.MustCompile(`^(\s*classDef\s+)` + regexp.QuoteMeta(issue.FixData["class"]) + `(\s+)` + regexp.QuoteMeta(issue.FixData["old"]))
 			for i, line := range lines {
-				if strings.Contains(line, "subgraph "+sgID) {
-					// Replace the title
-					re := regexp.MustCompile(`subgraph\s+` + regexp.QuoteMeta(sgID) + `\s*\[.*\]`)
-					lines[i] = re.ReplaceAllString(line, fmt.Sprintf(`subgraph %s ["%s"]`, sgID, newTitle))
+				if loc := re.FindStringSubmatchIndex(line); loc != nil {
+					lines[i] = line[:loc[5]] + issue.FixData["new"] + line[loc[1]:]
 					fixCount++
 					break
 				}
 			}
+
+		case "fix_shape":
+			// Rewrite the brackets of every definition of the node
+			code = strings.Join(lines, "\n")
+			d := issue.FixData
+			re := regexp.MustCompile(`(^|[^\w-])(` + regexp.QuoteMeta(d["id"]) + `\s*)` + regexp.QuoteMeta(d["old_open"]+d["label"]+d["old_close"]))
+			if re.MatchString(code) {
+				code = re.ReplaceAllString(code, "${1}${2}"+strings.ReplaceAll(d["new_open"]+d["label"]+d["new_close"], "$", "$$"))
+				fixCount++
+			}
+			lines = strings.Split(code, "\n")
+
+		case "add_class":
+			class := issue.FixData["class"]
+			if _, ok := classMembers[class]; !ok {
+				classOrder = append(classOrder, class)
+			}
+			classMembers[class] = append(classMembers[class], issue.FixData["id"])
+			fixCount++
+
+		case "reorder_nodes":
+			if reordered, ok := reorderDeclarations(lines, strings.Split(issue.FixData["order"], ",")); ok {
+				lines = reordered
+				fixCount++
+			}
+
 		}
 	}
 
+	if len(classOrder) > 0 {
+		lines = addClassStatements(lines, classOrder, classMembers)
+	}
+
 	return strings.Join(lines, "\n"), fixCount
 }
 
-// fixNodeLabel replaces a node's label, handling multi-line cases
-func fixNodeLabel(code string, nodeID string, newLabel string) string {
-	// Match node definitions like: A[Label] or A[Multi\nLine]
-	// This regex handles the node ID followed by various bracket types
-	patterns := []string{
-		`(\s` + regexp.QuoteMeta(nodeID) + `)\[([^\]]*)\]`,         // [Label]
-		`(\s` + regexp.QuoteMeta(nodeID) + `)\[\(([^\)]*)\)\]`,     // [(Label)]
-		`(\s` + regexp.QuoteMeta(nodeID) + `)\(\(([^\)]*)\)\)`,     // ((Label))
-		`(\s` + regexp.QuoteMeta(nodeID) + `)\(([^\)]*)\)`,         // (Label)
-		`(\s` + regexp.QuoteMeta(nodeID) + `)\[\[([^\]]*)\]\]`,     // [[Label]]
+// spanFix is a replacement of the code between two byte offsets
+type spanFix struct {
+	start, end int
+	text       string
+}
+
+// fixSpans applies the fixes that rewrite the source range the parser
+// recorded for a label, title or link: FixData start and end are byte
+// offsets into the code, and old is the text expected there. The fixes
+// are applied from the last span back so earlier offsets stay valid; a
+// span shared by several issues, as the link of A & B --> C is, is
+// rewritten once.
+func fixSpans(code string, issues []Issue) (string, int) {
+	fixes := []spanFix{}
+	for _, issue := range issues {
+		if !issue.Fixable {
+			continue
+		}
+		d := issue.FixData
+		start, errStart := strconv.Atoi(d["start"])
+		end, errEnd := strconv.Atoi(d["end"])
+		if errStart != nil || errEnd != nil || start < 0 || start > end || end > len(code) || code[start:end] != d["old"] {
+			continue
+		}
+
+		fix := spanFix{start: start, end: end}
+		switch issue.FixType {
+		case "quote_subgraph":
+			fix.text = quotedTitle(code, start, d["title"])
+		case "fix_subgraph_newline":
+			fix.text = quotedTitle(code, start, d["new"])
+		case "fix_arrow", "fix_newline", "fix_edge_newline":
+			fix.text = d["new"]
+		default:
+			continue
+		}
+		fixes = append(fixes, fix)
 	}
 
-	for _, pattern := range patterns {
-		re := regexp.MustCompile(pattern)
-		if re.MatchString(code) {
-			// Determine the bracket type from the match
-			match := re.FindStringSubmatch(code)
-			if len(match) > 0 {
-				// Replace preserving the bracket type
-				if strings.Contains(pattern, `\[\(`) {
-					code = re.ReplaceAllString(code, fmt.Sprintf(`$1[(%s)]`, newLabel))
-				} else if strings.Contains(pattern, `\(\(`) {
-					code = re.ReplaceAllString(code, fmt.Sprintf(`$1((%s))`, newLabel))
-				} else if strings.Contains(pattern, `\[\[`) {
-					code = re.ReplaceAllString(code, fmt.Sprintf(`$1[[%s]]`, newLabel))
-				} else if strings.Contains(pattern, `\(`) {
-					code = re.ReplaceAllString(code, fmt.Sprintf(`$1(%s)`, newLabel))
-				} else {
-					code = re.ReplaceAllString(code, fmt.Sprintf(`$1[%s]`, newLabel))
-				}
+	sort.SliceStable(fixes, func(i, j int) bool { return fixes[i].start > fixes[j].start })
+	count, limit := 0, len(code)+1
+	for _, fix := range fixes {
+		if fix.end > limit {
+			continue
+		}
+		code = code[:fix.start] + fix.text + code[fix.end:]
+		limit = fix.start
+		count++
+	}
+	return code, count
+}
+
+// quotedTitle writes a subgraph title in quotes, adding the brackets
+// unless the title at start already sits in them or is a quoted ID
+func quotedTitle(code string, start int, title string) string {
+	if (start > 0 && code[start-1] == '[') || strings.HasPrefix(code[start:], `"`) {
+		return `"` + title + `"`
+	}
+	return `["` + title + `"]`
+}
+
+// spanData adds the FixData keys that locate a fix at the source range of
+// the text old
+func spanData(span parser.Span, old string, data map[string]string) map[string]string {
+	data["start"] = strconv.Itoa(span.Start.Offset)
+	data["end"] = strconv.Itoa(span.End.Offset)
+	data["old"] = old
+	return data
+}
+
+// addClassStatements writes a class A,B name statement per class after
+// the last class statement, or else after the last line of code
+func addClassStatements(lines, classes []string, members map[string][]string) []string {
+	insertIdx, indent := len(lines), "    "
+	for i := len(lines) - 1; i >= 0; i-- {
+		if strings.TrimSpace(lines[i]) != "" {
+			insertIdx = i + 1
+			break
+		}
+	}
+	for i, line := range lines {
+		if trimmed := strings.TrimSpace(line); strings.HasPrefix(trimmed, "class ") {
+			insertIdx = i + 1
+			indent = line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		}
+	}
+
+	added := make([]string, len(classes))
+	for i, class := range classes {
+		added[i] = fmt.Sprintf("%sclass %s %s", indent, strings.Join(members[class], ","), class)
+	}
+	newLines := make([]string, 0, len(lines)+len(added))
+	newLines = append(newLines, lines[:insertIdx]...)
+	newLines = append(newLines, added...)
+	return append(newLines, lines[insertIdx:]...)
+}
+
+// reorderDeclarations sorts each run of consecutive lines that only
+// declare a node into the given order. Runs end at any other line, so
+// nodes never move out of their subgraph.
+func reorderDeclarations(lines, order []string) ([]string, bool) {
+	rank := make(map[string]int)
+	for i, id := range order {
+		rank[id] = i
+	}
+	ranked := func(line string) (int, bool) {
+		id := declaredNode(line)
+		r, ok := rank[id]
+		return r, ok && id != ""
+	}
+
+	result := append([]string(nil), lines...)
+	changed := false
+	for start := 0; start < len(result); {
+		end := start
+		for end < len(result) {
+			if _, ok := ranked(result[end]); !ok {
 				break
 			}
+			end++
+		}
+		if end-start > 1 {
+			run := result[start:end]
+			before := strings.Join(run, "\n")
+			sort.SliceStable(run, func(i, j int) bool {
+				ri, _ := ranked(run[i])
+				rj, _ := ranked(run[j])
+				return ri < rj
+			})
+			changed = changed || strings.Join(run, "\n") != before
 		}
+		start = end + 1
 	}
+	return result, changed
+}
 
-	return code
+// declaredNode returns the ID of the node a line declares if that is all
+// the line does, as in D1[Payment Service] or a bare D1, or ""
+func declaredNode(line string) string {
+	if strings.TrimSpace(line) == "" {
+		return ""
+	}
+	d, err := parser.ParseMermaid("flowchart TD\n" + line)
+	if err != nil || d.AST == nil || len(d.AST.Statements) != 2 {
+		return ""
+	}
+	chain, ok := d.AST.Statements[1].(*parser.ChainStmt)
+	if !ok || len(chain.Groups) != 1 || len(chain.Groups[0]) != 1 {
+		return ""
+	}
+	return chain.Groups[0][0].ID
 }
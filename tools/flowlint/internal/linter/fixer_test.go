@@ -0,0 +1,105 @@
+package linter
+
+import (
+	"testing"
+
+	"github.com/user/flowlint/internal/parser"
+)
+
+// lintFix parses code, runs a single rule on it and applies its fixes
+func lintFix(t *testing.T, code, rule string) (string, int) {
+	t.Helper()
+	d, err := parser.ParseMermaid(code)
+	if err != nil {
+		t.Fatalf("ParseMermaid: %v", err)
+	}
+	return Fix(code, Lint(d, Config{Enable: []string{rule}, Disable: []string{"all"}}))
+}
+
+func TestFixAtSpans(t *testing.T) {
+	tests := []struct {
+		name  string
+		rule  string
+		code  string
+		want  string
+		fixes int
+	}{
+		{
+			name:  "arrow of a node whose ID prefixes another",
+			rule:  "arrow-style",
+			code:  "flowchart TD\n    S1 -.-> D10[Payment DB]\n    S1 -.->|grpc| D1[Payment Service]",
+			want:  "flowchart TD\n    S1 -.-> D10[Payment DB]\n    S1 ==>|grpc| D1[Payment Service]",
+			fixes: 1,
+		},
+		{
+			name:  "second link of a chain",
+			rule:  "arrow-style",
+			code:  "flowchart TD\n    A -.->|grpc| B ==>|kafka| C",
+			want:  "flowchart TD\n    A ==>|grpc| B -.->|kafka| C",
+			fixes: 2,
+		},
+		{
+			name:  "link shared by a node group",
+			rule:  "arrow-style",
+			code:  "flowchart TD\n    A & B -.->|grpc| C",
+			want:  "flowchart TD\n    A & B ==>|grpc| C",
+			fixes: 1,
+		},
+		{
+			name:  "edge label repeated on an earlier edge",
+			rule:  "label-newlines",
+			code:  "flowchart TD\n    A -->|Get<br>Balance| B\n    C -->|Get<br>Balance| D",
+			want:  "flowchart TD\n    A -->|Get Balance| B\n    C -->|Get Balance| D",
+			fixes: 2,
+		},
+		{
+			name:  "node label",
+			rule:  "label-newlines",
+			code:  "flowchart TD\n    A[\"Payment\nService\"] --> B",
+			want:  "flowchart TD\n    A[\"Payment Service\"] --> B",
+			fixes: 1,
+		},
+		{
+			name:  "subgraph whose ID prefixes another",
+			rule:  "subgraph-quotes",
+			code:  "flowchart TD\n    subgraph deps2 [Other]\n    end\n    subgraph deps [Dependencies]\n    end",
+			want:  "flowchart TD\n    subgraph deps2 [\"Other\"]\n    end\n    subgraph deps [\"Dependencies\"]\n    end",
+			fixes: 2,
+		},
+		{
+			name:  "subgraph title without brackets",
+			rule:  "subgraph-quotes",
+			code:  "flowchart TD\n    subgraph deps Payment Dependencies\n    end",
+			want:  "flowchart TD\n    subgraph deps [\"Payment Dependencies\"]\n    end",
+			fixes: 1,
+		},
+		{
+			name:  "subgraph title newline",
+			rule:  "label-newlines",
+			code:  "flowchart TD\n    subgraph deps [\"Payment<br>Dependencies\"]\n    end",
+			want:  "flowchart TD\n    subgraph deps [\"Payment Dependencies\"]\n    end",
+			fixes: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, n := lintFix(t, tt.code, tt.rule)
+			if got != tt.want || n != tt.fixes {
+				t.Errorf("Fix = %d\n%s\nwant %d\n%s", n, got, tt.fixes, tt.want)
+			}
+		})
+	}
+}
+
+func TestFixSkipsStaleSpans(t *testing.T) {
+	code := "flowchart TD\n    A -.->|grpc| B"
+	d, err := parser.ParseMermaid(code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	issues := Lint(d, Config{Enable: []string{"arrow-style"}, Disable: []string{"all"}})
+	edited := "flowchart TD\n    X --- A -.->|grpc| B"
+	if got, n := Fix(edited, issues); n != 0 || got != edited {
+		t.Errorf("Fix on other code = %d\n%s\nwant it unchanged", n, got)
+	}
+}
@@ -0,0 +1,127 @@
+package linter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/user/flowlint/internal/layout"
+	"github.com/user/flowlint/internal/parser"
+)
+
+// checkLayout estimates how readable the diagram's layout is with a
+// layered layout of the nodes in declaration order, which mermaid's
+// layout follows closely. It reports edge crossings, edges spanning
+// several layers and edges cutting through subgraphs beyond the
+// thresholds. When the style guide forces the linear layout on crossing,
+// any crossing is reported.
+func checkLayout(diagram *parser.Diagram, cfg *Config) []Issue {
+	issues := []Issue{}
+	if len(diagram.Nodes) == 0 {
+		return issues
+	}
+
+	declared := layout.Declared(diagram)
+	m := declared.Metrics
+
+	force := cfg.Guide.LayoutStrategies.Thresholds.ForceLinearOnCrossing
+	allowed := cfg.Layout.Crossings
+	if force {
+		allowed = 0
+	}
+	if m.Crossings > allowed {
+		best := layout.Compute(diagram)
+		crossing := []*layout.Edge{}
+		for _, e := range declared.Edges {
+			if e.Crossings > 0 {
+				crossing = append(crossing, e)
+			}
+		}
+
+		issue := layoutIssue(fmt.Sprintf("Edge crossings with the nodes in declaration order: %d (%d allowed)", m.Crossings, allowed),
+			crossing, func(e *layout.Edge) string { return edgeName(e.Edge) })
+		switch {
+		case best.Metrics.Crossings <= allowed:
+			issue.Suggestion = fmt.Sprintf("Reorder the node declarations within their subgraphs; %d crossings remain", best.Metrics.Crossings)
+		case force:
+			issue.Suggestion = fmt.Sprintf("Reordering leaves %d crossings; the style guide asks for the linear pipeline layout", best.Metrics.Crossings)
+		default:
+			issue.Suggestion = fmt.Sprintf("Reordering leaves %d crossings; regroup the nodes or split the diagram", best.Metrics.Crossings)
+		}
+		if order, changed := declarationOrder(diagram, declared, best); changed && best.Metrics.Crossings < m.Crossings {
+			issue.Fixable = true
+			issue.FixType = "reorder_nodes"
+			issue.FixData = map[string]string{"order": strings.Join(order, ",")}
+		}
+		issues = append(issues, issue)
+	}
+
+	if m.LongEdges > cfg.Layout.LongEdges {
+		long := []*layout.Edge{}
+		for _, e := range declared.Edges {
+			if e.RankSpan > 1 {
+				long = append(long, e)
+			}
+		}
+		issue := layoutIssue(fmt.Sprintf("Edges spanning several layers: %d (%d allowed)", m.LongEdges, cfg.Layout.LongEdges),
+			long, func(e *layout.Edge) string { return fmt.Sprintf("%s (%d layers)", edgeName(e.Edge), e.RankSpan) })
+		issue.Suggestion = "Point long edges at the subgraph instead of a node deep inside it, or move the nodes closer to each other"
+		issues = append(issues, issue)
+	}
+
+	if m.Piercings > cfg.Layout.Piercings {
+		piercing := []*layout.Edge{}
+		for _, e := range declared.Edges {
+			if len(e.Pierces) > 0 {
+				piercing = append(piercing, e)
+			}
+		}
+		issue := layoutIssue(fmt.Sprintf("Edges cutting through subgraphs they do not touch: %d (%d allowed)", m.Piercings, cfg.Layout.Piercings),
+			piercing, func(e *layout.Edge) string {
+				return fmt.Sprintf("%s through %s", edgeName(e.Edge), strings.Join(e.Pierces, ", "))
+			})
+		issue.Suggestion = "Follow the style guide's layer order (entry, target, dependencies, topics, data, external) so edges run between neighbors"
+		issues = append(issues, issue)
+	}
+
+	return issues
+}
+
+// layoutIssue reports a layout flaw at the first edge showing it, with
+// every such edge described in the context
+func layoutIssue(message string, edges []*layout.Edge, describe func(*layout.Edge) string) Issue {
+	issue := Issue{Severity: SeverityWarning, Message: message}
+	names := make([]string, len(edges))
+	for i, e := range edges {
+		names[i] = describe(e)
+	}
+	issue.Context = strings.Join(names, "; ")
+	if len(edges) > 0 {
+		issue.Line = edges[0].Edge.Line
+		issue.Loc = edges[0].Edge.Loc
+	}
+	return issue
+}
+
+// declarationOrder returns the node IDs of every subgraph in the order
+// the best layout places them, and whether that differs from the
+// declaration order
+func declarationOrder(diagram *parser.Diagram, declared, best *layout.Layout) ([]string, bool) {
+	order, changed := []string{}, false
+	subgraphs := []string{""}
+	for _, sg := range diagram.Subgraphs {
+		subgraphs = append(subgraphs, sg.ID)
+	}
+	for _, id := range subgraphs {
+		was, now := declared.Order(id), best.Order(id)
+		if strings.Join(was, ",") != strings.Join(now, ",") {
+			changed = true
+		}
+		order = append(order, now...)
+	}
+	return order, changed
+}
+
+// edgeName writes an edge for messages, e.g. A ==> B
+func edgeName(edge *parser.Edge) string {
+	return fmt.Sprintf("%s %s %s", edge.From, edge.ArrowType, edge.To)
+}
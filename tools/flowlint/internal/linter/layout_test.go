@@ -0,0 +1,112 @@
+package linter
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/user/flowlint/internal/styles"
+)
+
+func TestLayoutCrossingsReordered(t *testing.T) {
+	code := "flowchart TD\n    A[Orders]\n    B[Billing]\n    C[Ledger]\n    D[Stock]\n    A --> D\n    B --> C"
+	issues := lintRule(t, code, "layout", Config{})
+	if len(issues) != 1 {
+		t.Fatalf("issues = %q, want one crossing", messages(issues))
+	}
+	issue := issues[0]
+	if want := "Edge crossings with the nodes in declaration order: 1 (0 allowed)"; issue.Message != want {
+		t.Errorf("message = %q, want %q", issue.Message, want)
+	}
+	if issue.Context != "A --> D; B --> C" || issue.Loc.String() != "6:5" {
+		t.Errorf("context = %q at %s, want both edges at 6:5", issue.Context, issue.Loc)
+	}
+	if !issue.Fixable || issue.FixType != "reorder_nodes" || issue.FixData["order"] != "A,D,B,C" {
+		t.Fatalf("fix = %v %q %v, want reorder_nodes to A,D,B,C", issue.Fixable, issue.FixType, issue.FixData)
+	}
+
+	fixed, n := Fix(code, issues)
+	want := "flowchart TD\n    A[Orders]\n    D[Stock]\n    B[Billing]\n    C[Ledger]\n    A --> D\n    B --> C"
+	if n != 1 || fixed != want {
+		t.Errorf("Fix = %d\n%s\nwant 1\n%s", n, fixed, want)
+	}
+	if msgs := messages(lintRule(t, fixed, "layout", Config{})); len(msgs) != 0 {
+		t.Errorf("after the fix: %q, want no issues", msgs)
+	}
+}
+
+func TestLayoutCrossingsAllowed(t *testing.T) {
+	code := "flowchart TD\n    A\n    B\n    C\n    D\n    A --> D\n    B --> C"
+	guide := styles.DefaultGuide()
+	guide.LayoutStrategies.Thresholds.ForceLinearOnCrossing = false
+
+	tests := []struct {
+		name   string
+		layout *LayoutThresholds
+		want   int
+	}{
+		{"default allows one", nil, 0},
+		{"zero allows none", &LayoutThresholds{Crossings: 0, LongEdges: 1, Piercings: 1}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := lintRule(t, code, "layout", Config{Guide: guide, Layout: tt.layout})
+			if len(issues) != tt.want {
+				t.Errorf("issues = %q, want %d", messages(issues), tt.want)
+			}
+		})
+	}
+}
+
+func TestLayoutPiercings(t *testing.T) {
+	code := "flowchart TD\n    A\n    subgraph S\n        B\n    end\n    C\n    A --> B --> C\n    A --> C"
+	issues := lintRule(t, code, "layout", Config{Layout: &LayoutThresholds{LongEdges: 1}})
+	want := []string{"Edges cutting through subgraphs they do not touch: 1 (0 allowed)"}
+	if msgs := messages(issues); !reflect.DeepEqual(msgs, want) {
+		t.Fatalf("messages = %q, want %q", msgs, want)
+	}
+	if issues[0].Context != "A --> C through S" {
+		t.Errorf("context = %q, want the edge and the subgraph", issues[0].Context)
+	}
+}
+
+func TestLayoutSubgraphReusingID(t *testing.T) {
+	// The second A sits in a B that reuses the ID of A's own child, so
+	// following subgraph IDs leads back to A
+	code := "flowchart TD\n    subgraph A\n    subgraph B\n    X\n    end\n    end\n    subgraph B\n    subgraph A\n    Y\n    end\n    end\n    X --> Y"
+	if msgs := messages(lintRule(t, code, "layout", Config{Layout: &LayoutThresholds{}})); len(msgs) != 0 {
+		t.Errorf("messages = %q, want no issues", msgs)
+	}
+}
+
+func TestReorderDeclarations(t *testing.T) {
+	lines := []string{
+		"flowchart TD",
+		"    C[Ledger]",
+		"    A[Orders]",
+		"    subgraph S",
+		"        E",
+		"        D",
+		"    end",
+		"    B",
+		"    A --> B",
+	}
+	got, changed := reorderDeclarations(lines, []string{"A", "B", "C", "D", "E"})
+	want := []string{
+		"flowchart TD",
+		"    A[Orders]",
+		"    C[Ledger]",
+		"    subgraph S",
+		"        D",
+		"        E",
+		"    end",
+		"    B",
+		"    A --> B",
+	}
+	if !changed || !reflect.DeepEqual(got, want) {
+		t.Errorf("reorderDeclarations = %v\n%s\nwant\n%s", changed, strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if _, changed := reorderDeclarations(want, []string{"A", "B", "C", "D", "E"}); changed {
+		t.Error("sorted declarations reported as changed")
+	}
+}
@@ -0,0 +1,236 @@
+package linter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/user/flowlint/internal/parser"
+)
+
+// RulesDocsURL is the rule reference; each rule has an anchor in it
+const RulesDocsURL = "https://github.com/user/flowlint/blob/main/tools/flowlint/docs/rules.md"
+
+// Rule is a single lint check with stable metadata, so findings can be
+// grouped, configured and looked up by rule
+type Rule interface {
+	ID() string   // stable identifier, e.g. FL001
+	Name() string // readable identifier, e.g. subgraph-quotes
+	DefaultSeverity() Severity
+	Description() string
+	DocsURL() string
+	Fixable() bool // whether Fix can repair some of its issues
+	Check(diagram *parser.Diagram, cfg *Config) []Issue
+}
+
+// checkRule is a Rule backed by a check function
+type checkRule struct {
+	id          string
+	name        string
+	severity    Severity
+	description string
+	fixable     bool
+	check       func(*parser.Diagram, *Config) []Issue
+}
+
+func (r *checkRule) ID() string                { return r.id }
+func (r *checkRule) Name() string              { return r.name }
+func (r *checkRule) DefaultSeverity() Severity { return r.severity }
+func (r *checkRule) Description() string       { return r.description }
+func (r *checkRule) Fixable() bool             { return r.fixable }
+
+func (r *checkRule) DocsURL() string {
+	return fmt.Sprintf("%s#%s-%s", RulesDocsURL, strings.ToLower(r.id), r.name)
+}
+
+func (r *checkRule) Check(diagram *parser.Diagram, cfg *Config) []Issue {
+	return r.check(diagram, cfg)
+}
+
+// registry holds every rule in the order Lint runs them
+var registry = []Rule{
+	&checkRule{id: "FL010", name: "unrecognized-statement", severity: SeverityError, check: checkUnparsed,
+		description: "Statements the parser does not understand (reported in strict mode)"},
+	&checkRule{id: "FL001", name: "subgraph-quotes", severity: SeverityError, fixable: true, check: checkSubgraphQuotes,
+		description: "Subgraph titles must be quoted"},
+	&checkRule{id: "FL002", name: "arrow-style", severity: SeverityError, fixable: true, check: checkArrowStyles,
+		description: "Sync calls use ==>, async calls use -.->"},
+	&checkRule{id: "FL003", name: "required-classdefs", severity: SeverityWarning, fixable: true, check: checkClassDefs,
+		description: "The service, kafka, database and external classDefs are defined"},
+	&checkRule{id: "FL004", name: "orphan-nodes", severity: SeverityWarning, check: checkOrphanNodes,
+		description: "Every node has at least one connection"},
+	&checkRule{id: "FL005", name: "abbreviations", severity: SeverityWarning, check: checkAbbreviations,
+		description: "Node labels use full words instead of abbreviations"},
+	&checkRule{id: "FL006", name: "duplicate-nodes", severity: SeverityError, check: checkDuplicateNodes,
+		description: "A node ID is not defined twice with a different label, shape or subgraph"},
+	&checkRule{id: "FL007", name: "label-newlines", severity: SeverityError, fixable: true, check: checkNewlinesInLabels,
+		description: "Node, edge and subgraph labels are single-line"},
+	&checkRule{id: "FL008", name: "complexity", severity: SeverityWarning, check: checkComplexity,
+		description: "The diagram is not spaghetti: grouped, not too dense, with a clear hub"},
+	&checkRule{id: "FL009", name: "inline-styles", severity: SeverityWarning, check: checkInlineStyles,
+		description: "style and linkStyle colors come from the palette"},
+	&checkRule{id: "FL011", name: "classdef-colors", severity: SeverityWarning, fixable: true, check: checkClassDefColors,
+		description: "classDef fill, stroke and text colors match the style guide"},
+	&checkRule{id: "FL012", name: "node-shapes", severity: SeverityError, fixable: true, check: checkNodeShapes,
+		description: "Nodes use the shape the style guide gives their type"},
+	&checkRule{id: "FL013", name: "node-classes", severity: SeverityWarning, fixable: true, check: checkNodeClasses,
+		description: "Every node has a class applied"},
+	&checkRule{id: "FL014", name: "budget", severity: SeverityWarning, check: checkBudget,
+		description: "The diagram stays within the style guide's node, edge, dependency and topic limits"},
+	&checkRule{id: "FL015", name: "layout", severity: SeverityWarning, fixable: true, check: checkLayout,
+		description: "Edges do not cross, span many layers or cut through subgraphs"},
+}
+
+// Rules returns every registered rule, ordered by ID
+func Rules() []Rule {
+	rules := append([]Rule(nil), registry...)
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].ID() < rules[j].ID()
+	})
+	return rules
+}
+
+// LookupRule finds a rule by ID (FL001, case-insensitive) or name
+// (subgraph-quotes), or returns nil
+func LookupRule(ref string) Rule {
+	for _, r := range registry {
+		if strings.EqualFold(r.ID(), ref) || r.Name() == ref {
+			return r
+		}
+	}
+	return nil
+}
+
+// ParseSeverity parses a severity level: error or warning
+func ParseSeverity(level string) (Severity, error) {
+	switch strings.ToLower(level) {
+	case "error":
+		return SeverityError, nil
+	case "warning", "warn":
+		return SeverityWarning, nil
+	}
+	return 0, fmt.Errorf("unknown severity %q: use error, warning or off", level)
+}
+
+// Validate reports unknown rules and severity levels, and rules given a
+// severity twice, by ID and by name
+func (c Config) Validate() error {
+	for _, refs := range [][]string{c.Enable, c.Disable} {
+		for _, ref := range refs {
+			if ref != "all" && LookupRule(ref) == nil {
+				return fmt.Errorf("unknown rule %q (see flowlint rules)", ref)
+			}
+		}
+	}
+	seen := make(map[Rule]string)
+	for _, ref := range c.severityRefs() {
+		level := c.Severity[ref]
+		r := LookupRule(ref)
+		if r == nil {
+			return fmt.Errorf("unknown rule %q (see flowlint rules)", ref)
+		}
+		if first, ok := seen[r]; ok {
+			return fmt.Errorf("rule %s has two severities, as %q and %q", r.ID(), first, ref)
+		}
+		seen[r] = ref
+		if level == "off" {
+			continue
+		}
+		if _, err := ParseSeverity(level); err != nil {
+			return fmt.Errorf("rule %s: %w", ref, err)
+		}
+	}
+	return nil
+}
+
+// Enabled returns the rules the configuration runs, in run order
+func (c Config) Enabled() []Rule {
+	enabled := make(map[string]bool)
+	for _, r := range registry {
+		enabled[r.ID()] = true
+	}
+	set := func(refs []string, on bool) {
+		for _, ref := range refs {
+			if ref == "all" {
+				for id := range enabled {
+					enabled[id] = on
+				}
+			} else if r := LookupRule(ref); r != nil {
+				enabled[r.ID()] = on
+			}
+		}
+	}
+	set(c.Disable, false)
+	set(c.Enable, true)
+	for ref, level := range c.Severity {
+		if r := LookupRule(ref); r != nil && level == "off" {
+			enabled[r.ID()] = false
+		}
+	}
+
+	rules := []Rule{}
+	for _, r := range registry {
+		if enabled[r.ID()] {
+			rules = append(rules, r)
+		}
+	}
+	return rules
+}
+
+// WithRules applies the rule selection of over on top of c: rules c
+// leaves off stay off unless over enables them, and over's severities
+// win. Settings other than the selection are kept from c.
+func (c Config) WithRules(over Config) Config {
+	enabled := make(map[string]bool)
+	for _, r := range c.Enabled() {
+		enabled[r.ID()] = true
+	}
+	disable := []string{}
+	for _, r := range registry {
+		if !enabled[r.ID()] {
+			disable = append(disable, r.ID())
+		}
+	}
+	severity := make(map[string]string)
+	for ref, level := range c.Severity {
+		if r := LookupRule(ref); r != nil && level != "off" {
+			severity[r.ID()] = level
+		}
+	}
+	for ref, level := range over.Severity {
+		if r := LookupRule(ref); r != nil {
+			severity[r.ID()] = level
+		}
+	}
+
+	c.Enable = over.Enable
+	c.Disable = append(disable, over.Disable...)
+	c.Severity = severity
+	return c
+}
+
+// severityOf returns the configured severity of a rule, if it has one.
+// Validate rejects a rule given two severities; should one slip through,
+// the first reference in sorted order wins.
+func (c Config) severityOf(r Rule) (Severity, bool) {
+	for _, ref := range c.severityRefs() {
+		if LookupRule(ref) != r {
+			continue
+		}
+		if severity, err := ParseSeverity(c.Severity[ref]); err == nil {
+			return severity, true
+		}
+	}
+	return 0, false
+}
+
+// severityRefs returns the rule references of the severity settings,
+// sorted
+func (c Config) severityRefs() []string {
+	refs := make([]string, 0, len(c.Severity))
+	for ref := range c.Severity {
+		refs = append(refs, ref)
+	}
+	sort.Strings(refs)
+	return refs
+}
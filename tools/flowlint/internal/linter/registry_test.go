@@ -0,0 +1,134 @@
+package linter
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// ruleIDs returns the ID of each rule
+func ruleIDs(rules []Rule) []string {
+	ids := []string{}
+	for _, r := range rules {
+		ids = append(ids, r.ID())
+	}
+	return ids
+}
+
+func TestLookupRule(t *testing.T) {
+	tests := []struct {
+		ref  string
+		want string
+	}{
+		{"FL004", "FL004"},
+		{"fl004", "FL004"},
+		{"orphan-nodes", "FL004"},
+		{"Orphan-Nodes", ""},
+		{"FL099", ""},
+		{"all", ""},
+	}
+	for _, tt := range tests {
+		got := ""
+		if r := LookupRule(tt.ref); r != nil {
+			got = r.ID()
+		}
+		if got != tt.want {
+			t.Errorf("LookupRule(%q) = %q, want %q", tt.ref, got, tt.want)
+		}
+	}
+}
+
+func TestRulesDocsURL(t *testing.T) {
+	if got, want := LookupRule("FL004").DocsURL(), "/tools/flowlint/docs/rules.md#fl004-orphan-nodes"; !strings.HasSuffix(got, want) {
+		t.Errorf("DocsURL = %q, want one ending in %q", got, want)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+		want string // error substring, "" for none
+	}{
+		{"known rules", Config{Enable: []string{"FL001", "orphan-nodes"}, Disable: []string{"all"},
+			Severity: map[string]string{"FL005": "error", "budget": "off"}}, ""},
+		{"unknown enable", Config{Enable: []string{"spelling"}}, `unknown rule "spelling"`},
+		{"unknown severity rule", Config{Severity: map[string]string{"FL099": "error"}}, `unknown rule "FL099"`},
+		{"bad level", Config{Severity: map[string]string{"FL005": "fatal"}}, `rule FL005: unknown severity "fatal"`},
+		{"ID and name", Config{Severity: map[string]string{"FL005": "error", "abbreviations": "warning"}},
+			`rule FL005 has two severities, as "FL005" and "abbreviations"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			switch {
+			case tt.want == "" && err != nil:
+				t.Errorf("Validate = %v, want nil", err)
+			case tt.want != "" && (err == nil || !strings.Contains(err.Error(), tt.want)):
+				t.Errorf("Validate = %v, want an error containing %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnabled(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+		want []string
+	}{
+		{"only", Config{Enable: []string{"abbreviations", "FL001"}, Disable: []string{"all"}}, []string{"FL001", "FL005"}},
+		{"enable wins over disable", Config{Enable: []string{"FL001"}, Disable: []string{"FL001", "all"}}, []string{"FL001"}},
+		{"enable all", Config{Enable: []string{"all"}, Disable: []string{"all"}}, ruleIDs(registry)},
+		{"off", Config{Enable: []string{"FL001", "FL002"}, Disable: []string{"all"},
+			Severity: map[string]string{"arrow-style": "off"}}, []string{"FL001"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ruleIDs(tt.cfg.Enabled()); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Enabled = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSeverityOverride(t *testing.T) {
+	code := "flowchart TD\n    A[Payment Svc] --> B"
+	issues := lintRule(t, code, "abbreviations", Config{Severity: map[string]string{"abbreviations": "error"}})
+	if len(issues) != 1 || issues[0].Severity != SeverityError || issues[0].Rule != "FL005" {
+		t.Errorf("issues = %+v, want one FL005 error", issues)
+	}
+	if issues := lintRule(t, code, "abbreviations", Config{}); len(issues) != 1 || issues[0].Severity != SeverityWarning {
+		t.Errorf("issues = %+v, want one warning by default", issues)
+	}
+}
+
+func TestWithRules(t *testing.T) {
+	project := Config{
+		Disable:  []string{"complexity"},
+		Severity: map[string]string{"orphan-nodes": "error", "FL005": "off"},
+	}
+	got := project.WithRules(Config{
+		Enable:   []string{"FL008"},
+		Disable:  []string{"budget"},
+		Severity: map[string]string{"FL004": "warning"},
+	})
+
+	enabled := ruleIDs(got.Enabled())
+	for _, id := range []string{"FL004", "FL008"} {
+		if !strings.Contains(strings.Join(enabled, ","), id) {
+			t.Errorf("%s not enabled: %v", id, enabled)
+		}
+	}
+	for _, id := range []string{"FL005", "FL014"} {
+		if strings.Contains(strings.Join(enabled, ","), id) {
+			t.Errorf("%s enabled: %v", id, enabled)
+		}
+	}
+	if want := map[string]string{"FL004": "warning"}; !reflect.DeepEqual(got.Severity, want) {
+		t.Errorf("severities = %v, want %v: the flag wins", got.Severity, want)
+	}
+
+	// Unknown references are left to Validate, not a panic
+	Config{Severity: map[string]string{"FL099": "error"}}.WithRules(Config{})
+}
@@ -3,9 +3,11 @@ package linter
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/user/flowlint/internal/parser"
+	"github.com/user/flowlint/internal/styles"
 )
 
 // Severity represents the severity of a lint issue
@@ -18,9 +20,11 @@ const (
 
 // Issue represents a linting issue found in the diagram
 type Issue struct {
+	Rule       string // ID of the rule that reported it, e.g. FL001
 	Severity   Severity
 	Message    string
-	Line       int
+	Line       int             // line in the file, same as Loc.Line
+	Loc        parser.Location // zero for issues about the diagram as a whole
 	Context    string
 	Suggestion string
 	Fixable    bool
@@ -28,24 +32,47 @@ type Issue struct {
 	FixData    map[string]string
 }
 
-// Lint runs all linting rules against the diagram
-func Lint(diagram *parser.Diagram) []Issue {
+// Lint runs the rules enabled by cfg against the diagram. Issues keep
+// the severity their rule gives them unless cfg overrides it. Settings
+// cfg leaves unset take their default.
+func Lint(diagram *parser.Diagram, cfg Config) []Issue {
 	issues := []Issue{}
+	cfg = cfg.withDefaults()
+
+	for _, rule := range cfg.Enabled() {
+		severity, override := cfg.severityOf(rule)
+		for _, issue := range rule.Check(diagram, &cfg) {
+			issue.Rule = rule.ID()
+			if override {
+				issue.Severity = severity
+			}
+			issues = append(issues, issue)
+		}
+	}
 
-	issues = append(issues, checkSubgraphQuotes(diagram)...)
-	issues = append(issues, checkArrowStyles(diagram)...)
-	issues = append(issues, checkClassDefs(diagram)...)
-	issues = append(issues, checkOrphanNodes(diagram)...)
-	issues = append(issues, checkAbbreviations(diagram)...)
-	issues = append(issues, checkDuplicateNodes(diagram)...)
-	issues = append(issues, checkNewlinesInLabels(diagram)...)
-	issues = append(issues, checkComplexity(diagram)...)
+	return issues
+}
+
+// checkUnparsed reports the statements a strict parse did not understand
+func checkUnparsed(diagram *parser.Diagram, cfg *Config) []Issue {
+	issues := []Issue{}
+
+	for _, diag := range diagram.Diagnostics {
+		issues = append(issues, Issue{
+			Severity:   SeverityError,
+			Message:    fmt.Sprintf("Unrecognized statement: %s", diag.Text),
+			Line:       diag.Loc.Line,
+			Loc:        diag.Loc,
+			Context:    diag.Text,
+			Suggestion: "Fix the syntax; nodes and edges on this line are not seen by any rule",
+		})
+	}
 
 	return issues
 }
 
 // checkSubgraphQuotes ensures all subgraph titles are quoted
-func checkSubgraphQuotes(diagram *parser.Diagram) []Issue {
+func checkSubgraphQuotes(diagram *parser.Diagram, cfg *Config) []Issue {
 	issues := []Issue{}
 
 	for _, sg := range diagram.Subgraphs {
@@ -54,11 +81,12 @@ func checkSubgraphQuotes(diagram *parser.Diagram) []Issue {
 				Severity:   SeverityError,
 				Message:    fmt.Sprintf("Subgraph '%s' title is not quoted", sg.ID),
 				Line:       sg.Line,
+				Loc:        sg.Loc,
 				Context:    fmt.Sprintf("subgraph %s [%s]", sg.ID, sg.Title),
 				Suggestion: fmt.Sprintf("Change to: subgraph %s [\"%s\"]", sg.ID, sg.Title),
 				Fixable:    true,
 				FixType:    "quote_subgraph",
-				FixData:    map[string]string{"id": sg.ID, "title": sg.Title},
+				FixData:    spanData(sg.TitleSpan, sg.RawTitle, map[string]string{"id": sg.ID, "title": sg.Title}),
 			})
 		}
 	}
@@ -66,46 +94,30 @@ func checkSubgraphQuotes(diagram *parser.Diagram) []Issue {
 	return issues
 }
 
-// checkArrowStyles ensures correct arrow usage for sync vs async
-func checkArrowStyles(diagram *parser.Diagram) []Issue {
+// checkArrowStyles ensures correct arrow usage for sync vs async. Links are
+// compared by stroke, so ===>, <==> and == text ==> all count as sync.
+func checkArrowStyles(diagram *parser.Diagram, cfg *Config) []Issue {
 	issues := []Issue{}
 
-	// Keywords that indicate async communication
-	asyncKeywords := []string{"kafka", "publish", "consume", "queue", "rabbitmq", "sqs", "pubsub"}
-	// Keywords that indicate sync communication
-	syncKeywords := []string{"grpc", "http", "rest", "sql", "cache", "redis"}
-
 	for _, edge := range diagram.Edges {
 		labelLower := strings.ToLower(edge.Label)
 
 		// Check if async keyword but using sync arrow
-		for _, keyword := range asyncKeywords {
-			if strings.Contains(labelLower, keyword) && edge.ArrowType == "==>" {
-				issues = append(issues, Issue{
-					Severity:   SeverityError,
-					Message:    fmt.Sprintf("Async call '%s' using sync arrow (==>)", edge.Label),
-					Line:       edge.Line,
-					Suggestion: "Change ==> to -.-> for async calls",
-					Fixable:    true,
-					FixType:    "fix_arrow",
-					FixData:    map[string]string{"from": edge.From, "to": edge.To, "old": "==>", "new": "-.->"},
-				})
+		for _, keyword := range cfg.AsyncKeywords {
+			if strings.Contains(labelLower, strings.ToLower(keyword)) && edge.Stroke == parser.StrokeThick {
+				issues = append(issues, arrowIssue(edge, SeverityError,
+					fmt.Sprintf("Async call '%s' using sync arrow (%s)", edge.Label, edge.ArrowType),
+					parser.StrokeDotted))
 				break
 			}
 		}
 
 		// Check if sync keyword but using async arrow
-		for _, keyword := range syncKeywords {
-			if strings.Contains(labelLower, keyword) && edge.ArrowType == "-.->" {
-				issues = append(issues, Issue{
-					Severity:   SeverityWarning,
-					Message:    fmt.Sprintf("Sync call '%s' using async arrow (-.->)", edge.Label),
-					Line:       edge.Line,
-					Suggestion: "Change -.-> to ==> for sync calls",
-					Fixable:    true,
-					FixType:    "fix_arrow",
-					FixData:    map[string]string{"from": edge.From, "to": edge.To, "old": "-.->", "new": "==>"},
-				})
+		for _, keyword := range cfg.SyncKeywords {
+			if strings.Contains(labelLower, strings.ToLower(keyword)) && edge.Stroke == parser.StrokeDotted {
+				issues = append(issues, arrowIssue(edge, SeverityWarning,
+					fmt.Sprintf("Sync call '%s' using async arrow (%s)", edge.Label, edge.ArrowType),
+					parser.StrokeThick))
 				break
 			}
 		}
@@ -114,21 +126,109 @@ func checkArrowStyles(diagram *parser.Diagram) []Issue {
 	return issues
 }
 
+// arrowIssue reports an edge drawn with the wrong stroke. The fix keeps the
+// link's head, length and direction, and moves an inline label
+// (A -- text --> B) into pipe form.
+func arrowIssue(edge *parser.Edge, severity Severity, message, stroke string) Issue {
+	fixed := edge.LinkType
+	fixed.Stroke = stroke
+	newArrow := fixed.String()
+	if edge.Inline {
+		newArrow += "|" + edge.Label + "|"
+	}
+
+	return Issue{
+		Severity:   severity,
+		Message:    message,
+		Line:       edge.Line,
+		Loc:        edge.Loc,
+		Context:    edge.RawArrow,
+		Suggestion: fmt.Sprintf("Change %s to %s", edge.ArrowType, fixed.String()),
+		Fixable:    true,
+		FixType:    "fix_arrow",
+		FixData:    spanData(edge.ArrowSpan, edge.RawArrow, map[string]string{"from": edge.From, "to": edge.To, "new": newArrow}),
+	}
+}
+
 // checkClassDefs ensures required class definitions exist
-func checkClassDefs(diagram *parser.Diagram) []Issue {
+func checkClassDefs(diagram *parser.Diagram, cfg *Config) []Issue {
 	issues := []Issue{}
 
-	requiredClasses := []string{"service", "kafka", "database", "external"}
-
-	for _, class := range requiredClasses {
+	for _, class := range cfg.RequiredClasses {
 		if _, ok := diagram.ClassDefs[class]; !ok {
+			def, known := cfg.Guide.ClassDef(class)
+			suggestion := "Add: " + def
+			if !known {
+				suggestion = fmt.Sprintf("Add: classDef %s fill:#...,stroke:#...,color:#...", class)
+			}
 			issues = append(issues, Issue{
 				Severity:   SeverityWarning,
 				Message:    fmt.Sprintf("Missing classDef for '%s'", class),
-				Suggestion: fmt.Sprintf("Add: classDef %s fill:#...,stroke:#...,color:#...", class),
-				Fixable:    true,
+				Suggestion: suggestion,
+				Fixable:    known,
 				FixType:    "add_classdef",
-				FixData:    map[string]string{"class": class},
+				FixData:    map[string]string{"class": class, "def": def},
+			})
+		}
+	}
+
+	return issues
+}
+
+// paletteProps are the classDef properties the style guide fixes
+var paletteProps = []string{"fill", "stroke", "color"}
+
+// checkClassDefColors compares the colors of every classDef the style
+// guide knows with its canonical definition
+func checkClassDefColors(diagram *parser.Diagram, cfg *Config) []Issue {
+	issues := []Issue{}
+	if diagram.AST == nil {
+		return issues
+	}
+
+	for _, stmt := range diagram.AST.Statements {
+		def, ok := stmt.(*parser.ClassDefStmt)
+		if !ok {
+			continue
+		}
+		props := parser.ParseStyleProps(def.Styles)
+		for _, class := range def.Names {
+			canonical, known := cfg.Guide.ClassDef(class)
+			if !known {
+				continue
+			}
+			want := parser.ParseStyleProps(styles.ClassDefStyles(canonical))
+
+			drift := []string{}
+			for _, key := range paletteProps {
+				switch got, ok := props[key]; {
+				case !ok:
+					drift = append(drift, fmt.Sprintf("%s missing (want %s)", key, want[key]))
+				case got != want[key]:
+					drift = append(drift, fmt.Sprintf("%s:%s (want %s)", key, got, want[key]))
+				}
+			}
+			if len(drift) == 0 {
+				continue
+			}
+
+			suggestion := "Use: " + canonical
+			if len(def.Names) > 1 {
+				suggestion = fmt.Sprintf("Give %s its own classDef: %s", class, canonical)
+			}
+			loc := diagram.Locate(def.Span)
+			issues = append(issues, Issue{
+				Severity:   SeverityWarning,
+				Message:    fmt.Sprintf("classDef %s does not match the style guide: %s", class, strings.Join(drift, ", ")),
+				Line:       loc.Line,
+				Loc:        loc,
+				Context:    fmt.Sprintf("classDef %s %s", strings.Join(def.Names, ","), def.Styles),
+				Suggestion: suggestion,
+				// A classDef shared by several classes cannot take the
+				// colors of just one of them
+				Fixable: len(def.Names) == 1,
+				FixType: "fix_classdef",
+				FixData: map[string]string{"class": class, "old": def.Styles, "new": canonicalStyles(want, def.Styles)},
 			})
 		}
 	}
@@ -136,8 +236,118 @@ func checkClassDefs(diagram *parser.Diagram) []Issue {
 	return issues
 }
 
+// canonicalStyles returns the palette colors followed by the properties
+// of styles that are not colors, such as stroke-width
+func canonicalStyles(palette map[string]string, styles string) string {
+	props := []string{}
+	for _, key := range paletteProps {
+		props = append(props, key+":"+palette[key])
+	}
+	for _, prop := range strings.Split(styles, ",") {
+		key, _, ok := strings.Cut(prop, ":")
+		key = strings.ToLower(strings.TrimSpace(key))
+		if ok && key != "fill" && key != "stroke" && key != "color" {
+			props = append(props, strings.TrimSpace(prop))
+		}
+	}
+	return strings.Join(props, ",")
+}
+
+// checkNodeShapes reports nodes not drawn with the shape the style guide
+// gives their type. A type read from the node's class is an error; one
+// guessed from its subgraph and label is a warning.
+func checkNodeShapes(diagram *parser.Diagram, cfg *Config) []Issue {
+	issues := []Issue{}
+
+	for _, node := range diagram.SortedNodes() {
+		t, source, byClass := nodeType(diagram, node)
+		want := cfg.Guide.ShapeOf(t)
+		delims, known := styles.Shapes[want]
+		if !known || node.Shape == want {
+			continue
+		}
+
+		severity := SeverityWarning
+		if byClass {
+			severity = SeverityError
+		}
+		label := node.RawLabel
+		if label == "" {
+			label = node.Label
+		}
+		issue := Issue{
+			Severity:   severity,
+			Message:    fmt.Sprintf("Node '%s' (%s, from its %s) is drawn as %s; the style guide draws %s nodes as %s", node.ID, t, source, node.Shape, t, want),
+			Line:       node.Line,
+			Loc:        node.Loc,
+			Context:    node.Label,
+			Suggestion: fmt.Sprintf("Write it as %s%s%s%s", node.ID, delims.Open, label, delims.Close),
+		}
+		// Only bracket definitions can be rewritten: a node that is never
+		// defined has no brackets, and A@{ shape: ... } names its shape
+		if old, ok := styles.Shapes[node.Shape]; ok && bracketDefined(node) {
+			issue.Fixable = true
+			issue.FixType = "fix_shape"
+			issue.FixData = map[string]string{
+				"id":        node.ID,
+				"label":     node.RawLabel,
+				"old_open":  old.Open,
+				"old_close": old.Close,
+				"new_open":  delims.Open,
+				"new_close": delims.Close,
+			}
+		}
+		issues = append(issues, issue)
+	}
+
+	return issues
+}
+
+// checkNodeClasses finds nodes without a class, which mermaid draws in
+// its default colors, and infers the class each should have
+func checkNodeClasses(diagram *parser.Diagram, cfg *Config) []Issue {
+	issues := []Issue{}
+
+	for _, node := range diagram.SortedNodes() {
+		if len(node.Classes) > 0 {
+			continue
+		}
+		issue := Issue{
+			Severity:   SeverityWarning,
+			Message:    fmt.Sprintf("Node '%s' has no class and renders in mermaid's default colors", node.ID),
+			Line:       node.Line,
+			Loc:        node.Loc,
+			Context:    node.Label,
+			Suggestion: fmt.Sprintf("Apply a class: class %s <type>", node.ID),
+		}
+		if class, source := inferClass(diagram, node, cfg); class != "" {
+			issue.Suggestion = fmt.Sprintf("Apply class %s (from %s): class %s %s", class, source, node.ID, class)
+			issue.Fixable = true
+			issue.FixType = "add_class"
+			issue.FixData = map[string]string{"id": node.ID, "class": class}
+		}
+		issues = append(issues, issue)
+	}
+
+	return issues
+}
+
+// bracketDefined reports whether every definition of a node uses the
+// bracket syntax, as in A[(label)]
+func bracketDefined(node *parser.Node) bool {
+	if len(node.Definitions) == 0 {
+		return false
+	}
+	for _, def := range node.Definitions {
+		if def.Attributes {
+			return false
+		}
+	}
+	return true
+}
+
 // checkOrphanNodes finds nodes with no connections
-func checkOrphanNodes(diagram *parser.Diagram) []Issue {
+func checkOrphanNodes(diagram *parser.Diagram, cfg *Config) []Issue {
 	issues := []Issue{}
 
 	orphans := diagram.GetOrphanNodes()
@@ -146,6 +356,7 @@ func checkOrphanNodes(diagram *parser.Diagram) []Issue {
 			Severity:   SeverityWarning,
 			Message:    fmt.Sprintf("Orphan node '%s' has no connections", node.ID),
 			Line:       node.Line,
+			Loc:        node.Loc,
 			Context:    node.Label,
 			Suggestion: "Add connections or remove the node",
 		})
@@ -155,36 +366,24 @@ func checkOrphanNodes(diagram *parser.Diagram) []Issue {
 }
 
 // checkAbbreviations warns about potential abbreviations in node labels
-func checkAbbreviations(diagram *parser.Diagram) []Issue {
+func checkAbbreviations(diagram *parser.Diagram, cfg *Config) []Issue {
 	issues := []Issue{}
 
-	// Common abbreviation patterns
-	abbrPatterns := []struct {
-		pattern string
-		full    string
-	}{
-		{`(?i)svc\b`, "Service"},
-		{`(?i)srv\b`, "Server"},
-		{`(?i)msg\b`, "Message"},
-		{`(?i)req\b`, "Request"},
-		{`(?i)res\b`, "Response"},
-		{`(?i)cfg\b`, "Config"},
-		{`(?i)db\b`, "Database"},
-		{`(?i)api\b`, "API"}, // This one is acceptable
-	}
-
-	for _, node := range diagram.Nodes {
-		for _, abbr := range abbrPatterns {
-			if abbr.pattern == `(?i)api\b` {
-				continue // API is acceptable
-			}
-			re := regexp.MustCompile(abbr.pattern)
-			if re.MatchString(node.Label) {
+	patterns := make([]*regexp.Regexp, len(cfg.Abbreviations))
+	for i, abbr := range cfg.Abbreviations {
+		patterns[i] = regexp.MustCompile(`(?i)` + regexp.QuoteMeta(abbr.Short) + `\b`)
+	}
+
+	for _, node := range diagram.SortedNodes() {
+		for i, abbr := range cfg.Abbreviations {
+			if patterns[i].MatchString(node.Label) {
 				issues = append(issues, Issue{
 					Severity:   SeverityWarning,
 					Message:    fmt.Sprintf("Node '%s' may contain abbreviation", node.Label),
 					Line:       node.Line,
-					Suggestion: fmt.Sprintf("Consider using full word '%s' instead", abbr.full),
+					Loc:        node.Loc,
+					Context:    node.Label,
+					Suggestion: fmt.Sprintf("Consider using full word '%s' instead", abbr.Full),
 				})
 				break
 			}
@@ -194,15 +393,62 @@ func checkAbbreviations(diagram *parser.Diagram) []Issue {
 	return issues
 }
 
-// checkDuplicateNodes finds duplicate node IDs
-func checkDuplicateNodes(diagram *parser.Diagram) []Issue {
-	// The parser already handles this by using a map, so duplicates would be overwritten
-	// This is more of a sanity check
-	return []Issue{}
+// checkDuplicateNodes finds node IDs defined more than once with a different
+// label, shape or subgraph. Mermaid silently keeps the last definition, so
+// reusing an ID such as D1 across services merges two nodes into one.
+func checkDuplicateNodes(diagram *parser.Diagram, cfg *Config) []Issue {
+	issues := []Issue{}
+
+	ids := make([]string, 0, len(diagram.Nodes))
+	for id := range diagram.Nodes {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		defs := diagram.Nodes[id].Definitions
+		if len(defs) < 2 {
+			continue
+		}
+		first := defs[0]
+		for _, def := range defs[1:] {
+			conflicts := []string{}
+			if def.Label != first.Label {
+				conflicts = append(conflicts, fmt.Sprintf("label '%s' vs '%s'", first.Label, def.Label))
+			}
+			if def.Shape != first.Shape {
+				conflicts = append(conflicts, fmt.Sprintf("shape %s vs %s", first.Shape, def.Shape))
+			}
+			if def.Subgraph != first.Subgraph {
+				conflicts = append(conflicts, fmt.Sprintf("subgraph '%s' vs '%s'", subgraphName(first.Subgraph), subgraphName(def.Subgraph)))
+			}
+			if len(conflicts) == 0 {
+				continue
+			}
+			issues = append(issues, Issue{
+				Severity:   SeverityError,
+				Message:    fmt.Sprintf("Node ID '%s' has conflicting definitions (lines %d and %d): %s", id, first.Line, def.Line, strings.Join(conflicts, ", ")),
+				Line:       def.Line,
+				Loc:        diagram.Locate(def.Span),
+				Context:    fmt.Sprintf("first defined on line %d", first.Line),
+				Suggestion: "Give each node a unique ID (e.g. prefix with the service: S1_, S2_)",
+			})
+		}
+	}
+
+	return issues
+}
+
+// subgraphName names a subgraph ID for messages, including the top level
+func subgraphName(id string) string {
+	if id == "" {
+		return "(top level)"
+	}
+	return id
 }
 
 // checkComplexity detects potential spaghetti diagrams
-func checkComplexity(diagram *parser.Diagram) []Issue {
+func checkComplexity(diagram *parser.Diagram, cfg *Config) []Issue {
 	issues := []Issue{}
 
 	nodeCount := len(diagram.Nodes)
@@ -232,7 +478,7 @@ func checkComplexity(diagram *parser.Diagram) []Issue {
 		outgoing[edge.From]++
 	}
 	for _, count := range outgoing {
-		if count > 3 {
+		if count > cfg.Thresholds.FanOut {
 			fanOutCount++
 		}
 	}
@@ -244,7 +490,7 @@ func checkComplexity(diagram *parser.Diagram) []Issue {
 		incoming[edge.To]++
 	}
 	for _, count := range incoming {
-		if count > 3 {
+		if count > cfg.Thresholds.FanIn {
 			fanInCount++
 		}
 	}
@@ -254,7 +500,7 @@ func checkComplexity(diagram *parser.Diagram) []Issue {
 	reasons := []string{}
 
 	// Rule 1: Too many nodes without subgraphs
-	if nodeCount > 10 && len(diagram.Subgraphs) < 2 {
+	if nodeCount > cfg.Thresholds.UngroupedNodes && len(diagram.Subgraphs) < 2 {
 		isSpaghetti = true
 		reasons = append(reasons, fmt.Sprintf("%d nodes without grouping", nodeCount))
 	}
@@ -262,26 +508,26 @@ func checkComplexity(diagram *parser.Diagram) []Issue {
 	// Rule 2: High edge-to-node ratio (dense connections)
 	if nodeCount > 0 {
 		edgeRatio := float64(edgeCount) / float64(nodeCount)
-		if edgeRatio > 2.0 {
+		if edgeRatio > cfg.Thresholds.EdgesPerNode {
 			isSpaghetti = true
 			reasons = append(reasons, fmt.Sprintf("high edge density (%.1f edges per node)", edgeRatio))
 		}
 	}
 
 	// Rule 3: Multiple nodes with high fan-out (not hub-and-spoke)
-	if fanOutCount > 2 {
+	if fanOutCount > cfg.Thresholds.FanNodes {
 		isSpaghetti = true
-		reasons = append(reasons, fmt.Sprintf("%d nodes with 4+ outgoing edges", fanOutCount))
+		reasons = append(reasons, fmt.Sprintf("%d nodes with %d+ outgoing edges", fanOutCount, cfg.Thresholds.FanOut+1))
 	}
 
 	// Rule 3b: Multiple nodes with high fan-in (converging arrows)
-	if fanInCount > 2 {
+	if fanInCount > cfg.Thresholds.FanNodes {
 		isSpaghetti = true
-		reasons = append(reasons, fmt.Sprintf("%d nodes with 4+ incoming edges", fanInCount))
+		reasons = append(reasons, fmt.Sprintf("%d nodes with %d+ incoming edges", fanInCount, cfg.Thresholds.FanIn+1))
 	}
 
 	// Rule 4: No clear hub pattern when complex
-	if nodeCount > 8 && maxConnections < nodeCount/2 {
+	if nodeCount > cfg.Thresholds.HubNodes && maxConnections < nodeCount/2 {
 		isSpaghetti = true
 		reasons = append(reasons, "no clear hub node - consider linear pipeline")
 	}
@@ -296,7 +542,7 @@ func checkComplexity(diagram *parser.Diagram) []Issue {
 		})
 
 		// Add specific advice
-		if hubNode != "" && maxConnections > 3 {
+		if hubNode != "" && maxConnections > cfg.Thresholds.HubConnections {
 			issues = append(issues, Issue{
 				Severity:   SeverityWarning,
 				Message:    fmt.Sprintf("Node '%s' has %d connections - good hub candidate", hubNode, maxConnections),
@@ -309,27 +555,29 @@ func checkComplexity(diagram *parser.Diagram) []Issue {
 	return issues
 }
 
-// checkNewlinesInLabels ensures no node labels contain newlines
-func checkNewlinesInLabels(diagram *parser.Diagram) []Issue {
+// checkNewlinesInLabels ensures no labels contain line breaks: real newlines,
+// <br> tags or \n escapes (the parser turns all of them into "\n")
+func checkNewlinesInLabels(diagram *parser.Diagram, cfg *Config) []Issue {
 	issues := []Issue{}
 
-	for _, node := range diagram.Nodes {
+	for _, node := range diagram.SortedNodes() {
 		if strings.Contains(node.Label, "\n") {
-			// Create fixed label by replacing newlines with spaces
-			fixedLabel := strings.ReplaceAll(node.Label, "\n", " ")
-			// Collapse multiple spaces
-			fixedLabel = regexp.MustCompile(`\s+`).ReplaceAllString(fixedLabel, " ")
-			fixedLabel = strings.TrimSpace(fixedLabel)
+			fixedLabel := singleLine(node.Label)
+			newLabel := fixedLabel
+			if strings.HasPrefix(strings.TrimSpace(node.RawLabel), `"`) {
+				newLabel = `"` + fixedLabel + `"`
+			}
 
 			issues = append(issues, Issue{
 				Severity:   SeverityError,
 				Message:    fmt.Sprintf("Node '%s' contains newline in label", node.ID),
 				Line:       node.Line,
-				Context:    node.Label,
+				Loc:        node.Loc,
+				Context:    node.RawLabel,
 				Suggestion: fmt.Sprintf("Change to single line: %s[%s]", node.ID, fixedLabel),
 				Fixable:    true,
 				FixType:    "fix_newline",
-				FixData:    map[string]string{"id": node.ID, "old": node.Label, "new": fixedLabel},
+				FixData:    spanData(node.LabelSpan, node.RawLabel, map[string]string{"id": node.ID, "new": newLabel}),
 			})
 		}
 	}
@@ -337,19 +585,18 @@ func checkNewlinesInLabels(diagram *parser.Diagram) []Issue {
 	// Also check edge labels
 	for _, edge := range diagram.Edges {
 		if strings.Contains(edge.Label, "\n") {
-			fixedLabel := strings.ReplaceAll(edge.Label, "\n", " ")
-			fixedLabel = regexp.MustCompile(`\s+`).ReplaceAllString(fixedLabel, " ")
-			fixedLabel = strings.TrimSpace(fixedLabel)
+			fixedLabel := singleLine(edge.Label)
 
 			issues = append(issues, Issue{
 				Severity:   SeverityError,
 				Message:    fmt.Sprintf("Edge label contains newline: %s -> %s", edge.From, edge.To),
 				Line:       edge.Line,
-				Context:    edge.Label,
+				Loc:        edge.Loc,
+				Context:    edge.RawLabel,
 				Suggestion: fmt.Sprintf("Change to single line: |%s|", fixedLabel),
 				Fixable:    true,
 				FixType:    "fix_edge_newline",
-				FixData:    map[string]string{"from": edge.From, "to": edge.To, "old": edge.Label, "new": fixedLabel},
+				FixData:    spanData(edge.LabelSpan, edge.RawLabel, map[string]string{"from": edge.From, "to": edge.To, "new": fixedLabel}),
 			})
 		}
 	}
@@ -357,22 +604,92 @@ func checkNewlinesInLabels(diagram *parser.Diagram) []Issue {
 	// Check subgraph titles
 	for _, sg := range diagram.Subgraphs {
 		if strings.Contains(sg.Title, "\n") {
-			fixedTitle := strings.ReplaceAll(sg.Title, "\n", " ")
-			fixedTitle = regexp.MustCompile(`\s+`).ReplaceAllString(fixedTitle, " ")
-			fixedTitle = strings.TrimSpace(fixedTitle)
+			fixedTitle := singleLine(sg.Title)
 
 			issues = append(issues, Issue{
 				Severity:   SeverityError,
 				Message:    fmt.Sprintf("Subgraph '%s' title contains newline", sg.ID),
 				Line:       sg.Line,
-				Context:    sg.Title,
+				Loc:        sg.Loc,
+				Context:    sg.RawTitle,
 				Suggestion: fmt.Sprintf("Change to single line: subgraph %s [\"%s\"]", sg.ID, fixedTitle),
 				Fixable:    true,
 				FixType:    "fix_subgraph_newline",
-				FixData:    map[string]string{"id": sg.ID, "old": sg.Title, "new": fixedTitle},
+				FixData:    spanData(sg.TitleSpan, sg.RawTitle, map[string]string{"id": sg.ID, "new": fixedTitle}),
 			})
 		}
 	}
 
 	return issues
 }
+
+// singleLine joins a multi-line label into one line with single spaces
+func singleLine(label string) string {
+	return strings.Join(strings.Fields(label), " ")
+}
+
+// checkInlineStyles flags style and linkStyle statements that use colors
+// outside the style guide palette. Such styles bypass the classDefs and
+// make the same node type render in different colors.
+func checkInlineStyles(diagram *parser.Diagram, cfg *Config) []Issue {
+	issues := []Issue{}
+	palette := paletteColors(cfg.Guide)
+
+	for _, style := range diagram.Styles {
+		if off := offPaletteColors(style.Styles, palette); len(off) > 0 {
+			issues = append(issues, Issue{
+				Severity:   SeverityWarning,
+				Message:    fmt.Sprintf("Inline style on '%s' uses colors outside the palette: %s", style.Target, strings.Join(off, ", ")),
+				Line:       style.Line,
+				Loc:        style.Loc,
+				Context:    fmt.Sprintf("style %s %s", style.Target, style.Styles),
+				Suggestion: fmt.Sprintf("Remove the style and apply a class instead: class %s <type>", style.Target),
+			})
+		}
+	}
+
+	for _, style := range diagram.LinkStyles {
+		if off := offPaletteColors(style.Styles, palette); len(off) > 0 {
+			issues = append(issues, Issue{
+				Severity:   SeverityWarning,
+				Message:    fmt.Sprintf("linkStyle %s uses colors outside the palette: %s", style.Target, strings.Join(off, ", ")),
+				Line:       style.Line,
+				Loc:        style.Loc,
+				Context:    fmt.Sprintf("linkStyle %s %s", style.Target, style.Styles),
+				Suggestion: "Use the arrow styles from the style guide (==>, -.->, -->) instead of recoloring links",
+			})
+		}
+	}
+
+	return issues
+}
+
+// paletteColors returns every color of the style guide palette and its
+// classDefs
+func paletteColors(guide *styles.Guide) map[string]bool {
+	colors := make(map[string]bool)
+	for _, c := range guide.Colors {
+		for _, value := range []string{c.Fill, c.Stroke, c.Text} {
+			colors[parser.NormalizeColor(value)] = true
+		}
+	}
+	for _, def := range guide.ClassDefs {
+		for _, value := range parser.ParseStyleProps(styles.ClassDefStyles(def)) {
+			colors[parser.NormalizeColor(value)] = true
+		}
+	}
+	return colors
+}
+
+// offPaletteColors returns the fill, stroke and text colors of a style
+// string that are not in the palette
+func offPaletteColors(styles string, palette map[string]bool) []string {
+	off := []string{}
+	props := parser.ParseStyleProps(styles)
+	for _, key := range []string{"fill", "stroke", "color"} {
+		if value, ok := props[key]; ok && !palette[value] {
+			off = append(off, fmt.Sprintf("%s:%s", key, value))
+		}
+	}
+	return off
+}
@@ -0,0 +1,198 @@
+package linter
+
+import (
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/user/flowlint/internal/parser"
+	"github.com/user/flowlint/internal/styles"
+)
+
+// lintRule parses code and runs a single rule on it
+func lintRule(t *testing.T, code, rule string, cfg Config) []Issue {
+	t.Helper()
+	d, err := parser.ParseMermaid(code)
+	if err != nil {
+		t.Fatalf("ParseMermaid: %v", err)
+	}
+	cfg.Enable = []string{rule}
+	cfg.Disable = []string{"all"}
+	return Lint(d, cfg)
+}
+
+// messages returns the message of each issue
+func messages(issues []Issue) []string {
+	msgs := []string{}
+	for _, issue := range issues {
+		msgs = append(msgs, issue.Message)
+	}
+	return msgs
+}
+
+func TestClassDefColorsWithSpacedProps(t *testing.T) {
+	guide := styles.DefaultGuide()
+	guide.ClassDefs["database"] = "classDef database fill:#ffec99, stroke:#fcc419, color:#e67700"
+	cfg := Config{Guide: guide}
+
+	tests := []struct {
+		name string
+		def  string
+		want []string
+	}{
+		{"matches", "classDef database fill:#ffec99,stroke:#fcc419,color:#e67700", []string{}},
+		{"matches with spaces", "classDef database fill:#ffec99, stroke:#fcc419, color:#e67700", []string{}},
+		{"stroke drifts", "classDef database fill:#ffec99, stroke:#000, color:#e67700",
+			[]string{"classDef database does not match the style guide: stroke:#000000 (want #fcc419)"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := lintRule(t, "flowchart TD\n    "+tt.def+"\n    A", "classdef-colors", cfg)
+			if got := messages(issues); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("issues = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPaletteColorsWithSpacedProps(t *testing.T) {
+	guide := styles.DefaultGuide()
+	guide.ClassDefs["step"] = "classDef step fill:#e8f4f8, stroke:#4a9ebb, color:#2c5f7c"
+	palette := paletteColors(guide)
+	for _, color := range []string{"#e8f4f8", "#4a9ebb", "#2c5f7c"} {
+		if !palette[color] {
+			t.Errorf("palette is missing %s", color)
+		}
+	}
+}
+
+func TestNodeClassesFromShape(t *testing.T) {
+	guide := styles.DefaultGuide()
+	guide.Shapes["cache"] = "hexagon"
+	cfg := Config{Guide: guide}
+
+	code := `flowchart TD
+    A([POST /orders])
+    B[Order Service]
+    C{{Redis}}
+    D[(order.created)]
+    E([order-consumers])
+    A ==> B
+    B --> C
+    B -.-> D
+    D -.-> E`
+	// B is a plain rectangle, which tells nothing
+	want := map[string]string{"A": "entry", "C": "cache", "D": "kafka", "E": "kafka"}
+
+	got := map[string]string{}
+	for _, issue := range lintRule(t, code, "node-classes", cfg) {
+		if issue.Fixable {
+			got[issue.FixData["id"]] = issue.FixData["class"]
+		}
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("inferred classes = %v, want %v", got, want)
+	}
+}
+
+func TestBudgetDependencyCount(t *testing.T) {
+	cfg := Config{Guide: styles.DefaultGuide()}
+	steps := "flowchart TD\n    S1[Step 1] --> S2[Step 2] --> S3[Step 3] --> S4[Step 4] --> S5[Step 5]\n    S5 --> S6[Step 6] --> S7[Step 7] --> S8[Step 8] --> S9[Step 9]"
+	deps := "flowchart TD\n    subgraph deps\n        D1[A] & D2[B] & D3[C] & D4[D] & D5[E] & D6[F] & D7[G] & D8[H] & D9[I]\n    end"
+
+	if msgs := messages(lintRule(t, steps, "budget", cfg)); len(msgs) != 0 {
+		t.Errorf("plain rectangles counted as dependencies: %q", msgs)
+	}
+	want := []string{"Diagram has 9 dependencies; the grouped layout works up to 8"}
+	if msgs := messages(lintRule(t, deps, "budget", cfg)); !reflect.DeepEqual(msgs, want) {
+		t.Errorf("messages = %q, want %q", msgs, want)
+	}
+}
+
+func TestShippedDiagramsWithinBudget(t *testing.T) {
+	// testdata holds copies of examples/diagram-example.md and
+	// templates/diagram-template.md from the repository root
+	for _, path := range []string{"testdata/diagram-example.md", "testdata/diagram-template.md"} {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, block := range parser.ExtractMermaidBlocks(string(content)) {
+			if msgs := messages(lintRule(t, block.Code, "budget", Config{})); len(msgs) != 0 {
+				t.Errorf("%s block %d: %q", path, block.Index+1, msgs)
+			}
+		}
+	}
+}
+
+func TestAbbreviationsInSourceOrder(t *testing.T) {
+	code := "flowchart TD\n    Z[Order Svc] --> Y[Order Msg]\n    X[Order DB] --> W[Order Req]\n    V[Order Cfg]"
+	want := []string{
+		"Node 'Order Svc' may contain abbreviation",
+		"Node 'Order Msg' may contain abbreviation",
+		"Node 'Order DB' may contain abbreviation",
+		"Node 'Order Req' may contain abbreviation",
+		"Node 'Order Cfg' may contain abbreviation",
+	}
+	for i := 0; i < 10; i++ {
+		if msgs := messages(lintRule(t, code, "abbreviations", Config{})); !reflect.DeepEqual(msgs, want) {
+			t.Fatalf("messages = %q, want %q", msgs, want)
+		}
+	}
+}
+
+func TestLabelNewlinesInSourceOrder(t *testing.T) {
+	code := "flowchart TD\n    Z[Payment<br>Service] --> Y[Order<br>Service]\n    X[Ledger<br>DB] --> W[Stock<br>Cache]"
+	want := []string{
+		"Node 'Z' contains newline in label",
+		"Node 'Y' contains newline in label",
+		"Node 'X' contains newline in label",
+		"Node 'W' contains newline in label",
+	}
+	for i := 0; i < 10; i++ {
+		if msgs := messages(lintRule(t, code, "label-newlines", Config{})); !reflect.DeepEqual(msgs, want) {
+			t.Fatalf("messages = %q, want %q", msgs, want)
+		}
+	}
+}
+
+func TestDuplicateNodes(t *testing.T) {
+	tests := []struct {
+		name string
+		code string
+		want []string
+	}{
+		{
+			name: "label",
+			code: "flowchart TD\n    D1[Payment Service]\n    D1[Ledger Service]",
+			want: []string{"Node ID 'D1' has conflicting definitions (lines 2 and 3): label 'Payment Service' vs 'Ledger Service'"},
+		},
+		{
+			name: "shape",
+			code: "flowchart TD\n    D1[Payment Service]\n    D1[(Payment Service)]",
+			want: []string{"Node ID 'D1' has conflicting definitions (lines 2 and 3): shape rectangle vs cylinder"},
+		},
+		{
+			name: "subgraph",
+			code: "flowchart TD\n    subgraph deps [\"Dependencies\"]\n        D1[Payment Service]\n    end\n    D1[Payment Service]",
+			want: []string{"Node ID 'D1' has conflicting definitions (lines 3 and 5): subgraph 'deps' vs '(top level)'"},
+		},
+		{
+			name: "everything, against the first definition",
+			code: "flowchart TD\n    D1[Payment Service]\n    D1[Payment Service]\n    subgraph s2\n        D1[(Ledger DB)]\n    end",
+			want: []string{"Node ID 'D1' has conflicting definitions (lines 2 and 5): label 'Payment Service' vs 'Ledger DB', shape rectangle vs cylinder, subgraph '(top level)' vs 's2'"},
+		},
+		{
+			name: "same definition twice, and references",
+			code: "flowchart TD\n    D1[Payment Service]\n    D1[Payment Service]\n    A --> D1",
+			want: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if msgs := messages(lintRule(t, tt.code, "duplicate-nodes", Config{})); !reflect.DeepEqual(msgs, tt.want) {
+				t.Errorf("messages = %q, want %q", msgs, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+package linter
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/user/flowlint/internal/parser"
+	"github.com/user/flowlint/internal/styles"
+)
+
+// Keywords in labels that tell node types of one class apart
+var (
+	cacheLabelRe    = regexp.MustCompile(`(?i)\b(cache|redis|memcached?)\b`)
+	consumerLabelRe = regexp.MustCompile(`(?i)\b(consumers?|group)\b`)
+)
+
+// subgraphTypes gives the node type of the standard subgraphs whose
+// members all stand for one kind of thing
+var subgraphTypes = map[string]string{
+	"entry":     "entry",
+	"deps":      "service",
+	"data":      "database",
+	"kafka":     "kafka_topic",
+	"kafka-in":  "kafka_topic",
+	"kafka-out": "kafka_topic",
+	"ext":       "external",
+}
+
+// nodeType infers what a node stands for: from its class if it has one
+// the style guide knows, else from the standard subgraph it sits in,
+// refined by its label. It returns the type, what it was inferred from,
+// and whether the class decided it; "" if nothing tells.
+func nodeType(diagram *parser.Diagram, node *parser.Node) (string, string, bool) {
+	for _, class := range node.Classes {
+		if t := classType(class, node.Label); t != "" {
+			return t, "class " + class, true
+		}
+	}
+	if t, id := subgraphType(diagram, node); t != "" {
+		return t, "subgraph " + id, false
+	}
+	return "", "", false
+}
+
+// subgraphType returns the type the innermost standard subgraph around
+// a node gives it, refined by its label, and that subgraph's ID
+func subgraphType(diagram *parser.Diagram, node *parser.Node) (string, string) {
+	subgraphs := append([]string{node.Subgraph}, diagram.Ancestors(node.Subgraph)...)
+	for _, id := range subgraphs {
+		t, ok := subgraphTypes[id]
+		if !ok {
+			continue
+		}
+		switch {
+		case t == "database" && (cacheLabelRe.MatchString(node.Label) || node.Shape == "rounded"):
+			t = "cache"
+		case t == "kafka_topic" && consumerLabelRe.MatchString(node.Label):
+			t = "consumer_group"
+		}
+		return t, id
+	}
+	return "", ""
+}
+
+// shapeType returns the type the style guide draws with a node's shape,
+// or "". Rectangles are mermaid's default shape, written for nodes of any
+// kind, so they tell nothing.
+func shapeType(diagram *parser.Diagram, node *parser.Node, guide *styles.Guide) string {
+	if node.Shape == "rectangle" {
+		return ""
+	}
+	return guide.TypeOfShape(node.Shape, node.Label, hasIncoming(diagram, node.ID))
+}
+
+// depsType returns the type the dependency file gives the node with a
+// label, matched as the completeness check matches it; "" if it is not
+// listed. Internal steps are of type step.
+func depsType(deps *parser.DepsFile, label string) string {
+	if deps == nil {
+		return ""
+	}
+	label = strings.ToLower(strings.Join(strings.Fields(label), " "))
+	matches := func(name string) bool {
+		name = strings.ToLower(name)
+		return name != "" && strings.Contains(label, name)
+	}
+	for _, svc := range deps.Services {
+		for _, e := range svc.Entrypoints {
+			if matches(e.Name) {
+				return "entry"
+			}
+		}
+		for _, dep := range svc.Dependencies.Sync {
+			if matches(dep.Name) {
+				return "service"
+			}
+		}
+		for _, dep := range svc.Dependencies.Async {
+			if matches(dep.Name) {
+				return "kafka_topic"
+			}
+		}
+		for _, db := range svc.Databases {
+			if matches(db.Name) {
+				return "database"
+			}
+		}
+		for _, cache := range svc.Caches {
+			if matches(cache.Name) {
+				return "cache"
+			}
+		}
+		for _, ext := range svc.External {
+			if matches(ext.Name) {
+				return "external"
+			}
+		}
+		for _, step := range svc.InternalSteps {
+			if matches(step.Name) {
+				return "step"
+			}
+		}
+	}
+	return ""
+}
+
+// inferClass picks the class for a node without one: the type the
+// dependency file lists it as, else the type of its subgraph, else of
+// its shape unless it is a plain rectangle. Internal steps and members of the target subgraph get the
+// step class when the diagram defines one, as the template does, and
+// service otherwise. It returns the class and what it was inferred
+// from; "" if nothing tells.
+func inferClass(diagram *parser.Diagram, node *parser.Node, cfg *Config) (string, string) {
+	t, source := depsType(cfg.Deps, node.Label), "the dependency file"
+	if t == "" {
+		var id string
+		if t, id = subgraphType(diagram, node); t != "" {
+			source = "subgraph " + id
+		} else if node.Subgraph == "target" {
+			t, source = "step", "subgraph target"
+		} else if t = shapeType(diagram, node, cfg.Guide); t != "" {
+			source = "its " + node.Shape + " shape"
+		}
+	}
+
+	if t == "step" {
+		if _, ok := diagram.ClassDefs["step"]; ok {
+			return "step", source
+		}
+		t = "service"
+	}
+	if class, ok := styles.NodeTypeToClass[t]; ok {
+		return class, source
+	}
+	return "", ""
+}
+
+func hasIncoming(diagram *parser.Diagram, id string) bool {
+	for _, edge := range diagram.Edges {
+		if edge.To == id {
+			return true
+		}
+	}
+	return false
+}
+
+// classType returns the node type a class stands for. Kafka classes
+// cover topics and consumer groups, which the label tells apart.
+func classType(class, label string) string {
+	if class == "kafka" {
+		if consumerLabelRe.MatchString(label) {
+			return "consumer_group"
+		}
+		return "kafka_topic"
+	}
+	if styles.NodeTypeToClass[class] == class {
+		return class
+	}
+	return ""
+}
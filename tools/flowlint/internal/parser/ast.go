@@ -0,0 +1,138 @@
+package parser
+
+// AST is the statement-level syntax tree of a mermaid flowchart
+type AST struct {
+	Statements []Statement
+}
+
+// Statement is a single flowchart statement with its source span
+type Statement interface {
+	Extent() Span
+	stmtNode()
+}
+
+// HeaderStmt is the diagram declaration: flowchart TD, graph LR
+type HeaderStmt struct {
+	Span
+	Keyword   string // flowchart or graph
+	Direction string // empty if not given
+}
+
+// DirectionStmt sets the direction inside a subgraph: direction TB
+type DirectionStmt struct {
+	Span
+	Direction string
+}
+
+// SubgraphStmt opens a subgraph: subgraph id ["Title"]
+type SubgraphStmt struct {
+	Span
+	ID        string
+	Title     string
+	Quoted    bool
+	TitleSpan Span // source range of the title text, quotes included
+}
+
+// EndStmt closes the innermost subgraph
+type EndStmt struct {
+	Span
+}
+
+// ClassDefStmt defines one or more style classes: classDef name fill:#...
+type ClassDefStmt struct {
+	Span
+	Names  []string
+	Styles string
+}
+
+// ClassStmt applies a class to nodes: class A,B name
+type ClassStmt struct {
+	Span
+	Nodes []string
+	Class string
+}
+
+// StyleStmt styles a single node: style A fill:#...
+type StyleStmt struct {
+	Span
+	Node   string
+	Styles string
+}
+
+// LinkStyleStmt styles links by index: linkStyle 0,1 stroke:#...
+type LinkStyleStmt struct {
+	Span
+	Links  string // comma-separated indices or "default"
+	Styles string
+}
+
+// ClickStmt binds an interaction to a node: click A href "..."
+type ClickStmt struct {
+	Span
+	Node string
+	Args string
+}
+
+// CommentStmt is a %% comment line
+type CommentStmt struct {
+	Span
+	Text string
+}
+
+// ChainStmt is a sequence of node groups joined by links:
+// A[Label] --> B & C ==>|text| D
+type ChainStmt struct {
+	Span
+	Groups [][]*NodeRef // Groups[i] is linked to Groups[i+1] by Links[i]
+	Links  []*Link
+}
+
+// BadStmt is a statement the parser could not understand
+type BadStmt struct {
+	Span
+	Text string
+}
+
+// NodeRef is a node mentioned in a chain, with an optional shape
+type NodeRef struct {
+	Span
+	ID    string
+	Shape *ShapeSpec // nil when the node is only referenced by ID
+	Class string     // :::class shorthand, empty if not given
+}
+
+// ShapeSpec is the bracketed shape and label of a node definition
+type ShapeSpec struct {
+	Span
+	Open      string
+	Close     string
+	Name      string // shape name: rectangle, cylinder, hexagon, ...
+	Label     string
+	Quoted    bool
+	LabelSpan Span              // source range of the label text
+	Attrs     map[string]string // attributes of A@{ ... }, nil for bracket shapes
+}
+
+// Link is a connection between two node groups in a chain
+type Link struct {
+	Span
+	Arrow     string   // canonical token, e.g. --> for both --> and -- text -->
+	Raw       string   // link as written, inline text included
+	Type      LinkType // stroke, head, length and direction
+	Label     string
+	LabelSpan Span // source range of the label text, if labelled
+	Inline    bool // label written inside the link: A -- text --> B
+}
+
+func (*HeaderStmt) stmtNode()    {}
+func (*DirectionStmt) stmtNode() {}
+func (*SubgraphStmt) stmtNode()  {}
+func (*EndStmt) stmtNode()       {}
+func (*ClassDefStmt) stmtNode()  {}
+func (*ClassStmt) stmtNode()     {}
+func (*StyleStmt) stmtNode()     {}
+func (*LinkStyleStmt) stmtNode() {}
+func (*ClickStmt) stmtNode()     {}
+func (*CommentStmt) stmtNode()   {}
+func (*ChainStmt) stmtNode()     {}
+func (*BadStmt) stmtNode()       {}
@@ -0,0 +1,437 @@
+package parser
+
+import (
+	"strings"
+)
+
+// validDirections are the flowchart directions mermaid accepts
+var validDirections = map[string]bool{
+	"TB": true, "TD": true, "BT": true, "LR": true, "RL": true,
+}
+
+// flowParser is a recursive-descent parser over the lexer's token stream
+type flowParser struct {
+	src  string
+	lx   *lexer
+	tok  Token
+	last Token // previously consumed token
+}
+
+// ParseFlowchart parses mermaid flowchart code into an AST. Statements the
+// parser does not understand are kept as BadStmt instead of being dropped.
+func ParseFlowchart(code string) *AST {
+	p := &flowParser{src: code, lx: newLexer(code)}
+	p.next()
+
+	ast := &AST{Statements: []Statement{}}
+	for p.tok.Kind != TokEOF {
+		if p.tok.Kind == TokNewline {
+			p.next()
+			continue
+		}
+		ast.Statements = append(ast.Statements, p.parseStatement())
+	}
+	return ast
+}
+
+func (p *flowParser) next() {
+	p.last = p.tok
+	p.tok = p.lx.Next()
+}
+
+// atStmtEnd reports whether the current token ends a statement. A trailing
+// %% comment ends the statement and is parsed as a statement of its own.
+func (p *flowParser) atStmtEnd() bool {
+	return p.tok.Kind == TokNewline || p.tok.Kind == TokEOF || p.tok.Kind == TokComment
+}
+
+// span returns the span from start to the end of the last consumed token
+func (p *flowParser) span(start Pos) Span {
+	return Span{Start: start, End: p.last.Span.End}
+}
+
+// bad skips to the end of the statement and records it as a BadStmt
+func (p *flowParser) bad(start Pos) Statement {
+	for !p.atStmtEnd() {
+		p.next()
+	}
+	span := p.span(start)
+	if span.End.Offset < start.Offset {
+		span.End = start
+	}
+	return &BadStmt{Span: span, Text: strings.TrimSpace(p.src[span.Start.Offset:span.End.Offset])}
+}
+
+// finish returns stmt if the statement ends here, or a BadStmt otherwise
+func (p *flowParser) finish(start Pos, stmt Statement) Statement {
+	if !p.atStmtEnd() {
+		return p.bad(start)
+	}
+	return stmt
+}
+
+func (p *flowParser) parseStatement() Statement {
+	start := p.tok.Span.Start
+
+	switch p.tok.Kind {
+	case TokComment:
+		stmt := &CommentStmt{Span: p.tok.Span, Text: p.tok.Value}
+		p.next()
+		return stmt
+	case TokIdent:
+		// handled below
+	default:
+		return p.bad(start)
+	}
+
+	switch p.tok.Text {
+	case "flowchart", "graph":
+		return p.parseHeader(start)
+	case "direction":
+		p.next()
+		if p.tok.Kind != TokIdent || !validDirections[p.tok.Text] {
+			return p.bad(start)
+		}
+		dir := p.tok.Text
+		p.next()
+		return p.finish(start, &DirectionStmt{Span: p.span(start), Direction: dir})
+	case "subgraph":
+		return p.parseSubgraph(start)
+	case "end":
+		p.next()
+		return p.finish(start, &EndStmt{Span: p.span(start)})
+	case "classDef", "class", "style", "linkStyle", "click":
+		return p.parseRawStatement(start)
+	}
+
+	return p.parseChain(start)
+}
+
+// parseHeader parses: flowchart TD
+func (p *flowParser) parseHeader(start Pos) Statement {
+	stmt := &HeaderStmt{Keyword: p.tok.Text}
+	p.next()
+	if p.tok.Kind == TokIdent {
+		if !validDirections[p.tok.Text] {
+			return p.bad(start)
+		}
+		stmt.Direction = p.tok.Text
+		p.next()
+	}
+	stmt.Span = p.span(start)
+	return p.finish(start, stmt)
+}
+
+// parseSubgraph parses the forms:
+//
+//	subgraph id ["Title"]
+//	subgraph id [Title]
+//	subgraph id Title words
+//	subgraph "Title"
+//	subgraph id
+func (p *flowParser) parseSubgraph(start Pos) Statement {
+	p.next()
+	stmt := &SubgraphStmt{}
+
+	switch p.tok.Kind {
+	case TokString:
+		stmt.ID = p.tok.Value
+		stmt.Title = p.tok.Value
+		stmt.Quoted = true
+		stmt.TitleSpan = p.tok.Span
+		p.next()
+	case TokIdent:
+		stmt.ID = p.tok.Text
+		p.next()
+		if p.tok.Kind == TokShape {
+			stmt.Title, stmt.Quoted = unquoteLabel(p.tok.Value)
+			stmt.TitleSpan = p.tok.ValueSpan
+			p.next()
+		} else if !p.atStmtEnd() {
+			// Title given without brackets: everything after the ID
+			titleStart := p.tok.Span.Start
+			for !p.atStmtEnd() {
+				p.next()
+			}
+			stmt.TitleSpan = p.span(titleStart)
+			stmt.Title, stmt.Quoted = unquoteLabel(p.src[titleStart.Offset:p.last.Span.End.Offset])
+		}
+	default:
+		return p.bad(start)
+	}
+
+	stmt.Span = p.span(start)
+	return p.finish(start, stmt)
+}
+
+// parseRawStatement parses keyword statements whose arguments are free-form
+// text: classDef, class, style, linkStyle and click
+func (p *flowParser) parseRawStatement(start Pos) Statement {
+	keyword := p.tok.Text
+	p.next()
+	if p.tok.Kind != TokRaw {
+		return p.bad(start)
+	}
+	raw := p.tok.Value
+	p.next()
+	span := p.span(start)
+
+	fields := strings.Fields(raw)
+	if len(fields) < 2 {
+		return p.bad(start)
+	}
+	first := fields[0]
+	rest := strings.TrimSpace(raw[len(first):])
+
+	var stmt Statement
+	switch keyword {
+	case "classDef":
+		stmt = &ClassDefStmt{Span: span, Names: splitList(first), Styles: rest}
+	case "class":
+		// class A,B name / class A, B name: the class is the last field
+		class := fields[len(fields)-1]
+		nodes := splitList(strings.TrimSpace(strings.TrimSuffix(raw, class)))
+		if len(nodes) == 0 {
+			return p.bad(start)
+		}
+		stmt = &ClassStmt{Span: span, Nodes: nodes, Class: class}
+	case "style":
+		stmt = &StyleStmt{Span: span, Node: first, Styles: rest}
+	case "linkStyle":
+		stmt = &LinkStyleStmt{Span: span, Links: first, Styles: rest}
+	case "click":
+		stmt = &ClickStmt{Span: span, Node: first, Args: rest}
+	}
+	return p.finish(start, stmt)
+}
+
+// parseChain parses node declarations and edges:
+//
+//	chain := group (link group)*
+//	group := node ('&' node)*
+//	link  := arrow ['|' label '|'] | start label end
+//
+// where the second link form is A -- text --> B.
+func (p *flowParser) parseChain(start Pos) Statement {
+	stmt := &ChainStmt{}
+
+	group, ok := p.parseGroup()
+	if !ok {
+		return p.bad(start)
+	}
+	stmt.Groups = append(stmt.Groups, group)
+
+	for p.tok.Kind == TokArrow {
+		link := &Link{
+			Span:  p.tok.Span,
+			Arrow: p.tok.Link.String(),
+			Raw:   p.tok.Text,
+			Type:  p.tok.Link,
+		}
+		if p.tok.Value != "" {
+			// A -- text --> B
+			link.Label, _ = unquoteLabel(p.tok.Value)
+			link.LabelSpan = p.tok.ValueSpan
+			link.Inline = true
+		}
+		p.next()
+		if p.tok.Kind == TokEdgeLabel {
+			link.Label, _ = unquoteLabel(p.tok.Value)
+			link.LabelSpan = p.tok.ValueSpan
+			link.Span.End = p.tok.Span.End
+			p.next()
+		}
+
+		group, ok := p.parseGroup()
+		if !ok {
+			return p.bad(start)
+		}
+		stmt.Links = append(stmt.Links, link)
+		stmt.Groups = append(stmt.Groups, group)
+	}
+
+	stmt.Span = p.span(start)
+	return p.finish(start, stmt)
+}
+
+// parseGroup parses one or more nodes joined by '&'
+func (p *flowParser) parseGroup() ([]*NodeRef, bool) {
+	group := []*NodeRef{}
+	for {
+		node, ok := p.parseNode()
+		if !ok {
+			return nil, false
+		}
+		group = append(group, node)
+		if p.tok.Kind != TokAmp {
+			return group, true
+		}
+		p.next()
+	}
+}
+
+// parseNode parses a node ID with an optional shape and class shorthand:
+// A, A[Label], A[(Label)], A[Label]:::service
+func (p *flowParser) parseNode() (*NodeRef, bool) {
+	if p.tok.Kind != TokIdent {
+		return nil, false
+	}
+	start := p.tok.Span.Start
+	node := &NodeRef{ID: p.tok.Text}
+	p.next()
+
+	if p.tok.Kind == TokShape {
+		shape := &ShapeSpec{
+			Span:      p.tok.Span,
+			Open:      p.tok.Open,
+			Close:     p.tok.Close,
+			Name:      shapeName(p.tok.Open, p.tok.Close),
+			LabelSpan: p.tok.ValueSpan,
+		}
+		shape.Label, shape.Quoted = unquoteLabel(p.tok.Value)
+		if shape.Open == "@{" {
+			p.applyShapeAttrs(node, shape, p.tok)
+		}
+		node.Shape = shape
+		p.next()
+	}
+
+	if p.tok.Kind == TokClass {
+		node.Class = p.tok.Value
+		p.next()
+	}
+
+	node.Span = p.span(start)
+	return node, true
+}
+
+// applyShapeAttrs fills a shape from the A@{ shape: ..., label: ... } syntax.
+// Without a label attribute the node shows its ID.
+func (p *flowParser) applyShapeAttrs(node *NodeRef, shape *ShapeSpec, tok Token) {
+	shape.Name = "rectangle"
+	shape.Label, shape.Quoted = node.ID, false
+	shape.LabelSpan = Span{Start: tok.ValueSpan.Start, End: tok.ValueSpan.Start}
+	shape.Attrs = map[string]string{}
+
+	for _, attr := range parseShapeAttrs(tok.Value) {
+		shape.Attrs[attr.Key] = attr.Value
+		switch attr.Key {
+		case "shape":
+			if name, ok := shapeAliases[attr.Value]; ok {
+				shape.Name = name
+			} else {
+				shape.Name = attr.Value
+			}
+		case "label":
+			shape.Label, shape.Quoted = unquoteLabel(attr.Raw)
+			start := advancePos(tok.ValueSpan.Start, tok.Value[:attr.Start])
+			shape.LabelSpan = Span{Start: start, End: advancePos(start, attr.Raw)}
+		}
+	}
+}
+
+// unquoteLabel trims a label and strips surrounding double quotes
+func unquoteLabel(s string) (string, bool) {
+	s = strings.TrimSpace(s)
+	if len(s) >= 2 && strings.HasPrefix(s, `"`) && strings.HasSuffix(s, `"`) {
+		return s[1 : len(s)-1], true
+	}
+	return s, false
+}
+
+// splitList splits a comma-separated list, dropping blanks
+func splitList(s string) []string {
+	items := []string{}
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// shapeName maps a pair of delimiters to its shape name
+func shapeName(open, close string) string {
+	for _, d := range shapeDelims {
+		if d.Open == open && d.Close == close {
+			return d.Shape
+		}
+	}
+	return "rectangle"
+}
+
+// shapeAliases maps the names accepted by the A@{ shape: ... } syntax to
+// the shape names used by the bracket syntax
+var shapeAliases = map[string]string{
+	"rect": "rectangle", "rectangle": "rectangle", "proc": "rectangle", "process": "rectangle",
+	"rounded": "rounded", "event": "rounded",
+	"stadium": "stadium", "pill": "stadium", "terminal": "stadium",
+	"fr-rect": "double_rectangle", "subproc": "double_rectangle", "subprocess": "double_rectangle",
+	"subroutine": "double_rectangle", "framed-rectangle": "double_rectangle",
+	"cyl": "cylinder", "cylinder": "cylinder", "database": "cylinder", "db": "cylinder",
+	"circle": "circle", "circ": "circle",
+	"diam": "diamond", "diamond": "diamond", "decision": "diamond", "question": "diamond",
+	"hex": "hexagon", "hexagon": "hexagon", "prepare": "hexagon",
+	"lean-r": "parallelogram", "lean-right": "parallelogram", "in-out": "parallelogram",
+	"lean-l": "parallelogram_alt", "lean-left": "parallelogram_alt", "out-in": "parallelogram_alt",
+	"trap-b": "trapezoid", "trapezoid": "trapezoid", "trapezoid-bottom": "trapezoid", "priority": "trapezoid",
+	"trap-t": "trapezoid_alt", "trapezoid-top": "trapezoid_alt", "inv-trapezoid": "trapezoid_alt", "manual": "trapezoid_alt",
+	"dbl-circ": "double_circle", "double-circle": "double_circle",
+	"odd": "asymmetric",
+}
+
+// shapeAttr is one key: value pair of an A@{ ... } shape
+type shapeAttr struct {
+	Key   string
+	Value string
+	Start int // byte offset of the raw value within the attribute text
+	Raw   string
+}
+
+// parseShapeAttrs splits the body of A@{ shape: cyl, label: "Orders, v2" }
+// into key/value pairs. Commas inside quotes do not separate pairs.
+func parseShapeAttrs(body string) []shapeAttr {
+	attrs := []shapeAttr{}
+	inQuote := false
+	itemStart := 0
+	for i := 0; i <= len(body); i++ {
+		if i < len(body) {
+			if body[i] == '"' {
+				inQuote = !inQuote
+			}
+			if inQuote || body[i] != ',' {
+				continue
+			}
+		}
+		item := body[itemStart:i]
+		if colon := strings.Index(item, ":"); colon >= 0 {
+			raw := item[colon+1:]
+			lead := len(raw) - len(strings.TrimLeft(raw, " \t\r\n"))
+			raw = strings.TrimSpace(raw)
+			value, _ := unquoteLabel(raw)
+			attrs = append(attrs, shapeAttr{
+				Key:   strings.TrimSpace(item[:colon]),
+				Value: value,
+				Start: itemStart + colon + 1 + lead,
+				Raw:   raw,
+			})
+		}
+		itemStart = i + 1
+	}
+	return attrs
+}
+
+// advancePos returns the position reached after reading text from p
+func advancePos(p Pos, text string) Pos {
+	for i := 0; i < len(text); i++ {
+		if text[i] == '\n' {
+			p.Line++
+			p.Col = 1
+		} else {
+			p.Col++
+		}
+		p.Offset++
+	}
+	return p
+}
@@ -0,0 +1,355 @@
+package parser
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/user/flowlint/internal/styles"
+)
+
+// statementKinds returns a short name for each statement type, for
+// comparing the shape of an AST
+func statementKinds(ast *AST) []string {
+	kinds := []string{}
+	for _, stmt := range ast.Statements {
+		var kind string
+		switch stmt.(type) {
+		case *HeaderStmt:
+			kind = "header"
+		case *DirectionStmt:
+			kind = "direction"
+		case *SubgraphStmt:
+			kind = "subgraph"
+		case *EndStmt:
+			kind = "end"
+		case *ClassDefStmt:
+			kind = "classDef"
+		case *ClassStmt:
+			kind = "class"
+		case *StyleStmt:
+			kind = "style"
+		case *LinkStyleStmt:
+			kind = "linkStyle"
+		case *ClickStmt:
+			kind = "click"
+		case *CommentStmt:
+			kind = "comment"
+		case *ChainStmt:
+			kind = "chain"
+		case *BadStmt:
+			kind = "bad"
+		}
+		kinds = append(kinds, kind)
+	}
+	return kinds
+}
+
+func TestParseFlowchartStatements(t *testing.T) {
+	tests := []struct {
+		name string
+		code string
+		want []string
+	}{
+		{"header", "flowchart TD", []string{"header"}},
+		{"graph header", "graph LR", []string{"header"}},
+		{"header without direction", "flowchart", []string{"header"}},
+		{"bad direction", "flowchart XY", []string{"bad"}},
+		{"semicolons", "flowchart TD; A --> B; B --> C", []string{"header", "chain", "chain"}},
+		{"comment", "%% a note\nA", []string{"comment", "chain"}},
+		{"trailing comment", "A --> B %% note", []string{"chain", "comment"}},
+		{"subgraph", "subgraph deps [\"Dependencies\"]\nA\nend", []string{"subgraph", "chain", "end"}},
+		{"direction", "subgraph s\ndirection LR\nend", []string{"subgraph", "direction", "end"}},
+		{"nested subgraphs", "subgraph a\nsubgraph b\nX\nend\nend", []string{"subgraph", "subgraph", "chain", "end", "end"}},
+		{"classDef", "classDef service fill:#a5d8ff", []string{"classDef"}},
+		{"class", "class A,B service", []string{"class"}},
+		{"style", "style A fill:#fff", []string{"style"}},
+		{"linkStyle", "linkStyle 0,1 stroke:#f00", []string{"linkStyle"}},
+		{"click", `click A href "https://example.com"`, []string{"click"}},
+		{"class shorthand", "A[Label]:::service --> B:::db", []string{"chain"}},
+		{"keyword without arguments", "classDef", []string{"bad"}},
+		{"illegal text", "A --> B ??", []string{"bad"}},
+		{"misspelled arrow", "A =>> B", []string{"bad"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := statementKinds(ParseFlowchart(tt.code))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseFlowchart(%q) = %v, want %v", tt.code, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseFlowchartChain(t *testing.T) {
+	tests := []struct {
+		name   string
+		code   string
+		groups [][]string
+		arrows []string
+	}{
+		{"single node", "A", [][]string{{"A"}}, nil},
+		{"edge", "A --> B", [][]string{{"A"}, {"B"}}, []string{"-->"}},
+		{"no spaces", "A-->B", [][]string{{"A"}, {"B"}}, []string{"-->"}},
+		{"chain", "A ==> B ==> C", [][]string{{"A"}, {"B"}, {"C"}}, []string{"==>", "==>"}},
+		{"fan-out", "A --> B & C", [][]string{{"A"}, {"B", "C"}}, []string{"-->"}},
+		{"fan-in", "A & B --> C", [][]string{{"A", "B"}, {"C"}}, []string{"-->"}},
+		{"inline definitions", "S1_step1[Validate Request] --> S1_step2[Load Account]",
+			[][]string{{"S1_step1"}, {"S1_step2"}}, []string{"-->"}},
+		{"hyphenated IDs", "kafka-in --> kafka-out", [][]string{{"kafka-in"}, {"kafka-out"}}, []string{"-->"}},
+		{"pipe label", "A -->|gRPC: Get| B", [][]string{{"A"}, {"B"}}, []string{"-->"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ast := ParseFlowchart(tt.code)
+			if len(ast.Statements) != 1 {
+				t.Fatalf("got %d statements, want 1", len(ast.Statements))
+			}
+			chain, ok := ast.Statements[0].(*ChainStmt)
+			if !ok {
+				t.Fatalf("got %T, want *ChainStmt", ast.Statements[0])
+			}
+			groups := [][]string{}
+			for _, group := range chain.Groups {
+				ids := []string{}
+				for _, ref := range group {
+					ids = append(ids, ref.ID)
+				}
+				groups = append(groups, ids)
+			}
+			var arrows []string
+			for _, link := range chain.Links {
+				arrows = append(arrows, link.Arrow)
+			}
+			if !reflect.DeepEqual(groups, tt.groups) {
+				t.Errorf("groups = %v, want %v", groups, tt.groups)
+			}
+			if !reflect.DeepEqual(arrows, tt.arrows) {
+				t.Errorf("arrows = %v, want %v", arrows, tt.arrows)
+			}
+		})
+	}
+}
+
+// firstShape parses code and returns the shape of the first node
+func firstShape(t *testing.T, code string) *ShapeSpec {
+	t.Helper()
+	ast := ParseFlowchart(code)
+	if len(ast.Statements) == 0 {
+		t.Fatalf("ParseFlowchart(%q) returned no statements", code)
+	}
+	chain, ok := ast.Statements[0].(*ChainStmt)
+	if !ok {
+		t.Fatalf("ParseFlowchart(%q) = %T, want *ChainStmt", code, ast.Statements[0])
+	}
+	return chain.Groups[0][0].Shape
+}
+
+func TestShapeDelimiters(t *testing.T) {
+	tests := []struct {
+		code   string
+		shape  string
+		label  string
+		quoted bool
+	}{
+		{"A[Label]", "rectangle", "Label", false},
+		{"A(Label)", "rounded", "Label", false},
+		{"A([Label])", "stadium", "Label", false},
+		{"A[[Label]]", "double_rectangle", "Label", false},
+		{"A[(Label)]", "cylinder", "Label", false},
+		{"A((Label))", "circle", "Label", false},
+		{"A(((Label)))", "double_circle", "Label", false},
+		{"A{Label}", "diamond", "Label", false},
+		{"A{{Label}}", "hexagon", "Label", false},
+		{"A[/Label/]", "parallelogram", "Label", false},
+		{`A[\Label\]`, "parallelogram_alt", "Label", false},
+		{`A[/Label\]`, "trapezoid", "Label", false},
+		{`A[\Label/]`, "trapezoid_alt", "Label", false},
+		{"A>Label]", "asymmetric", "Label", false},
+		{`A["Label"]`, "rectangle", "Label", true},
+		{`A["Orders [v2]"]`, "rectangle", "Orders [v2]", true},
+		{`A[("Orders (v2)")]`, "cylinder", "Orders (v2)", true},
+		{"A[ Label ]", "rectangle", "Label", false},
+
+		// An opener without its closer falls through to the next shape
+		// that does close, keeping the rest of the opener in the label
+		{"I[(unclosed]", "rectangle", "(unclosed", false},
+		{"A[/Label]", "rectangle", "/Label", false},
+		{"A([Label)", "rounded", "[Label", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			shape := firstShape(t, tt.code)
+			if shape == nil {
+				t.Fatalf("no shape parsed")
+			}
+			if shape.Name != tt.shape || shape.Label != tt.label || shape.Quoted != tt.quoted {
+				t.Errorf("got %s %q quoted=%v, want %s %q quoted=%v",
+					shape.Name, shape.Label, shape.Quoted, tt.shape, tt.label, tt.quoted)
+			}
+		})
+	}
+}
+
+func TestUnclosedShape(t *testing.T) {
+	for _, code := range []string{"A[Label", "A[(Label", "A{{Label", `A["Label]`} {
+		t.Run(code, func(t *testing.T) {
+			kinds := statementKinds(ParseFlowchart(code + "\nB --> C"))
+			want := []string{"bad", "chain"}
+			if !reflect.DeepEqual(kinds, want) {
+				t.Errorf("got %v, want %v: an unclosed shape must not swallow the next line", kinds, want)
+			}
+		})
+	}
+}
+
+func TestMultiLineShapeLabel(t *testing.T) {
+	code := "A[First\nSecond] --> B"
+	shape := firstShape(t, code)
+	if shape.Label != "First\nSecond" {
+		t.Errorf("label = %q, want %q", shape.Label, "First\nSecond")
+	}
+	if got := code[shape.LabelSpan.Start.Offset:shape.LabelSpan.End.Offset]; got != "First\nSecond" {
+		t.Errorf("label span covers %q", got)
+	}
+	if shape.LabelSpan.End.Line != 2 {
+		t.Errorf("label ends on line %d, want 2", shape.LabelSpan.End.Line)
+	}
+}
+
+func TestParseSubgraph(t *testing.T) {
+	tests := []struct {
+		code   string
+		id     string
+		title  string
+		quoted bool
+	}{
+		{`subgraph deps ["Dependencies"]`, "deps", "Dependencies", true},
+		{"subgraph deps [Dependencies]", "deps", "Dependencies", false},
+		{"subgraph deps Dependencies and more", "deps", "Dependencies and more", false},
+		{`subgraph "Dependencies"`, "Dependencies", "Dependencies", true},
+		{"subgraph deps", "deps", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			ast := ParseFlowchart(tt.code)
+			sg, ok := ast.Statements[0].(*SubgraphStmt)
+			if !ok {
+				t.Fatalf("got %T, want *SubgraphStmt", ast.Statements[0])
+			}
+			if sg.ID != tt.id || sg.Title != tt.title || sg.Quoted != tt.quoted {
+				t.Errorf("got %q %q quoted=%v, want %q %q quoted=%v", sg.ID, sg.Title, sg.Quoted, tt.id, tt.title, tt.quoted)
+			}
+		})
+	}
+}
+
+func TestParseRawStatements(t *testing.T) {
+	tests := []struct {
+		code string
+		want Statement
+	}{
+		{"classDef service fill:#a5d8ff,stroke:#339af0", &ClassDefStmt{Names: []string{"service"}, Styles: "fill:#a5d8ff,stroke:#339af0"}},
+		{"classDef a,b fill:#fff", &ClassDefStmt{Names: []string{"a", "b"}, Styles: "fill:#fff"}},
+		{"class A,B service", &ClassStmt{Nodes: []string{"A", "B"}, Class: "service"}},
+		{"class A, B service", &ClassStmt{Nodes: []string{"A", "B"}, Class: "service"}},
+		{"style A fill:#fff,stroke:#000", &StyleStmt{Node: "A", Styles: "fill:#fff,stroke:#000"}},
+		{"linkStyle 0,2 stroke:#f00", &LinkStyleStmt{Links: "0,2", Styles: "stroke:#f00"}},
+		{"linkStyle default stroke:#f00", &LinkStyleStmt{Links: "default", Styles: "stroke:#f00"}},
+		{`click A href "https://example.com"`, &ClickStmt{Node: "A", Args: `href "https://example.com"`}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			ast := ParseFlowchart(tt.code)
+			got := ast.Statements[0]
+			// Compare without spans
+			reflect.ValueOf(got).Elem().FieldByName("Span").Set(reflect.ValueOf(Span{}))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClassShorthand(t *testing.T) {
+	ast := ParseFlowchart("A[Payment]:::service --> B:::database")
+	chain := ast.Statements[0].(*ChainStmt)
+	a, b := chain.Groups[0][0], chain.Groups[1][0]
+	if a.Class != "service" || a.Shape == nil || a.Shape.Label != "Payment" {
+		t.Errorf("A: class %q, shape %+v", a.Class, a.Shape)
+	}
+	if b.Class != "database" || b.Shape != nil {
+		t.Errorf("B: class %q, shape %+v", b.Class, b.Shape)
+	}
+}
+
+func TestStatementSpans(t *testing.T) {
+	code := "flowchart TD\n    A[Start] --> B\n    classDef x fill:#fff"
+	ast := ParseFlowchart(code)
+	want := []string{"flowchart TD", "A[Start] --> B", "classDef x fill:#fff"}
+	for i, stmt := range ast.Statements {
+		span := stmt.Extent()
+		if got := code[span.Start.Offset:span.End.Offset]; got != want[i] {
+			t.Errorf("statement %d covers %q, want %q", i, got, want[i])
+		}
+	}
+	if start := ast.Statements[1].Extent().Start; start.Line != 2 || start.Col != 5 {
+		t.Errorf("chain starts at %d:%d, want 2:5", start.Line, start.Col)
+	}
+	if !strings.HasPrefix(code[ast.Statements[2].Extent().Start.Offset:], "classDef") {
+		t.Errorf("classDef span starts in the wrong place")
+	}
+}
+
+func TestShapeAttributes(t *testing.T) {
+	tests := []struct {
+		code  string
+		shape string
+		label string
+		attrs map[string]string
+	}{
+		{`A@{ shape: cyl, label: "Orders DB" }`, "cylinder", "Orders DB", map[string]string{"shape": "cyl", "label": "Orders DB"}},
+		{`A@{ shape: hex }`, "hexagon", "A", map[string]string{"shape": "hex"}},
+		{`A@{ label: "Only a label" }`, "rectangle", "Only a label", map[string]string{"label": "Only a label"}},
+		{`A@{ shape: lean-r, label: "Orders, v2" }`, "parallelogram", "Orders, v2", map[string]string{"shape": "lean-r", "label": "Orders, v2"}},
+		{`A@{ shape: trap-t }`, "trapezoid_alt", "A", map[string]string{"shape": "trap-t"}},
+		{`A@{ shape: dbl-circ }`, "double_circle", "A", map[string]string{"shape": "dbl-circ"}},
+		{`A@{ shape: fr-rect }`, "double_rectangle", "A", map[string]string{"shape": "fr-rect"}},
+		{`A@{ shape: bolt }`, "bolt", "A", map[string]string{"shape": "bolt"}},
+		{"A@{ shape: cyl,\n    label: \"Orders\" }", "cylinder", "Orders", map[string]string{"shape": "cyl", "label": "Orders"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			shape := firstShape(t, tt.code)
+			if shape == nil {
+				t.Fatalf("no shape parsed")
+			}
+			if shape.Name != tt.shape || shape.Label != tt.label {
+				t.Errorf("got %s %q, want %s %q", shape.Name, shape.Label, tt.shape, tt.label)
+			}
+			if !reflect.DeepEqual(shape.Attrs, tt.attrs) {
+				t.Errorf("attrs = %v, want %v", shape.Attrs, tt.attrs)
+			}
+		})
+	}
+}
+
+func TestShapeAttributeLabelSpan(t *testing.T) {
+	code := `A@{ shape: cyl, label: "Orders DB" } --> B`
+	shape := firstShape(t, code)
+	if got := code[shape.LabelSpan.Start.Offset:shape.LabelSpan.End.Offset]; got != `"Orders DB"` {
+		t.Errorf("label span covers %q", got)
+	}
+}
+
+// TestStyleShapesRoundTrip checks that every shape the style package can
+// write is parsed back to the same name
+func TestStyleShapesRoundTrip(t *testing.T) {
+	for name, delims := range styles.Shapes {
+		code := "A" + delims.Open + "Label" + delims.Close
+		shape := firstShape(t, code)
+		if shape == nil || shape.Name != name || shape.Label != "Label" {
+			t.Errorf("%s: %s parsed as %+v", name, code, shape)
+		}
+	}
+}
@@ -0,0 +1,431 @@
+package parser
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TokenKind identifies the lexical class of a token
+type TokenKind int
+
+const (
+	TokEOF       TokenKind = iota
+	TokNewline             // end of statement: newline or ';'
+	TokComment             // %% comment up to end of line
+	TokIdent               // node ID or keyword
+	TokString              // "quoted text"
+	TokShape               // node shape with label: [text], [(text)], {text}, ...
+	TokArrow               // link: -->, ==>, -.->, ...
+	TokEdgeLabel           // |text| following a link
+	TokAmp                 // & between nodes
+	TokRaw                 // rest of the line after classDef, class, style, linkStyle, click
+	TokClass               // :::class shorthand after a node
+	TokIllegal             // anything the lexer does not understand
+)
+
+// Pos is a position in mermaid source. Line and Col are 1-based.
+type Pos struct {
+	Offset int
+	Line   int
+	Col    int
+}
+
+// Span is the source range [Start, End) covered by a token or statement
+type Span struct {
+	Start Pos
+	End   Pos
+}
+
+// Extent returns the span itself, so types embedding a Span expose it
+func (s Span) Extent() Span { return s }
+
+// Token is a single lexical token
+type Token struct {
+	Kind  TokenKind
+	Text  string // raw source text of the token
+	Value string // label text for TokShape/TokEdgeLabel/TokString/TokArrow, rest of line for TokRaw
+	// ValueSpan is the source range of Value, between the delimiters
+	ValueSpan Span
+	Open      string   // opening delimiter for TokShape
+	Close     string   // closing delimiter for TokShape
+	Link      LinkType // structure of a TokArrow
+	Span      Span
+}
+
+// shapeDelims lists node shape delimiters, longest opener first. The
+// lexer tries them in order, so [/text\] falls through to the trapezoid
+// when no /] closes the parallelogram, and [/text] ends up a rectangle.
+var shapeDelims = []struct {
+	Open  string
+	Close string
+	Shape string
+}{
+	{"@{", "}", ""}, // A@{ shape: cyl, label: "..." }: shape comes from the attributes
+	{"(((", ")))", "double_circle"},
+	{"((", "))", "circle"},
+	{"([", "])", "stadium"},
+	{"[(", ")]", "cylinder"},
+	{"[[", "]]", "double_rectangle"},
+	{"[/", "/]", "parallelogram"},
+	{"[\\", "\\]", "parallelogram_alt"},
+	{"[/", "\\]", "trapezoid"},
+	{"[\\", "/]", "trapezoid_alt"},
+	{"{{", "}}", "hexagon"},
+	{">", "]", "asymmetric"},
+	{"[", "]", "rectangle"},
+	{"{", "}", "diamond"},
+	{"(", ")", "rounded"},
+}
+
+// rawKeywords take the rest of their line as free-form text
+var rawKeywords = map[string]bool{
+	"classDef":  true,
+	"class":     true,
+	"style":     true,
+	"linkStyle": true,
+	"click":     true,
+}
+
+// lexer splits mermaid flowchart source into tokens
+type lexer struct {
+	src       string
+	pos       Pos
+	stmtStart bool      // next token starts a statement
+	prev      TokenKind // kind of the previous non-newline token
+	rawRest   bool      // next token is the rest of the line
+}
+
+func newLexer(src string) *lexer {
+	return &lexer{
+		src:       src,
+		pos:       Pos{Line: 1, Col: 1},
+		stmtStart: true,
+		prev:      TokNewline,
+	}
+}
+
+// peekByte returns the byte at offset n from the current position, or 0
+func (l *lexer) peekByte(n int) byte {
+	if l.pos.Offset+n < len(l.src) {
+		return l.src[l.pos.Offset+n]
+	}
+	return 0
+}
+
+// advance moves the position forward by n bytes, tracking lines and columns
+func (l *lexer) advance(n int) {
+	for i := 0; i < n && l.pos.Offset < len(l.src); i++ {
+		if l.src[l.pos.Offset] == '\n' {
+			l.pos.Line++
+			l.pos.Col = 1
+		} else {
+			l.pos.Col++
+		}
+		l.pos.Offset++
+	}
+}
+
+func (l *lexer) rest() string {
+	return l.src[l.pos.Offset:]
+}
+
+// skipSpace skips blanks but not newlines
+func (l *lexer) skipSpace() {
+	for {
+		c := l.peekByte(0)
+		if c != ' ' && c != '\t' && c != '\r' {
+			return
+		}
+		l.advance(1)
+	}
+}
+
+// token builds a token from start up to the current position
+func (l *lexer) token(kind TokenKind, start Pos) Token {
+	return Token{
+		Kind: kind,
+		Text: l.src[start.Offset:l.pos.Offset],
+		Span: Span{Start: start, End: l.pos},
+	}
+}
+
+// Next returns the next token in the source
+func (l *lexer) Next() Token {
+	tok := l.next()
+	switch tok.Kind {
+	case TokNewline:
+		l.stmtStart = true
+	case TokIdent:
+		if l.stmtStart && rawKeywords[tok.Text] {
+			l.rawRest = true
+		}
+		l.stmtStart = false
+	default:
+		l.stmtStart = false
+	}
+	if tok.Kind != TokNewline {
+		l.prev = tok.Kind
+	} else {
+		l.prev = TokNewline
+	}
+	return tok
+}
+
+func (l *lexer) next() Token {
+	l.skipSpace()
+	start := l.pos
+
+	if l.rawRest {
+		l.rawRest = false
+		if raw, ok := l.lexRaw(start); ok {
+			return raw
+		}
+	}
+
+	if l.pos.Offset >= len(l.src) {
+		return l.token(TokEOF, start)
+	}
+
+	c := l.peekByte(0)
+	switch {
+	case c == '\n' || c == ';':
+		l.advance(1)
+		return l.token(TokNewline, start)
+
+	case strings.HasPrefix(l.rest(), "%%"):
+		for l.pos.Offset < len(l.src) && l.peekByte(0) != '\n' {
+			l.advance(1)
+		}
+		tok := l.token(TokComment, start)
+		tok.Value = strings.TrimSpace(strings.TrimPrefix(tok.Text, "%%"))
+		return tok
+
+	case c == '|' && l.prev == TokArrow:
+		return l.lexEdgeLabel(start)
+
+	case c == '"':
+		return l.lexString(start)
+
+	case c == '&':
+		l.advance(1)
+		return l.token(TokAmp, start)
+	}
+
+	if l.prev == TokIdent {
+		if tok, ok := l.lexShape(start); ok {
+			return tok
+		}
+	}
+
+	afterNode := l.prev == TokIdent || l.prev == TokShape
+	if afterNode && strings.HasPrefix(l.rest(), ":::") {
+		return l.lexClass(start)
+	}
+
+	// x--x and o--o start with ID characters, so they are only links
+	// right after a node
+	afterNode = afterNode || l.prev == TokClass
+	if strings.ContainsRune("-=.<~", rune(c)) || (afterNode && (c == 'x' || c == 'o')) {
+		if tok, ok := l.lexLink(start); ok {
+			return tok
+		}
+	}
+
+	if isIdentRune(l.peekRune()) {
+		return l.lexIdent(start)
+	}
+
+	// Unknown text: consume up to the next blank or statement end
+	for l.pos.Offset < len(l.src) {
+		c := l.peekByte(0)
+		if c == ' ' || c == '\t' || c == '\r' || c == '\n' || c == ';' {
+			break
+		}
+		l.advance(1)
+	}
+	return l.token(TokIllegal, start)
+}
+
+func (l *lexer) peekRune() rune {
+	r, _ := utf8.DecodeRuneInString(l.rest())
+	return r
+}
+
+func isIdentRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// lexIdent scans a node ID or keyword. Hyphens are allowed inside IDs
+// (kafka-in) as long as they are not the start of a link (A-->B).
+func (l *lexer) lexIdent(start Pos) Token {
+	for l.pos.Offset < len(l.src) {
+		r, size := utf8.DecodeRuneInString(l.rest())
+		if isIdentRune(r) {
+			l.advance(size)
+			continue
+		}
+		if r == '-' {
+			next, _ := utf8.DecodeRuneInString(l.src[l.pos.Offset+1:])
+			if isIdentRune(next) {
+				l.advance(1)
+				continue
+			}
+		}
+		break
+	}
+	return l.token(TokIdent, start)
+}
+
+// lexClass scans the :::class shorthand of A:::service or A[Label]:::service
+func (l *lexer) lexClass(start Pos) Token {
+	l.advance(3)
+	nameStart := l.pos
+	ident := l.lexIdent(nameStart)
+	if ident.Text == "" {
+		return l.token(TokIllegal, start)
+	}
+	tok := l.token(TokClass, start)
+	tok.Value = ident.Text
+	tok.ValueSpan = ident.Span
+	return tok
+}
+
+// lexString scans a double-quoted string, which may span lines
+func (l *lexer) lexString(start Pos) Token {
+	l.advance(1)
+	for l.pos.Offset < len(l.src) {
+		c := l.peekByte(0)
+		l.advance(1)
+		if c == '"' {
+			tok := l.token(TokString, start)
+			tok.Value = tok.Text[1 : len(tok.Text)-1]
+			tok.ValueSpan = Span{Start: l.offsetPos(start, 1), End: l.offsetPos(l.pos, -1)}
+			return tok
+		}
+	}
+	return l.illegalLine(start)
+}
+
+// lexShape scans a node shape such as [Label] or [(Label)] that follows a node ID.
+// The label may continue over several lines until its closing delimiter.
+func (l *lexer) lexShape(start Pos) (Token, bool) {
+	for _, d := range shapeDelims {
+		if !strings.HasPrefix(l.rest(), d.Open) {
+			continue
+		}
+		l.advance(len(d.Open))
+		valueStart := l.pos
+		if !l.scanLabel(d.Open, d.Close) {
+			l.pos = start
+			continue
+		}
+		valueEnd := l.pos
+		value := l.src[valueStart.Offset:valueEnd.Offset]
+		if strings.Contains(value, d.Close[len(d.Close)-1:]) && !strings.HasPrefix(strings.TrimSpace(value), `"`) {
+			// An unquoted label cannot contain the closing bracket; a
+			// shorter closer earlier on ([/a\] vs [/a/]) ends this shape
+			l.pos = start
+			continue
+		}
+		l.advance(len(d.Close))
+		tok := l.token(TokShape, start)
+		tok.Value = value
+		tok.ValueSpan = Span{Start: valueStart, End: valueEnd}
+		tok.Open = d.Open
+		tok.Close = d.Close
+		return tok, true
+	}
+	if strings.ContainsAny(l.rest()[:1], "[({>@") {
+		return l.illegalLine(start), true
+	}
+	return Token{}, false
+}
+
+// scanLabel advances to the closing delimiter of a label. A label starting
+// with a quote runs to the matching quote first, so delimiters inside "..."
+// do not end it. Once an unquoted label has crossed a line break, meeting
+// another opening bracket of its kind means the label was never closed.
+func (l *lexer) scanLabel(open, close string) bool {
+	l.skipSpace()
+	if l.peekByte(0) == '"' {
+		l.advance(1)
+		for l.pos.Offset < len(l.src) && l.peekByte(0) != '"' {
+			l.advance(1)
+		}
+		if l.pos.Offset >= len(l.src) {
+			return false
+		}
+		l.advance(1)
+	}
+	guard := open[0]
+	if guard == '@' {
+		guard = '{'
+	}
+	multiline := false
+	for l.pos.Offset < len(l.src) {
+		if strings.HasPrefix(l.rest(), close) {
+			return true
+		}
+		c := l.peekByte(0)
+		if c == '\n' {
+			multiline = true
+		} else if multiline && c == guard {
+			return false
+		}
+		l.advance(1)
+	}
+	return false
+}
+
+// lexEdgeLabel scans a |label| following a link. Like node labels, it may
+// span several lines.
+func (l *lexer) lexEdgeLabel(start Pos) Token {
+	l.advance(1)
+	valueStart := l.pos
+	for l.pos.Offset < len(l.src) {
+		if l.peekByte(0) == '|' {
+			valueEnd := l.pos
+			l.advance(1)
+			tok := l.token(TokEdgeLabel, start)
+			tok.Value = l.src[valueStart.Offset:valueEnd.Offset]
+			tok.ValueSpan = Span{Start: valueStart, End: valueEnd}
+			return tok
+		}
+		l.advance(1)
+	}
+	return l.illegalLine(start)
+}
+
+// illegalLine rewinds to start and returns the rest of its line as an
+// illegal token. Used when a label or string is never closed, so the error
+// does not swallow the rest of the diagram.
+func (l *lexer) illegalLine(start Pos) Token {
+	l.pos = start
+	for l.pos.Offset < len(l.src) && l.peekByte(0) != '\n' {
+		l.advance(1)
+	}
+	return l.token(TokIllegal, start)
+}
+
+// offsetPos returns p moved by n bytes within a single line
+func (l *lexer) offsetPos(p Pos, n int) Pos {
+	return Pos{Offset: p.Offset + n, Line: p.Line, Col: p.Col + n}
+}
+
+// lexRaw returns the rest of the line up to a newline or ';'
+func (l *lexer) lexRaw(start Pos) (Token, bool) {
+	for l.pos.Offset < len(l.src) {
+		c := l.peekByte(0)
+		if c == '\n' || c == ';' {
+			break
+		}
+		l.advance(1)
+	}
+	if l.pos.Offset == start.Offset {
+		return Token{}, false
+	}
+	tok := l.token(TokRaw, start)
+	tok.Value = strings.TrimSpace(tok.Text)
+	return tok, true
+}
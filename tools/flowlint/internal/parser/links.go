@@ -0,0 +1,234 @@
+package parser
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Link strokes
+const (
+	StrokeNormal    = "normal"    // --> ---
+	StrokeThick     = "thick"     // ==> ===
+	StrokeDotted    = "dotted"    // -.-> -.-
+	StrokeInvisible = "invisible" // ~~~
+)
+
+// Link heads
+const (
+	HeadArrow  = "arrow"  // >
+	HeadCross  = "cross"  // x
+	HeadCircle = "circle" // o
+	HeadNone   = "none"   // open link
+)
+
+// LinkType is the structure of a link token, independent of how it was
+// written: A -- text --> B and A -->|text| B have the same LinkType.
+type LinkType struct {
+	Stroke        string // normal, thick, dotted, invisible
+	Head          string // head at the target end: arrow, cross, circle, none
+	Length        int    // 1 for -->, 2 for --->, 3 for ---->
+	Bidirectional bool   // <-->, x--x, o--o: the same head at the source end
+}
+
+// String returns the canonical mermaid token for the link type
+func (t LinkType) String() string {
+	length := t.Length
+	if length < 1 {
+		length = 1
+	}
+
+	head := headChars[t.Head]
+	tail := ""
+	if t.Bidirectional {
+		tail = tailChars[t.Head]
+	}
+
+	switch t.Stroke {
+	case StrokeInvisible:
+		return strings.Repeat("~", length+2)
+	case StrokeDotted:
+		return tail + "-" + strings.Repeat(".", length) + "-" + head
+	case StrokeThick:
+		if head == "" {
+			return strings.Repeat("=", length+2)
+		}
+		return tail + strings.Repeat("=", length+1) + head
+	default:
+		if head == "" {
+			return strings.Repeat("-", length+2)
+		}
+		return tail + strings.Repeat("-", length+1) + head
+	}
+}
+
+var headChars = map[string]string{HeadArrow: ">", HeadCross: "x", HeadCircle: "o", HeadNone: ""}
+var tailChars = map[string]string{HeadArrow: "<", HeadCross: "x", HeadCircle: "o", HeadNone: ""}
+
+var headNames = map[byte]string{'>': HeadArrow, 'x': HeadCross, 'o': HeadCircle}
+var tailNames = map[byte]string{'<': HeadArrow, 'x': HeadCross, 'o': HeadCircle}
+
+var (
+	// Complete link tokens. The head is optional here; the open forms
+	// need one extra stroke character (--- rather than --).
+	normalLinkRe    = regexp.MustCompile(`^([<xo]?)(-{2,})([>xo]?)`)
+	thickLinkRe     = regexp.MustCompile(`^([<xo]?)(={2,})([>xo]?)`)
+	dottedLinkRe    = regexp.MustCompile(`^([<xo]?)-(\.+)-([>xo]?)`)
+	invisibleLinkRe = regexp.MustCompile(`^~{3,}`)
+
+	// Starts of links with inline text: A -- text --> B, A == text ==> B,
+	// A -. text .-> B
+	textLinkStartRe = regexp.MustCompile(`^([<xo]?)(--|==|-\.)[ \t]`)
+
+	// Ends of links with inline text, by start token
+	textLinkEndRes = map[string]*regexp.Regexp{
+		"--": regexp.MustCompile(`-{2,}[>xo]|-{3,}`),
+		"==": regexp.MustCompile(`={2,}[>xo]|={3,}`),
+		"-.": regexp.MustCompile(`-?(\.+)-[>xo]?`),
+	}
+)
+
+// scanLink matches a complete link token at the start of s. It returns the
+// token length and its type, or 0 if s does not start with a link.
+func scanLink(s string) (int, LinkType) {
+	if m := invisibleLinkRe.FindString(s); m != "" {
+		return len(m), LinkType{Stroke: StrokeInvisible, Head: HeadNone, Length: len(m) - 2}
+	}
+
+	if m := dottedLinkRe.FindStringSubmatch(s); m != nil {
+		if t, ok := linkType(StrokeDotted, m[1], m[3], len(m[2]), s[len(m[0]):]); ok {
+			return len(m[0]), t
+		}
+	}
+
+	for _, lr := range []struct {
+		re     *regexp.Regexp
+		stroke string
+	}{{normalLinkRe, StrokeNormal}, {thickLinkRe, StrokeThick}} {
+		m := lr.re.FindStringSubmatch(s)
+		if m == nil {
+			continue
+		}
+		tail, body, head := m[1], m[2], m[3]
+		if head != "" && head != ">" && startsIdent(s[len(m[0]):]) {
+			// --oB: the o belongs to the next node ID
+			head = ""
+		}
+		n := len(tail) + len(body) + len(head)
+		length := len(body) - 1
+		if head == "" {
+			// Open links need three stroke characters: --- or ===
+			if len(body) < 3 {
+				return 0, LinkType{}
+			}
+			length = len(body) - 2
+		}
+		if t, ok := linkType(lr.stroke, tail, head, length, s[n:]); ok {
+			return n, t
+		}
+	}
+	return 0, LinkType{}
+}
+
+// linkType builds a LinkType from the matched parts of a link, checking
+// that a source-end head mirrors the target-end head (<-->, x--x, o--o)
+func linkType(stroke, tail, head string, length int, after string) (LinkType, bool) {
+	if head != "" && head != ">" && startsIdent(after) {
+		return LinkType{}, false
+	}
+	t := LinkType{Stroke: stroke, Head: HeadNone, Length: length}
+	if head != "" {
+		t.Head = headNames[head[0]]
+	}
+	if tail != "" {
+		if tailNames[tail[0]] != t.Head {
+			return LinkType{}, false
+		}
+		t.Bidirectional = true
+	}
+	return t, true
+}
+
+// startsIdent reports whether s begins with a node ID character
+func startsIdent(s string) bool {
+	return s != "" && isIdentRune(rune(s[0]))
+}
+
+// lexLink scans a link at the current position: a complete token such as
+// ==> or <-.->, or a link with inline text such as -- gRPC: Get -->.
+// Inline text is returned as the token's Value.
+func (l *lexer) lexLink(start Pos) (Token, bool) {
+	rest := l.rest()
+
+	if m := textLinkStartRe.FindStringSubmatch(rest); m != nil {
+		if tok, ok := l.lexTextLink(start, m[1], m[2]); ok {
+			return tok, true
+		}
+	}
+
+	n, t := scanLink(rest)
+	if n == 0 {
+		return Token{}, false
+	}
+	l.advance(n)
+	tok := l.token(TokArrow, start)
+	tok.Link = t
+	return tok, true
+}
+
+// lexTextLink scans the text and closing token of A -- text --> B. The
+// text must end on the line it starts on.
+func (l *lexer) lexTextLink(start Pos, tail, open string) (Token, bool) {
+	rest := l.rest()
+	textStart := len(tail) + len(open)
+	line := rest[textStart:]
+	if nl := strings.IndexByte(line, '\n'); nl >= 0 {
+		line = line[:nl]
+	}
+	loc := textLinkEndRes[open].FindStringIndex(line)
+	if loc == nil {
+		return Token{}, false
+	}
+	endStart, endStop := textStart+loc[0], textStart+loc[1]
+	end := rest[endStart:endStop]
+
+	var t LinkType
+	var ok bool
+	switch open {
+	case "--", "==":
+		stroke := StrokeNormal
+		if open == "==" {
+			stroke = StrokeThick
+		}
+		body := strings.TrimRight(end, ">xo")
+		head := end[len(body):]
+		length := len(body) - 1
+		if head == "" {
+			length = len(body) - 2
+		}
+		t, ok = linkType(stroke, tail, head, length, rest[endStop:])
+	case "-.":
+		body := strings.TrimRight(end, ">xo")
+		head := end[len(body):]
+		t, ok = linkType(StrokeDotted, tail, head, strings.Count(body, "."), rest[endStop:])
+	}
+	if !ok {
+		return Token{}, false
+	}
+
+	text := rest[textStart:endStart]
+	if strings.TrimSpace(text) == "" {
+		return Token{}, false
+	}
+
+	// Label span covers the trimmed text
+	lead := len(text) - len(strings.TrimLeft(text, " \t"))
+	label := strings.TrimSpace(text)
+	valueStart := advancePos(start, rest[:textStart+lead])
+
+	l.advance(endStop)
+	tok := l.token(TokArrow, start)
+	tok.Link = t
+	tok.Value = label
+	tok.ValueSpan = Span{Start: valueStart, End: advancePos(valueStart, label)}
+	return tok, true
+}
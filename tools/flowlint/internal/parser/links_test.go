@@ -0,0 +1,97 @@
+package parser
+
+import (
+	"testing"
+)
+
+func TestLinkTypes(t *testing.T) {
+	tests := []struct {
+		code  string
+		arrow string
+		want  LinkType
+	}{
+		{"A --> B", "-->", LinkType{Stroke: StrokeNormal, Head: HeadArrow, Length: 1}},
+		{"A ---> B", "--->", LinkType{Stroke: StrokeNormal, Head: HeadArrow, Length: 2}},
+		{"A ----> B", "---->", LinkType{Stroke: StrokeNormal, Head: HeadArrow, Length: 3}},
+		{"A --- B", "---", LinkType{Stroke: StrokeNormal, Head: HeadNone, Length: 1}},
+		{"A ==> B", "==>", LinkType{Stroke: StrokeThick, Head: HeadArrow, Length: 1}},
+		{"A ====> B", "====>", LinkType{Stroke: StrokeThick, Head: HeadArrow, Length: 3}},
+		{"A === B", "===", LinkType{Stroke: StrokeThick, Head: HeadNone, Length: 1}},
+		{"A -.-> B", "-.->", LinkType{Stroke: StrokeDotted, Head: HeadArrow, Length: 1}},
+		{"A -..-> B", "-..->", LinkType{Stroke: StrokeDotted, Head: HeadArrow, Length: 2}},
+		{"A -.- B", "-.-", LinkType{Stroke: StrokeDotted, Head: HeadNone, Length: 1}},
+		{"A --x B", "--x", LinkType{Stroke: StrokeNormal, Head: HeadCross, Length: 1}},
+		{"A --o B", "--o", LinkType{Stroke: StrokeNormal, Head: HeadCircle, Length: 1}},
+		{"A <--> B", "<-->", LinkType{Stroke: StrokeNormal, Head: HeadArrow, Length: 1, Bidirectional: true}},
+		{"A x--x B", "x--x", LinkType{Stroke: StrokeNormal, Head: HeadCross, Length: 1, Bidirectional: true}},
+		{"A o--o B", "o--o", LinkType{Stroke: StrokeNormal, Head: HeadCircle, Length: 1, Bidirectional: true}},
+		{"A <==> B", "<==>", LinkType{Stroke: StrokeThick, Head: HeadArrow, Length: 1, Bidirectional: true}},
+		{"A <-.-> B", "<-.->", LinkType{Stroke: StrokeDotted, Head: HeadArrow, Length: 1, Bidirectional: true}},
+		{"A ~~~ B", "~~~", LinkType{Stroke: StrokeInvisible, Head: HeadNone, Length: 1}},
+		{"A-->B", "-->", LinkType{Stroke: StrokeNormal, Head: HeadArrow, Length: 1}},
+		{"A---oB", "---", LinkType{Stroke: StrokeNormal, Head: HeadNone, Length: 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			d := mustParse(t, "flowchart TD\n    "+tt.code)
+			if len(d.Edges) != 1 {
+				t.Fatalf("got %d edges, want 1", len(d.Edges))
+			}
+			edge := d.Edges[0]
+			if edge.ArrowType != tt.arrow || edge.LinkType != tt.want {
+				t.Errorf("got %s %+v, want %s %+v", edge.ArrowType, edge.LinkType, tt.arrow, tt.want)
+			}
+			if got := edge.LinkType.String(); got != tt.arrow {
+				t.Errorf("String() = %s, want %s", got, tt.arrow)
+			}
+		})
+	}
+}
+
+func TestInvalidLinks(t *testing.T) {
+	for _, code := range []string{"A =>> B", "A -> B", "A <--x B", "A -.. B"} {
+		t.Run(code, func(t *testing.T) {
+			d := mustParse(t, "flowchart TD\n    "+code)
+			if len(d.Edges) != 0 {
+				t.Errorf("got edges %v, want none", edgeList(d))
+			}
+		})
+	}
+}
+
+func TestLinkLabels(t *testing.T) {
+	tests := []struct {
+		code   string
+		arrow  string
+		label  string
+		inline bool
+		raw    string
+	}{
+		{"A -->|gRPC: GetBalance| B", "-->", "gRPC: GetBalance", false, "-->"},
+		{"A -- gRPC: GetBalance --> B", "-->", "gRPC: GetBalance", true, "-- gRPC: GetBalance -->"},
+		{"A == publish ==> B", "==>", "publish", true, "== publish ==>"},
+		{"A -. consume .-> B", "-.->", "consume", true, "-. consume .->"},
+		{"A -- related --- B", "---", "related", true, "-- related ---"},
+		{`A -->|"quoted"| B`, "-->", "quoted", false, "-->"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			code := "flowchart TD\n    " + tt.code
+			d := mustParse(t, code)
+			if len(d.Edges) != 1 {
+				t.Fatalf("got %d edges, want 1", len(d.Edges))
+			}
+			edge := d.Edges[0]
+			if edge.ArrowType != tt.arrow || edge.Label != tt.label || edge.Inline != tt.inline {
+				t.Errorf("got %s %q inline=%v, want %s %q inline=%v",
+					edge.ArrowType, edge.Label, edge.Inline, tt.arrow, tt.label, tt.inline)
+			}
+			if edge.RawArrow != tt.raw {
+				t.Errorf("raw arrow = %q, want %q", edge.RawArrow, tt.raw)
+			}
+			if got := code[edge.LabelSpan.Start.Offset:edge.LabelSpan.End.Offset]; got != edge.RawLabel {
+				t.Errorf("label span covers %q, raw label is %q", got, edge.RawLabel)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package parser
+
+import "fmt"
+
+// Location is a source range in the file a diagram was read from. Lines
+// and columns are 1-based byte positions; EndCol is just past the range.
+type Location struct {
+	File    string
+	Line    int
+	Col     int
+	EndLine int
+	EndCol  int
+}
+
+// IsValid reports whether the location points anywhere
+func (l Location) IsValid() bool {
+	return l.Line > 0
+}
+
+// String formats the location as file:line:col, the form terminals and
+// editors turn into links
+func (l Location) String() string {
+	pos := fmt.Sprintf("%d:%d", l.Line, l.Col)
+	if l.File == "" {
+		return pos
+	}
+	return l.File + ":" + pos
+}
+
+// Locate maps a span of the diagram's code to its location in the file
+func (d *Diagram) Locate(span Span) Location {
+	start, end := d.filePos(span.Start), d.filePos(span.End)
+	return Location{
+		File:    d.File,
+		Line:    start.Line,
+		Col:     start.Col,
+		EndLine: end.Line,
+		EndCol:  end.Col,
+	}
+}
+
+// filePos maps a position in the diagram's code to the file. The first
+// line is shifted right to where the code starts; the others by the
+// indentation removed from them, which also lies before the position in
+// the file.
+func (d *Diagram) filePos(p Pos) Pos {
+	if p.Line == 1 {
+		p.Col += d.Origin.Col - 1
+	}
+	for i := 1; i < p.Line && i < len(d.dedents); i++ {
+		p.Offset += d.dedents[i]
+	}
+	if p.Line > 1 && p.Line <= len(d.dedents) {
+		p.Col += d.dedents[p.Line-1]
+	}
+	p.Line += d.Origin.Line - 1
+	p.Offset += d.Origin.Offset
+	return p
+}
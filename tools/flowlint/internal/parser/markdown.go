@@ -6,19 +6,153 @@ import (
 	"strings"
 )
 
-// ExtractMermaid extracts the mermaid code block from markdown content
+// MermaidBlock is one mermaid fenced block in a markdown document
+type MermaidBlock struct {
+	Index     int    // position among the document's mermaid blocks, from 0
+	Code      string // block content with surrounding blank space trimmed
+	Start     int    // byte offset of the opening fence
+	End       int    // byte offset just past the closing fence
+	CodeStart int    // byte offset of Code in the document
+	CodeEnd   int    // byte offset just past Code
+	Line      int    // 1-based document line on which Code starts
+	Col       int    // 1-based column at which Code starts
+	Indent    string // indentation of the opening fence
+
+	// dedents holds the spaces removed from the start of each line of
+	// Code, as the fence's indentation is removed from its content
+	dedents []int
+}
+
+// Origin returns the document position at which the block's code starts
+func (b MermaidBlock) Origin() Pos {
+	return Pos{Offset: b.CodeStart, Line: b.Line, Col: b.Col}
+}
+
+// fenceRe matches the opening line of a fenced code block: up to three
+// spaces of indentation, a run of three or more backticks or tildes, and
+// the info string. Deeper indentation makes an indented code block.
+var fenceRe = regexp.MustCompile("^( {0,3})(`{3,}|~{3,})[ \t]*(.*)$")
+
+// fence is an open fenced code block
+type fence struct {
+	marker  string // the backticks or tildes that opened it
+	mermaid bool
+	block   MermaidBlock
+}
+
+// closes reports whether line closes the fence: up to three spaces of
+// indentation, the same fence character, at least as many of them, and
+// nothing else
+func (f *fence) closes(line string) bool {
+	trimmed := strings.TrimLeft(line, " ")
+	if len(line)-len(trimmed) > 3 {
+		return false
+	}
+	line = strings.TrimSpace(trimmed)
+	return len(line) >= len(f.marker) && strings.Trim(line, f.marker[:1]) == ""
+}
+
+// ExtractMermaidBlocks returns every non-empty mermaid block in the
+// markdown content, in document order. Both ``` and ~~~ fences are
+// recognized, indented by up to three spaces as in CommonMark; the fence's
+// indentation is removed from each line of the code. Other fenced blocks
+// are skipped whole, so a mermaid fence quoted inside them is not picked up.
+func ExtractMermaidBlocks(content string) []MermaidBlock {
+	blocks := []MermaidBlock{}
+
+	offset := 0
+	var open *fence
+	for offset < len(content) {
+		lineEnd := strings.IndexByte(content[offset:], '\n')
+		next := len(content)
+		if lineEnd >= 0 {
+			lineEnd += offset
+			next = lineEnd + 1
+		} else {
+			lineEnd = len(content)
+		}
+		line := strings.TrimRight(content[offset:lineEnd], "\r")
+
+		switch {
+		case open == nil:
+			if m := fenceRe.FindStringSubmatch(line); m != nil {
+				info := strings.Fields(m[3])
+				if m[2][0] == '`' && strings.Contains(m[3], "`") {
+					break // not a fence: backtick info strings cannot hold backticks
+				}
+				open = &fence{
+					marker:  m[2],
+					mermaid: len(info) > 0 && info[0] == "mermaid",
+					block:   MermaidBlock{Start: offset, CodeStart: next, Indent: m[1]},
+				}
+			}
+		case open.closes(line):
+			if open.mermaid {
+				open.block.CodeEnd = offset
+				open.block.End = lineEnd
+				if block := finishBlock(content, open.block, len(blocks)); block.Code != "" {
+					blocks = append(blocks, block)
+				}
+			}
+			open = nil
+		}
+		offset = next
+	}
+
+	return blocks
+}
+
+// finishBlock trims the block's code, removes the fence's indentation from
+// it and records where it starts
+func finishBlock(content string, block MermaidBlock, index int) MermaidBlock {
+	raw := content[block.CodeStart:block.CodeEnd]
+	lead := len(raw) - len(strings.TrimLeft(raw, " \t\r\n"))
+	trimmed := strings.TrimSpace(raw)
+
+	block.Index = index
+	block.CodeStart += lead
+	block.CodeEnd = block.CodeStart + len(trimmed)
+	block.Line = strings.Count(content[:block.CodeStart], "\n") + 1
+	block.Col = block.CodeStart - (strings.LastIndexByte(content[:block.CodeStart], '\n') + 1) + 1
+
+	// The first line starts past its indentation already; the others lose
+	// up to as many spaces as indent the fence
+	lines := strings.Split(trimmed, "\n")
+	block.dedents = make([]int, len(lines))
+	for i := 1; i < len(lines); i++ {
+		n := 0
+		for n < len(block.Indent) && n < len(lines[i]) && lines[i][n] == ' ' {
+			n++
+		}
+		lines[i] = lines[i][n:]
+		block.dedents[i] = n
+	}
+	block.Code = strings.Join(lines, "\n")
+	return block
+}
+
+// ExtractMermaid extracts the first mermaid code block from markdown content
 func ExtractMermaid(content string) (string, error) {
-	// Match ```mermaid ... ```
-	re := regexp.MustCompile("(?s)```mermaid\\s*\\n(.+?)\\n```")
-	matches := re.FindStringSubmatch(content)
-	if len(matches) < 2 {
+	blocks := ExtractMermaidBlocks(content)
+	if len(blocks) == 0 {
 		return "", fmt.Errorf("no mermaid code block found")
 	}
-	return strings.TrimSpace(matches[1]), nil
+	return blocks[0].Code, nil
 }
 
-// ReplaceMermaid replaces the mermaid code block in markdown with new code
-func ReplaceMermaid(content, newMermaid string) string {
-	re := regexp.MustCompile("(?s)```mermaid\\s*\\n.+?\\n```")
-	return re.ReplaceAllString(content, "```mermaid\n"+newMermaid+"\n```")
+// ReplaceMermaidBlock replaces the code of one block, leaving the fences and
+// every other block untouched. The fence's indentation is put back on each
+// non-blank line after the first. When replacing several blocks, work from
+// the last block to the first so earlier offsets stay valid.
+func ReplaceMermaidBlock(content string, block MermaidBlock, newCode string) string {
+	if block.Indent != "" {
+		lines := strings.Split(newCode, "\n")
+		for i := 1; i < len(lines); i++ {
+			if strings.TrimSpace(lines[i]) != "" {
+				lines[i] = block.Indent + lines[i]
+			}
+		}
+		newCode = strings.Join(lines, "\n")
+	}
+	return content[:block.CodeStart] + newCode + content[block.CodeEnd:]
 }
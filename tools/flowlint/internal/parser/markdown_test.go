@@ -0,0 +1,187 @@
+package parser
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestExtractMermaidBlocks(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{
+			name:    "several blocks",
+			content: "# A\n\n```mermaid\nflowchart TD\n    A --> B\n```\n\ntext\n\n```mermaid\nflowchart LR\n    C --> D\n```\n",
+			want:    []string{"flowchart TD\n    A --> B", "flowchart LR\n    C --> D"},
+		},
+		{
+			name:    "tilde fence",
+			content: "~~~mermaid\nflowchart TD\n    A --> B\n~~~\n",
+			want:    []string{"flowchart TD\n    A --> B"},
+		},
+		{
+			name:    "closed by a longer fence only",
+			content: "````mermaid\nflowchart TD\n```\n    A --> B\n`````\n",
+			want:    []string{"flowchart TD\n```\n    A --> B"},
+		},
+		{
+			name:    "other fence quoting a mermaid fence",
+			content: "~~~markdown\n```mermaid\nflowchart TD\n```\n~~~\n\n```mermaid\nflowchart LR\n```\n",
+			want:    []string{"flowchart LR"},
+		},
+		{
+			name:    "indented fence",
+			content: "1. Flow:\n\n   ```mermaid\n   flowchart TD\n       A --> B\n   ```\n",
+			want:    []string{"flowchart TD\n    A --> B"},
+		},
+		{
+			name:    "indentation beyond the fence's kept",
+			content: "  ```mermaid\n  flowchart TD\n      A --> B\n C --> D\n  ```\n",
+			want:    []string{"flowchart TD\n    A --> B\nC --> D"},
+		},
+		{
+			name:    "indented code, not a fence",
+			content: "text\n\n    ```mermaid\n    flowchart TD\n    ```\n",
+			want:    []string{},
+		},
+		{
+			name:    "closing fence too deeply indented",
+			content: "```mermaid\nflowchart TD\n    ```\n",
+			want:    []string{},
+		},
+		{
+			name:    "backticks in the info string",
+			content: "```mermaid `x`\nflowchart TD\n```\n",
+			want:    []string{},
+		},
+		{
+			name:    "empty block skipped",
+			content: "```mermaid\n\n```\n```mermaid\nflowchart TD\n```\n",
+			want:    []string{"flowchart TD"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{}
+			for i, block := range ExtractMermaidBlocks(tt.content) {
+				if block.Index != i {
+					t.Errorf("block %d has Index %d", i, block.Index)
+				}
+				got = append(got, block.Code)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("codes = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMermaidBlockPositions(t *testing.T) {
+	content := "# A\n\n```mermaid\nflowchart TD\n```\n\n- item\n\n  ~~~ mermaid\n\n  flowchart LR\n  ~~~\n"
+	blocks := ExtractMermaidBlocks(content)
+	if len(blocks) != 2 {
+		t.Fatalf("got %d blocks, want 2", len(blocks))
+	}
+
+	tests := []struct {
+		line, col int
+		indent    string
+	}{
+		{line: 4, col: 1, indent: ""},
+		{line: 11, col: 3, indent: "  "},
+	}
+	for i, tt := range tests {
+		b := blocks[i]
+		if b.Line != tt.line || b.Col != tt.col || b.Indent != tt.indent {
+			t.Errorf("block %d at %d:%d indented %q, want %d:%d indented %q",
+				i, b.Line, b.Col, b.Indent, tt.line, tt.col, tt.indent)
+		}
+		if got := content[b.CodeStart:b.CodeEnd]; got != b.Code {
+			t.Errorf("block %d spans %q, want %q", i, got, b.Code)
+		}
+		if fence := content[b.Start+len(b.Indent):]; !strings.HasPrefix(fence, "```") && !strings.HasPrefix(fence, "~~~") {
+			t.Errorf("block %d Start %d is not at its fence", i, b.Start)
+		}
+		if end := content[:b.End]; !strings.HasSuffix(end, "```") && !strings.HasSuffix(end, "~~~") {
+			t.Errorf("block %d End %d is not past its fence", i, b.End)
+		}
+	}
+}
+
+func TestLocateInBlock(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{
+			name:    "second block",
+			content: "# A\n\n```mermaid\nflowchart TD\n```\n\n```mermaid\nflowchart TD\n    A --> B\n    D1 =>> D2\n```\n",
+			want:    []string{"doc.md:10:5: unrecognized statement: D1 =>> D2"},
+		},
+		{
+			name:    "indented fence",
+			content: "1. Flow:\n\n   ```mermaid\n   flowchart TD\n       A --> B\n       D1 =>> D2\n   ```\n",
+			want:    []string{"doc.md:6:8: unrecognized statement: D1 =>> D2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blocks := ExtractMermaidBlocks(tt.content)
+			block := blocks[len(blocks)-1]
+			d, err := ParseMermaidBlock("doc.md", block, ParseOptions{Strict: true})
+			if err != nil {
+				t.Fatalf("ParseMermaidBlock: %v", err)
+			}
+			got := []string{}
+			for _, diag := range d.Diagnostics {
+				got = append(got, diag.String())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diagnostics = %q, want %q", got, tt.want)
+			}
+
+			// Offsets and columns map back to the same text in the file
+			arrow := d.Edges[0].ArrowSpan
+			start, end := d.filePos(arrow.Start), d.filePos(arrow.End)
+			if got := tt.content[start.Offset:end.Offset]; got != "-->" {
+				t.Errorf("arrow maps to %q in the file, want %q", got, "-->")
+			}
+			loc := d.Locate(arrow)
+			lines := strings.Split(tt.content, "\n")
+			if got := lines[loc.Line-1][loc.Col-1 : loc.EndCol-1]; got != "-->" {
+				t.Errorf("arrow located at %d:%d-%d, which reads %q", loc.Line, loc.Col, loc.EndCol, got)
+			}
+		})
+	}
+}
+
+func TestReplaceMermaidBlock(t *testing.T) {
+	content := "1. Flow:\n\n   ```mermaid\n   flowchart TD\n       A --> B\n   ```\n\n```mermaid\nflowchart LR\n```\n"
+	blocks := ExtractMermaidBlocks(content)
+	if len(blocks) != 2 {
+		t.Fatalf("got %d blocks, want 2", len(blocks))
+	}
+
+	got := ReplaceMermaidBlock(content, blocks[1], "flowchart LR\n    C --> D")
+	got = ReplaceMermaidBlock(got, blocks[0], "flowchart TD\n    classDef service fill:#a5d8ff\n\n    A --> B")
+	want := "1. Flow:\n\n   ```mermaid\n   flowchart TD\n       classDef service fill:#a5d8ff\n\n       A --> B\n   ```\n\n```mermaid\nflowchart LR\n    C --> D\n```\n"
+	if got != want {
+		t.Errorf("ReplaceMermaidBlock =\n%s\nwant\n%s", got, want)
+	}
+
+	// The replaced code reads back as written
+	codes := []string{}
+	for _, block := range ExtractMermaidBlocks(got) {
+		codes = append(codes, block.Code)
+	}
+	wantCodes := []string{"flowchart TD\n    classDef service fill:#a5d8ff\n\n    A --> B", "flowchart LR\n    C --> D"}
+	if !reflect.DeepEqual(codes, wantCodes) {
+		t.Errorf("codes = %q, want %q", codes, wantCodes)
+	}
+}
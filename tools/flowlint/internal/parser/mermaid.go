@@ -1,18 +1,38 @@
 package parser
 
 import (
+	"fmt"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 )
 
 // Node represents a node in the mermaid diagram
 type Node struct {
-	ID       string
-	Label    string
-	Shape    string // rectangle, cylinder, stadium, etc.
-	Line     int
-	Classes  []string
-	Subgraph string
+	ID        string
+	Label     string   // line breaks (<br>, \n, or a real newline) become "\n"
+	RawLabel  string   // label as written between the delimiters
+	LabelSpan Span     // source range of RawLabel
+	Shape     string   // rectangle, cylinder, stadium, hexagon, etc.
+	Line      int      // line in the file, same as Loc.Line
+	Loc       Location // first definition, or first reference if never defined
+	Classes   []string
+	Subgraph  string
+	Style     string // properties from style statements
+	// Definitions lists every place the node is written with a shape,
+	// in source order. Mermaid keeps the last one.
+	Definitions []NodeDef
+}
+
+// NodeDef is one definition site of a node ID
+type NodeDef struct {
+	Label      string
+	Shape      string
+	Subgraph   string // innermost enclosing subgraph, empty at top level
+	Attributes bool   // written as A@{ shape: ... } rather than with brackets
+	Line       int    // line in the file
+	Span       Span   // source range in the diagram code
 }
 
 // Edge represents a connection between nodes
@@ -20,17 +40,31 @@ type Edge struct {
 	From      string
 	To        string
 	Label     string
-	ArrowType string // -->, ==>, -.->
-	Line      int
+	RawLabel  string
+	LabelSpan Span
+	ArrowType string   // canonical link token: -->, ==>, -.->, <-->, ~~~
+	RawArrow  string   // link as written, e.g. -- gRPC: Get -->
+	ArrowSpan Span     // source range of RawArrow
+	Inline    bool     // label written inside the link rather than as |label|
+	Line      int      // line in the file, same as Loc.Line
+	Loc       Location // from the source node to the target node
+	Style     string   // properties from linkStyle statements
+	LinkType           // Stroke, Head, Length, Bidirectional
 }
 
 // Subgraph represents a subgraph grouping
 type Subgraph struct {
-	ID     string
-	Title  string
-	Quoted bool
-	Line   int
-	Nodes  []string
+	ID        string
+	Title     string
+	Quoted    bool
+	RawTitle  string // title as written, quotes included
+	TitleSpan Span
+	Direction string   // set by a direction statement inside the subgraph
+	Line      int      // line in the file, same as Loc.Line
+	Loc       Location // the subgraph statement
+	Nodes     []string // nodes directly inside this subgraph
+	Parent    string   // enclosing subgraph ID, empty at top level
+	Children  []string // subgraphs nested directly inside this one
 }
 
 // Diagram represents a parsed mermaid diagram
@@ -40,135 +74,261 @@ type Diagram struct {
 	Edges     []*Edge
 	Subgraphs []*Subgraph
 	ClassDefs map[string]string
-	Classes   map[string][]string // node -> classes
-	RawLines  []string
+	Classes   map[string][]string // node -> classes, from class statements and :::class
+	// ClassApplications lists every class application in source order
+	ClassApplications []ClassApplication
+	Styles            []*InlineStyle // style statements
+	LinkStyles        []*InlineStyle // linkStyle statements
+	RawLines          []string
+	AST               *AST
+	File              string // file the diagram was read from, if any
+	Origin            Pos    // position in File at which the code starts
+	dedents           []int  // indentation removed from each line of the code
+	// Diagnostics lists statements the parser did not understand. It is
+	// only filled in strict mode; otherwise they are skipped.
+	Diagnostics []Diagnostic
+}
+
+// ParseOptions controls how mermaid code is parsed
+type ParseOptions struct {
+	// Strict reports every unrecognized statement as a diagnostic
+	Strict bool
+}
+
+// Diagnostic is a problem found while parsing, with the offending text
+type Diagnostic struct {
+	Loc     Location
+	Message string
+	Text    string
+}
+
+// String formats the diagnostic as file:line:col: message: text
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%s: %s: %s", d.Loc, d.Message, d.Text)
 }
 
-// ParseMermaid parses mermaid flowchart code into a structured diagram
+// ClassApplication is one place a class is applied to a node
+type ClassApplication struct {
+	Node      string
+	Class     string
+	Shorthand bool // A:::class rather than a class statement
+	Line      int  // line in the file
+}
+
+// InlineStyle is a style or linkStyle statement
+type InlineStyle struct {
+	Target string // node ID for style; link indices ("0,2" or "default") for linkStyle
+	Styles string
+	Line   int // line in the file, same as Loc.Line
+	Loc    Location
+}
+
+// ParseMermaid parses mermaid flowchart code into a structured diagram.
+// The code is first parsed into an AST by ParseFlowchart; the diagram's
+// nodes, edges and subgraphs are then built from its statements.
+// Locations are relative to the code itself.
 func ParseMermaid(code string) (*Diagram, error) {
+	return parseMermaid(code, "", Pos{Line: 1, Col: 1}, nil, ParseOptions{})
+}
+
+// ParseMermaidBlock parses a block extracted from a markdown file, so that
+// every location points into that file
+func ParseMermaidBlock(file string, block MermaidBlock, opts ParseOptions) (*Diagram, error) {
+	return parseMermaid(block.Code, file, block.Origin(), block.dedents, opts)
+}
+
+func parseMermaid(code, file string, origin Pos, dedents []int, opts ParseOptions) (*Diagram, error) {
 	diagram := &Diagram{
+		File:      file,
+		Origin:    origin,
+		dedents:   dedents,
 		Nodes:     make(map[string]*Node),
 		Edges:     []*Edge{},
 		Subgraphs: []*Subgraph{},
 		ClassDefs: make(map[string]string),
 		Classes:   make(map[string][]string),
-		RawLines:  []string{},
+		RawLines:  strings.Split(code, "\n"),
 	}
 
-	lines := strings.Split(code, "\n")
-	diagram.RawLines = lines
+	ast := ParseFlowchart(code)
+	diagram.AST = ast
 
-	var currentSubgraph *Subgraph
-
-	// Regex patterns
-	directionRe := regexp.MustCompile(`^flowchart\s+(LR|TD|TB|RL|BT)`)
-	// Node patterns: [], [(...)], ([...]), [[...]], {...}, ((...)), (...)
-	nodeRe := regexp.MustCompile(`^\s*([A-Za-z0-9_]+)(\[|\[\(|\(\[|\[\[|\{|\(\(|\()(.+?)(\]|\)\]|\]\)|\]\]|\}|\)\)|\))`)
-	edgeRe := regexp.MustCompile(`^\s*([A-Za-z0-9_]+)\s*(-->|==>|-.->|-.-|--)\s*(\|[^|]+\|)?\s*([A-Za-z0-9_]+)`)
-	subgraphStartRe := regexp.MustCompile(`^\s*subgraph\s+([A-Za-z0-9_-]+)\s*\[?"?([^"\]]*)"?\]?`)
-	subgraphEndRe := regexp.MustCompile(`^\s*end\s*$`)
-	classDefRe := regexp.MustCompile(`^\s*classDef\s+([A-Za-z0-9_]+)\s+(.+)`)
-	classRe := regexp.MustCompile(`^\s*class\s+([A-Za-z0-9_,\s]+)\s+([A-Za-z0-9_]+)`)
+	// Subgraph IDs can be used as edge endpoints; they are not nodes
+	subgraphIDs := make(map[string]bool)
+	for _, stmt := range ast.Statements {
+		if sg, ok := stmt.(*SubgraphStmt); ok {
+			subgraphIDs[sg.ID] = true
+		}
+	}
 
-	for lineNum, line := range lines {
-		line = strings.TrimSpace(line)
+	// Open subgraphs, innermost last
+	var scope []*Subgraph
+	defined := make(map[string]bool)
 
-		// Skip comments and empty lines
-		if strings.HasPrefix(line, "%%") || line == "" {
-			continue
+	// addNode records a node reference, creating the node on first use.
+	// Shaped references define the node; bare IDs only create it.
+	addNode := func(ref *NodeRef) {
+		if subgraphIDs[ref.ID] {
+			return
 		}
-
-		// Check direction
-		if matches := directionRe.FindStringSubmatch(line); matches != nil {
-			diagram.Direction = matches[1]
-			continue
+		node, ok := diagram.Nodes[ref.ID]
+		if !ok {
+			loc := diagram.Locate(ref.Span)
+			node = &Node{
+				ID:    ref.ID,
+				Label: ref.ID,
+				Shape: "rectangle",
+				Line:  loc.Line,
+				Loc:   loc,
+			}
+			diagram.Nodes[ref.ID] = node
 		}
-
-		// Check subgraph start
-		if matches := subgraphStartRe.FindStringSubmatch(line); matches != nil {
-			quoted := strings.Contains(line, `"`)
-			currentSubgraph = &Subgraph{
-				ID:     matches[1],
-				Title:  strings.Trim(matches[2], `"`),
-				Quoted: quoted,
-				Line:   lineNum + 1,
-				Nodes:  []string{},
+		if ref.Shape != nil {
+			node.Label = normalizeLabel(ref.Shape.Label)
+			node.RawLabel = sourceText(code, ref.Shape.LabelSpan)
+			node.LabelSpan = ref.Shape.LabelSpan
+			node.Shape = ref.Shape.Name
+			loc := diagram.Locate(ref.Span)
+			if !defined[ref.ID] {
+				node.Line = loc.Line
+				node.Loc = loc
+				defined[ref.ID] = true
 			}
-			diagram.Subgraphs = append(diagram.Subgraphs, currentSubgraph)
-			continue
+			def := NodeDef{
+				Label:      node.Label,
+				Shape:      node.Shape,
+				Attributes: ref.Shape.Attrs != nil,
+				Line:       loc.Line,
+				Span:       ref.Span,
+			}
+			if len(scope) > 0 {
+				def.Subgraph = scope[len(scope)-1].ID
+			}
+			node.Definitions = append(node.Definitions, def)
 		}
-
-		// Check subgraph end
-		if subgraphEndRe.MatchString(line) {
-			currentSubgraph = nil
-			continue
+		if len(scope) > 0 && node.Subgraph == "" {
+			current := scope[len(scope)-1]
+			node.Subgraph = current.ID
+			current.Nodes = append(current.Nodes, node.ID)
 		}
+	}
 
-		// Check classDef
-		if matches := classDefRe.FindStringSubmatch(line); matches != nil {
-			diagram.ClassDefs[matches[1]] = matches[2]
-			continue
-		}
+	applyClass := func(nodeID, class string, shorthand bool, pos Pos) {
+		diagram.Classes[nodeID] = append(diagram.Classes[nodeID], class)
+		diagram.ClassApplications = append(diagram.ClassApplications, ClassApplication{
+			Node:      nodeID,
+			Class:     class,
+			Shorthand: shorthand,
+			Line:      diagram.filePos(pos).Line,
+		})
+	}
+
+	for _, stmt := range ast.Statements {
+		switch s := stmt.(type) {
+		case *HeaderStmt:
+			diagram.Direction = s.Direction
 
-		// Check class application
-		if matches := classRe.FindStringSubmatch(line); matches != nil {
-			nodes := strings.Split(matches[1], ",")
-			className := matches[2]
-			for _, node := range nodes {
-				node = strings.TrimSpace(node)
-				diagram.Classes[node] = append(diagram.Classes[node], className)
+		case *DirectionStmt:
+			if len(scope) > 0 {
+				scope[len(scope)-1].Direction = s.Direction
 			}
-			continue
-		}
 
-		// Check edges (must check before nodes since edges contain node references)
-		if matches := edgeRe.FindStringSubmatch(line); matches != nil {
-			label := ""
-			if matches[3] != "" {
-				label = strings.Trim(matches[3], "|")
+		case *SubgraphStmt:
+			// A subgraph cannot sit inside itself: reopening an ID that is
+			// still open continues that subgraph until the matching end
+			if open := openSubgraph(scope, s.ID); open != nil {
+				if opts.Strict {
+					diagram.Diagnostics = append(diagram.Diagnostics, Diagnostic{
+						Loc:     diagram.Locate(s.Span),
+						Message: fmt.Sprintf("subgraph '%s' is already open", s.ID),
+						Text:    sourceText(code, s.Span),
+					})
+				}
+				scope = append(scope, open)
+				continue
 			}
-			edge := &Edge{
-				From:      matches[1],
-				To:        matches[4],
-				ArrowType: matches[2],
-				Label:     label,
-				Line:      lineNum + 1,
+			loc := diagram.Locate(s.Span)
+			sg := &Subgraph{
+				ID:        s.ID,
+				Title:     normalizeLabel(s.Title),
+				Quoted:    s.Quoted,
+				RawTitle:  sourceText(code, s.TitleSpan),
+				TitleSpan: s.TitleSpan,
+				Line:      loc.Line,
+				Loc:       loc,
+				Nodes:     []string{},
+				Children:  []string{},
+			}
+			if len(scope) > 0 {
+				parent := scope[len(scope)-1]
+				sg.Parent = parent.ID
+				parent.Children = append(parent.Children, sg.ID)
+			}
+			diagram.Subgraphs = append(diagram.Subgraphs, sg)
+			scope = append(scope, sg)
+
+		case *EndStmt:
+			if len(scope) > 0 {
+				scope = scope[:len(scope)-1]
 			}
-			diagram.Edges = append(diagram.Edges, edge)
-			continue
-		}
 
-		// Check nodes
-		if matches := nodeRe.FindStringSubmatch(line); matches != nil {
-			shape := "rectangle"
-			switch matches[2] {
-			case "[(":
-				shape = "cylinder"
-			case "([":
-				shape = "stadium"
-			case "[[":
-				shape = "double_rectangle"
-			case "{":
-				shape = "diamond"
-			case "((":
-				shape = "circle"
-			case "(":
-				shape = "rounded"
+		case *ClassDefStmt:
+			for _, name := range s.Names {
+				diagram.ClassDefs[name] = s.Styles
 			}
 
-			node := &Node{
-				ID:    matches[1],
-				Label: matches[3],
-				Shape: shape,
-				Line:  lineNum + 1,
+		case *ClassStmt:
+			for _, node := range s.Nodes {
+				applyClass(node, s.Class, false, s.Start)
 			}
 
-			if currentSubgraph != nil {
-				node.Subgraph = currentSubgraph.ID
-				currentSubgraph.Nodes = append(currentSubgraph.Nodes, node.ID)
+		case *StyleStmt:
+			loc := diagram.Locate(s.Span)
+			diagram.Styles = append(diagram.Styles, &InlineStyle{Target: s.Node, Styles: s.Styles, Line: loc.Line, Loc: loc})
+
+		case *LinkStyleStmt:
+			loc := diagram.Locate(s.Span)
+			diagram.LinkStyles = append(diagram.LinkStyles, &InlineStyle{Target: s.Links, Styles: s.Styles, Line: loc.Line, Loc: loc})
+
+		case *BadStmt:
+			if opts.Strict {
+				diagram.Diagnostics = append(diagram.Diagnostics, Diagnostic{
+					Loc:     diagram.Locate(s.Span),
+					Message: "unrecognized statement",
+					Text:    s.Text,
+				})
 			}
 
-			diagram.Nodes[node.ID] = node
+		case *ChainStmt:
+			for _, group := range s.Groups {
+				for _, ref := range group {
+					addNode(ref)
+					if ref.Class != "" {
+						applyClass(ref.ID, ref.Class, true, ref.Start)
+					}
+				}
+			}
+			for i, link := range s.Links {
+				for _, from := range s.Groups[i] {
+					for _, to := range s.Groups[i+1] {
+						loc := diagram.Locate(Span{Start: from.Start, End: to.End})
+						diagram.Edges = append(diagram.Edges, &Edge{
+							From:      from.ID,
+							To:        to.ID,
+							Label:     normalizeLabel(link.Label),
+							RawLabel:  sourceText(code, link.LabelSpan),
+							LabelSpan: link.LabelSpan,
+							ArrowType: link.Arrow,
+							RawArrow:  link.Raw,
+							ArrowSpan: Span{Start: link.Start, End: advancePos(link.Start, link.Raw)},
+							Inline:    link.Inline,
+							Line:      loc.Line,
+							Loc:       loc,
+							LinkType:  link.Type,
+						})
+					}
+				}
+			}
 		}
 	}
 
@@ -179,27 +339,227 @@ func ParseMermaid(code string) (*Diagram, error) {
 		}
 	}
 
+	// Apply inline styles. linkStyle indices count links in source order,
+	// which is the order of diagram.Edges.
+	for _, style := range diagram.Styles {
+		if node, ok := diagram.Nodes[style.Target]; ok {
+			node.Style = joinStyles(node.Style, style.Styles)
+		}
+	}
+	for _, style := range diagram.LinkStyles {
+		for _, index := range splitList(style.Target) {
+			if index == "default" {
+				for _, edge := range diagram.Edges {
+					if edge.Style == "" {
+						edge.Style = style.Styles
+					}
+				}
+				continue
+			}
+			if i, err := strconv.Atoi(index); err == nil && i >= 0 && i < len(diagram.Edges) {
+				diagram.Edges[i].Style = joinStyles(diagram.Edges[i].Style, style.Styles)
+			}
+		}
+	}
+
 	return diagram, nil
 }
 
+// joinStyles appends style properties to an existing style string
+func joinStyles(existing, styles string) string {
+	if existing == "" {
+		return styles
+	}
+	return existing + "," + styles
+}
+
+// ParseStyleProps splits a style string such as
+// "fill:#a5d8ff,stroke:#339af0,color:#1864ab" into properties. Keys are
+// lowercased and hex colors are normalized to lowercase #rrggbb.
+func ParseStyleProps(styles string) map[string]string {
+	props := make(map[string]string)
+	for _, prop := range strings.Split(styles, ",") {
+		key, value, ok := strings.Cut(prop, ":")
+		if !ok {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		props[key] = NormalizeColor(strings.TrimSpace(value))
+	}
+	return props
+}
+
+// NormalizeColor lowercases a hex color and expands #rgb to #rrggbb.
+// Other values are returned unchanged.
+func NormalizeColor(value string) string {
+	if !strings.HasPrefix(value, "#") {
+		return value
+	}
+	value = strings.ToLower(value)
+	if len(value) == 4 {
+		value = "#" + strings.Repeat(value[1:2], 2) + strings.Repeat(value[2:3], 2) + strings.Repeat(value[3:4], 2)
+	}
+	return value
+}
+
+// lineBreakRe matches the line break markup mermaid renders inside labels
+var lineBreakRe = regexp.MustCompile(`(?i)<br\s*/?>|\\n`)
+
+// normalizeLabel turns <br> tags and \n escapes into real newlines so that
+// every kind of line break in a label looks the same to the linter
+func normalizeLabel(label string) string {
+	return lineBreakRe.ReplaceAllString(label, "\n")
+}
+
+// sourceText returns the code covered by span
+func sourceText(code string, span Span) string {
+	if span.End.Offset <= span.Start.Offset || span.End.Offset > len(code) {
+		return ""
+	}
+	return code[span.Start.Offset:span.End.Offset]
+}
+
 // HasNodeWithLabel checks if a node with the given label exists
 func (d *Diagram) HasNodeWithLabel(label string) bool {
 	label = strings.ToLower(label)
 	for _, node := range d.Nodes {
-		if strings.ToLower(node.Label) == label {
+		// Compare multi-line labels as if they were written on one line
+		nodeLabel := strings.ToLower(strings.Join(strings.Fields(node.Label), " "))
+		if nodeLabel == label {
 			return true
 		}
 		// Also check if label is contained (for partial matches)
-		if strings.Contains(strings.ToLower(node.Label), label) {
+		if strings.Contains(nodeLabel, label) {
 			return true
 		}
 	}
 	return false
 }
 
+// FindSubgraph returns the subgraph with the given ID, or nil
+func (d *Diagram) FindSubgraph(id string) *Subgraph {
+	for _, sg := range d.Subgraphs {
+		if sg.ID == id {
+			return sg
+		}
+	}
+	return nil
+}
+
+// Ancestors returns the IDs of the subgraphs enclosing the given subgraph,
+// innermost first. The subgraph itself is not included.
+func (d *Diagram) Ancestors(subgraphID string) []string {
+	ancestors := []string{}
+	seen := map[string]bool{subgraphID: true}
+	sg := d.FindSubgraph(subgraphID)
+	for sg != nil && sg.Parent != "" && !seen[sg.Parent] {
+		seen[sg.Parent] = true
+		ancestors = append(ancestors, sg.Parent)
+		sg = d.FindSubgraph(sg.Parent)
+	}
+	return ancestors
+}
+
+// Subdiagram returns the part of the diagram inside a subgraph: its
+// nodes, nested subgraphs and the edges between them, with the
+// subgraph's direct members at the top level. It returns nil if there is
+// no such subgraph.
+func (d *Diagram) Subdiagram(subgraphID string) *Diagram {
+	root := d.FindSubgraph(subgraphID)
+	if root == nil {
+		return nil
+	}
+
+	sub := &Diagram{
+		Direction: d.Direction,
+		Nodes:     make(map[string]*Node),
+		ClassDefs: d.ClassDefs,
+		Classes:   d.Classes,
+		Styles:    d.Styles,
+		File:      d.File,
+		Origin:    d.Origin,
+		dedents:   d.dedents,
+	}
+	if root.Direction != "" {
+		sub.Direction = root.Direction
+	}
+
+	// within reports whether a subgraph is nested in the root
+	within := func(id string) bool {
+		for _, ancestor := range d.Ancestors(id) {
+			if ancestor == subgraphID {
+				return true
+			}
+		}
+		return false
+	}
+	for _, sg := range d.Subgraphs {
+		if !within(sg.ID) {
+			continue
+		}
+		copied := *sg
+		if copied.Parent == subgraphID {
+			copied.Parent = ""
+		}
+		sub.Subgraphs = append(sub.Subgraphs, &copied)
+	}
+	for id, node := range d.Nodes {
+		if node.Subgraph != subgraphID && !within(node.Subgraph) {
+			continue
+		}
+		copied := *node
+		if copied.Subgraph == subgraphID {
+			copied.Subgraph = ""
+		}
+		sub.Nodes[id] = &copied
+	}
+
+	contains := func(id string) bool {
+		return sub.Nodes[id] != nil || sub.FindSubgraph(id) != nil
+	}
+	for _, e := range d.Edges {
+		if contains(e.From) && contains(e.To) {
+			sub.Edges = append(sub.Edges, e)
+		}
+	}
+	return sub
+}
+
+// openSubgraph returns the subgraph with the given ID among the open
+// ones, or nil
+func openSubgraph(scope []*Subgraph, id string) *Subgraph {
+	for _, sg := range scope {
+		if sg.ID == id {
+			return sg
+		}
+	}
+	return nil
+}
+
+// SortedNodes returns the nodes in source order: by the position of
+// their first definition, or first reference if never defined
+func (d *Diagram) SortedNodes() []*Node {
+	nodes := make([]*Node, 0, len(d.Nodes))
+	for _, node := range d.Nodes {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		a, b := nodes[i].Loc, nodes[j].Loc
+		if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		if a.Col != b.Col {
+			return a.Col < b.Col
+		}
+		return nodes[i].ID < nodes[j].ID
+	})
+	return nodes
+}
+
 // GetOrphanNodes returns nodes with no incoming or outgoing edges.
-// A node inside a subgraph is NOT an orphan if its parent subgraph
-// has an incoming or outgoing edge (arrow to subgroup covers all nodes inside).
+// A node inside a subgraph is NOT an orphan if its subgraph, or any
+// subgraph enclosing it, has an incoming or outgoing edge (arrow to
+// subgroup covers all nodes inside, including nested subgroups).
 func (d *Diagram) GetOrphanNodes() []*Node {
 	connected := make(map[string]bool)
 	for _, edge := range d.Edges {
@@ -207,20 +567,29 @@ func (d *Diagram) GetOrphanNodes() []*Node {
 		connected[edge.To] = true
 	}
 
-	// Mark all nodes inside a connected subgraph as connected
-	for _, sg := range d.Subgraphs {
-		if connected[sg.ID] {
-			for _, nodeID := range sg.Nodes {
-				connected[nodeID] = true
-			}
-		}
-	}
-
 	orphans := []*Node{}
 	for id, node := range d.Nodes {
-		if !connected[id] {
-			orphans = append(orphans, node)
+		if connected[id] || d.subgraphConnected(node.Subgraph, connected) {
+			continue
 		}
+		orphans = append(orphans, node)
 	}
 	return orphans
 }
+
+// subgraphConnected reports whether the subgraph or any of its ancestors
+// is an edge endpoint
+func (d *Diagram) subgraphConnected(subgraphID string, connected map[string]bool) bool {
+	if subgraphID == "" {
+		return false
+	}
+	if connected[subgraphID] {
+		return true
+	}
+	for _, id := range d.Ancestors(subgraphID) {
+		if connected[id] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,456 @@
+package parser
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// mustParse parses mermaid code, failing the test on error
+func mustParse(t *testing.T, code string) *Diagram {
+	t.Helper()
+	d, err := ParseMermaid(code)
+	if err != nil {
+		t.Fatalf("ParseMermaid: %v", err)
+	}
+	return d
+}
+
+// edgeList writes each edge as "From Arrow To"
+func edgeList(d *Diagram) []string {
+	edges := []string{}
+	for _, e := range d.Edges {
+		edges = append(edges, e.From+" "+e.ArrowType+" "+e.To)
+	}
+	return edges
+}
+
+func TestParseMermaidGraph(t *testing.T) {
+	tests := []struct {
+		name  string
+		code  string
+		nodes []string
+		edges []string
+	}{
+		{
+			name:  "chain",
+			code:  "flowchart TD\n    A ==> B ==> C",
+			nodes: []string{"A", "B", "C"},
+			edges: []string{"A ==> B", "B ==> C"},
+		},
+		{
+			name:  "fan-out and fan-in",
+			code:  "flowchart TD\n    A & B --> C & D",
+			nodes: []string{"A", "B", "C", "D"},
+			edges: []string{"A --> C", "A --> D", "B --> C", "B --> D"},
+		},
+		{
+			name:  "inline definitions",
+			code:  "flowchart TD\n    S1_step1[Validate Request] --> S1_step2[Load Account]",
+			nodes: []string{"S1_step1", "S1_step2"},
+			edges: []string{"S1_step1 --> S1_step2"},
+		},
+		{
+			name:  "subgraph endpoints are not nodes",
+			code:  "flowchart TD\n    subgraph deps\n        D1\n    end\n    A --> deps",
+			nodes: []string{"A", "D1"},
+			edges: []string{"A --> deps"},
+		},
+		{
+			name:  "unrecognized statements are skipped",
+			code:  "flowchart TD\n    A --> B\n    A =>> C",
+			nodes: []string{"A", "B"},
+			edges: []string{"A --> B"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := mustParse(t, tt.code)
+			nodes := []string{}
+			for id := range d.Nodes {
+				nodes = append(nodes, id)
+			}
+			sort.Strings(nodes)
+			if !reflect.DeepEqual(nodes, tt.nodes) {
+				t.Errorf("nodes = %v, want %v", nodes, tt.nodes)
+			}
+			if got := edgeList(d); !reflect.DeepEqual(got, tt.edges) {
+				t.Errorf("edges = %v, want %v", got, tt.edges)
+			}
+		})
+	}
+}
+
+func TestParseMermaidNodes(t *testing.T) {
+	d := mustParse(t, `flowchart LR
+    A[Payment Service] --> B[(Orders DB)]
+    B --> C
+    subgraph ext ["External"]
+        C[[Stripe]]
+    end`)
+
+	if d.Direction != "LR" {
+		t.Errorf("direction = %q, want LR", d.Direction)
+	}
+	tests := []struct {
+		id, label, shape, subgraph string
+		line                       int
+	}{
+		{"A", "Payment Service", "rectangle", "", 2},
+		{"B", "Orders DB", "cylinder", "", 2},
+		{"C", "Stripe", "double_rectangle", "ext", 5},
+	}
+	for _, tt := range tests {
+		node := d.Nodes[tt.id]
+		if node == nil {
+			t.Errorf("node %s missing", tt.id)
+			continue
+		}
+		if node.Label != tt.label || node.Shape != tt.shape || node.Subgraph != tt.subgraph || node.Line != tt.line {
+			t.Errorf("node %s = %q %s in %q line %d, want %q %s in %q line %d", tt.id,
+				node.Label, node.Shape, node.Subgraph, node.Line, tt.label, tt.shape, tt.subgraph, tt.line)
+		}
+	}
+	if len(d.Subgraphs) != 1 {
+		t.Fatalf("got %d subgraphs, want 1", len(d.Subgraphs))
+	}
+	if d.Subgraphs[0].Title != "External" || !reflect.DeepEqual(d.Subgraphs[0].Nodes, []string{"C"}) {
+		t.Errorf("subgraphs = %+v", d.Subgraphs[0])
+	}
+}
+
+func TestNestedSubgraphs(t *testing.T) {
+	d := mustParse(t, `flowchart TD
+    subgraph system ["Payments"]
+        subgraph svc ["Payment Service"]
+            subgraph steps
+                S1
+            end
+            S2
+        end
+        S3
+    end
+    S4`)
+
+	tests := []struct {
+		id, parent string
+		children   []string
+		nodes      []string
+	}{
+		{"system", "", []string{"svc"}, []string{"S3"}},
+		{"svc", "system", []string{"steps"}, []string{"S2"}},
+		{"steps", "svc", []string{}, []string{"S1"}},
+	}
+	for _, tt := range tests {
+		sg := d.FindSubgraph(tt.id)
+		if sg == nil {
+			t.Errorf("subgraph %s missing", tt.id)
+			continue
+		}
+		if sg.Parent != tt.parent || !reflect.DeepEqual(sg.Children, tt.children) || !reflect.DeepEqual(sg.Nodes, tt.nodes) {
+			t.Errorf("subgraph %s: parent %q, children %v, nodes %v; want %q, %v, %v",
+				tt.id, sg.Parent, sg.Children, sg.Nodes, tt.parent, tt.children, tt.nodes)
+		}
+	}
+
+	members := map[string]string{"S1": "steps", "S2": "svc", "S3": "system", "S4": ""}
+	for id, want := range members {
+		if got := d.Nodes[id].Subgraph; got != want {
+			t.Errorf("node %s is in %q, want %q", id, got, want)
+		}
+	}
+
+	if got := d.Ancestors("steps"); !reflect.DeepEqual(got, []string{"svc", "system"}) {
+		t.Errorf("Ancestors(steps) = %v, want [svc system]", got)
+	}
+	if got := d.Ancestors("system"); len(got) != 0 {
+		t.Errorf("Ancestors(system) = %v, want none", got)
+	}
+}
+
+func TestSubgraphReopenedInsideItself(t *testing.T) {
+	code := "flowchart TD\n    subgraph A\n    subgraph A\n    X-->Y\n    end\n    end\n    Z"
+	d, err := parseMermaid(code, "", Pos{Line: 1, Col: 1}, nil, ParseOptions{Strict: true})
+	if err != nil {
+		t.Fatalf("parseMermaid: %v", err)
+	}
+
+	if len(d.Subgraphs) != 1 {
+		t.Fatalf("got %d subgraphs, want 1", len(d.Subgraphs))
+	}
+	sg := d.Subgraphs[0]
+	if sg.Parent != "" || len(sg.Children) != 0 || !reflect.DeepEqual(sg.Nodes, []string{"X", "Y"}) {
+		t.Errorf("subgraph A: parent %q, children %v, nodes %v; want no parent or children and nodes [X Y]", sg.Parent, sg.Children, sg.Nodes)
+	}
+	if got := d.Nodes["Z"].Subgraph; got != "" {
+		t.Errorf("Z is in %q: the second end must close A", got)
+	}
+	want := []string{"3:5: subgraph 'A' is already open: subgraph A"}
+	got := []string{}
+	for _, diag := range d.Diagnostics {
+		got = append(got, diag.String())
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("diagnostics = %q, want %q", got, want)
+	}
+}
+
+func TestGetOrphanNodes(t *testing.T) {
+	tests := []struct {
+		name string
+		code string
+		want []string
+	}{
+		{
+			name: "edge to the node",
+			code: "flowchart TD\n    A --> B\n    C",
+			want: []string{"C"},
+		},
+		{
+			name: "edge to the enclosing subgraph",
+			code: "flowchart TD\n    subgraph deps\n        D1\n    end\n    A --> deps",
+			want: []string{},
+		},
+		{
+			name: "edge to an ancestor subgraph",
+			code: "flowchart TD\n    subgraph system\n        subgraph svc\n            S1\n        end\n    end\n    A --> system",
+			want: []string{},
+		},
+		{
+			name: "edge to a sibling subgraph",
+			code: "flowchart TD\n    subgraph a\n        A1\n    end\n    subgraph b\n        B1\n    end\n    X --> a",
+			want: []string{"B1"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{}
+			for _, node := range mustParse(t, tt.code).GetOrphanNodes() {
+				got = append(got, node.ID)
+			}
+			sort.Strings(got)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("orphans = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSortedNodes(t *testing.T) {
+	tests := []struct {
+		name string
+		code string
+		want []string
+	}{
+		{"by line", "flowchart TD\n    Z[Last]\n    A[First]", []string{"Z", "A"}},
+		{"by column on one line", "flowchart TD\n    Z --> B --> A", []string{"Z", "B", "A"}},
+		{"definition before reference", "flowchart TD\n    B --> A\n    subgraph s\n        A[Defined]\n    end", []string{"B", "A"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{}
+			for _, node := range mustParse(t, tt.code).SortedNodes() {
+				got = append(got, node.ID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("nodes = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMultiLineLabels(t *testing.T) {
+	tests := []struct {
+		name  string
+		code  string
+		label string
+		raw   string
+	}{
+		{"single line", "A[Payment Service]", "Payment Service", "Payment Service"},
+		{"real newline", "A[Payment\nService]", "Payment\nService", "Payment\nService"},
+		{"br tag", "A[Payment<br>Service]", "Payment\nService", "Payment<br>Service"},
+		{"self-closing br", "A[Payment<br/>Service]", "Payment\nService", "Payment<br/>Service"},
+		{"escaped newline", `A[Payment\nService]`, "Payment\nService", `Payment\nService`},
+		{"quoted", "A[\"Payment\nService\"]", "Payment\nService", "\"Payment\nService\""},
+		{"cylinder", "A[(Orders\nDB)]", "Orders\nDB", "Orders\nDB"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code := "flowchart TD\n    " + tt.code + " --> B"
+			d := mustParse(t, code)
+			node := d.Nodes["A"]
+			if node.Label != tt.label {
+				t.Errorf("label = %q, want %q", node.Label, tt.label)
+			}
+			if node.RawLabel != tt.raw {
+				t.Errorf("raw label = %q, want %q", node.RawLabel, tt.raw)
+			}
+			if got := code[node.LabelSpan.Start.Offset:node.LabelSpan.End.Offset]; got != tt.raw {
+				t.Errorf("label span covers %q, want %q", got, tt.raw)
+			}
+			if len(d.Edges) != 1 {
+				t.Errorf("got %d edges, want 1", len(d.Edges))
+			}
+		})
+	}
+}
+
+func TestMultiLineEdgeAndSubgraphLabels(t *testing.T) {
+	code := "flowchart TD\n    subgraph deps [\"Core\nDependencies\"]\n        A -->|gRPC:\nGetBalance| B\n    end\n    C"
+	d := mustParse(t, code)
+
+	edge := d.Edges[0]
+	if edge.Label != "gRPC:\nGetBalance" || edge.RawLabel != "gRPC:\nGetBalance" {
+		t.Errorf("edge label = %q, raw %q", edge.Label, edge.RawLabel)
+	}
+	sg := d.FindSubgraph("deps")
+	if sg.Title != "Core\nDependencies" || sg.RawTitle != "\"Core\nDependencies\"" {
+		t.Errorf("subgraph title = %q, raw %q", sg.Title, sg.RawTitle)
+	}
+	if got := d.Nodes["C"]; got == nil || got.Subgraph != "" || got.Line != 7 {
+		t.Errorf("node after the multi-line labels = %+v", got)
+	}
+}
+
+func TestNodeDefinitions(t *testing.T) {
+	d := mustParse(t, `flowchart TD
+    D1[Orders DB] --> D2
+    subgraph billing
+        D1[(Billing DB)]
+    end
+    D1 --> D2`)
+
+	defs := d.Nodes["D1"].Definitions
+	want := []NodeDef{
+		{Label: "Orders DB", Shape: "rectangle", Subgraph: "", Line: 2},
+		{Label: "Billing DB", Shape: "cylinder", Subgraph: "billing", Line: 4},
+	}
+	if len(defs) != len(want) {
+		t.Fatalf("got %d definitions, want %d", len(defs), len(want))
+	}
+	for i := range want {
+		defs[i].Span = Span{}
+		if defs[i] != want[i] {
+			t.Errorf("definition %d = %+v, want %+v", i, defs[i], want[i])
+		}
+	}
+
+	// Mermaid keeps the last definition; the location stays at the first
+	node := d.Nodes["D1"]
+	if node.Label != "Billing DB" || node.Shape != "cylinder" || node.Line != 2 {
+		t.Errorf("D1 = %q %s line %d, want the last definition at line 2", node.Label, node.Shape, node.Line)
+	}
+	if got := d.Nodes["D2"].Definitions; len(got) != 0 {
+		t.Errorf("D2 is only referenced, got definitions %+v", got)
+	}
+}
+
+func TestClassApplications(t *testing.T) {
+	d := mustParse(t, `flowchart TD
+    A[Payment]:::service --> B:::database
+    class A,C entry
+    C`)
+
+	want := []ClassApplication{
+		{Node: "A", Class: "service", Shorthand: true, Line: 2},
+		{Node: "B", Class: "database", Shorthand: true, Line: 2},
+		{Node: "A", Class: "entry", Line: 3},
+		{Node: "C", Class: "entry", Line: 3},
+	}
+	if !reflect.DeepEqual(d.ClassApplications, want) {
+		t.Errorf("class applications = %+v, want %+v", d.ClassApplications, want)
+	}
+	classes := map[string][]string{"A": {"service", "entry"}, "B": {"database"}, "C": {"entry"}}
+	for id, want := range classes {
+		if got := d.Nodes[id].Classes; !reflect.DeepEqual(got, want) {
+			t.Errorf("node %s classes = %v, want %v", id, got, want)
+		}
+	}
+}
+
+func TestInlineStyles(t *testing.T) {
+	d := mustParse(t, `flowchart TD
+    A --> B
+    B --> C
+    C --> D
+    style A fill:#fff
+    style A stroke:#000
+    linkStyle 0,2 stroke:#f00
+    linkStyle default stroke:#999
+    linkStyle 7 stroke:#0f0`)
+
+	if got := d.Nodes["A"].Style; got != "fill:#fff,stroke:#000" {
+		t.Errorf("A style = %q", got)
+	}
+	if len(d.Styles) != 2 || d.Styles[0].Target != "A" || d.Styles[0].Line != 5 {
+		t.Errorf("styles = %+v", d.Styles)
+	}
+	if len(d.LinkStyles) != 3 || d.LinkStyles[0].Target != "0,2" || d.LinkStyles[0].Line != 7 {
+		t.Errorf("link styles = %+v", d.LinkStyles)
+	}
+	edgeStyles := []string{"stroke:#f00", "stroke:#999", "stroke:#f00"}
+	for i, want := range edgeStyles {
+		if got := d.Edges[i].Style; got != want {
+			t.Errorf("edge %d style = %q, want %q", i, got, want)
+		}
+	}
+}
+
+func TestParseStyleProps(t *testing.T) {
+	got := ParseStyleProps("fill:#A5D8FF, Stroke: #39f ,stroke-width:2px,bogus")
+	want := map[string]string{"fill": "#a5d8ff", "stroke": "#3399ff", "stroke-width": "2px"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseStyleProps = %v, want %v", got, want)
+	}
+}
+
+func TestStrictDiagnostics(t *testing.T) {
+	code := "flowchart TD\n    A --> B\n    D1 =>> D2\n    subgrap deps\n    B --> C"
+
+	lenient := mustParse(t, code)
+	if len(lenient.Diagnostics) != 0 {
+		t.Errorf("lenient parse reported %v", lenient.Diagnostics)
+	}
+
+	content := "# Diagram\n\n```mermaid\n" + code + "\n```\n"
+	blocks := ExtractMermaidBlocks(content)
+	d, err := ParseMermaidBlock("doc.md", blocks[0], ParseOptions{Strict: true})
+	if err != nil {
+		t.Fatalf("ParseMermaidBlock: %v", err)
+	}
+	want := []string{
+		"doc.md:6:5: unrecognized statement: D1 =>> D2",
+		"doc.md:7:5: unrecognized statement: subgrap deps",
+	}
+	got := []string{}
+	for _, diag := range d.Diagnostics {
+		got = append(got, diag.String())
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("diagnostics = %q, want %q", got, want)
+	}
+	if len(d.Edges) != 2 {
+		t.Errorf("strict mode still builds the rest of the diagram: got %d edges, want 2", len(d.Edges))
+	}
+}
+
+func TestNodeDefinitionSyntax(t *testing.T) {
+	d := mustParse(t, "flowchart TD\n    A[(Orders)] --> B@{ shape: cyl }\n    A --> C[Ledger]\n    C@{ label: \"Ledger\" }")
+	tests := []struct {
+		id   string
+		want []bool
+	}{
+		{"A", []bool{false}},
+		{"B", []bool{true}},
+		{"C", []bool{false, true}},
+	}
+	for _, tt := range tests {
+		got := []bool{}
+		for _, def := range d.Nodes[tt.id].Definitions {
+			got = append(got, def.Attributes)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s definitions use attributes %v, want %v", tt.id, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,552 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// indent is one level of indentation in formatted output
+const indent = "    "
+
+// printItem is one output statement with the comments attached to it
+type printItem struct {
+	leading  []string // %% comment lines written before the statement
+	text     string
+	trailing string // %% comment on the statement's own line
+}
+
+// printChain is an edge statement and the index of its first edge in
+// source order, as counted by linkStyle
+type printChain struct {
+	item  *printItem
+	stmt  *ChainStmt
+	index int
+}
+
+// edgeCount returns the number of edges a chain creates: A & B --> C is two
+func (c *printChain) edgeCount() int {
+	n := 0
+	for i := range c.stmt.Links {
+		n += len(c.stmt.Groups[i]) * len(c.stmt.Groups[i+1])
+	}
+	return n
+}
+
+// printer renders a diagram in canonical form. The sections are, in
+// order: the header, classDef statements, subgraphs, top-level node
+// declarations, top-level edges, class assignments grouped by class, and
+// style, linkStyle and click statements. A subgraph holds its direction,
+// the declarations of its member nodes, its child subgraphs and the edges
+// written inside it.
+//
+// A node's shape is printed once: on its declaration line if it was
+// declared on a line of its own, or else in the first edge of its own
+// subgraph that mentions it. Comments travel with
+// the statement that follows them, or with the statement they trail on
+// the same line.
+type printer struct {
+	d    *Diagram
+	code string
+
+	header    []*printItem
+	classDefs []*printItem
+	styles    []*printItem
+	classNote *printItem // comments around class statements
+	trailer   []string   // comments after the last statement
+
+	edges     map[string][]*printChain // edges by enclosing subgraph, "" at top level
+	sgOpen    map[string]*printItem    // subgraph lines by ID
+	sgEnd     map[string]*printItem    // end lines by subgraph ID
+	titleOnly map[string]bool          // subgraph "Title" form
+	nodeNote  map[string]*printItem    // node declarations with their comments
+	shapes    map[string]*ShapeSpec    // last shape written for each node
+	order     []string                 // node IDs in order of first appearance
+
+	inline  map[string]*NodeRef // reference that carries each node's shape
+	remap   map[int]int         // source edge index to printed edge index
+	printed map[string]bool     // subgraphs already printed
+}
+
+// Format prints the diagram as canonical mermaid: ordered sections,
+// four-space indentation, one space around links, link labels in pipe
+// form and comments kept with their statements. The output is
+// deterministic, and formatting it again changes nothing. Diagrams with
+// unrecognized statements, or a subgraph nested inside itself, are not
+// formatted, since their meaning is unknown.
+func Format(d *Diagram) (string, error) {
+	p := &printer{
+		d:         d,
+		code:      strings.Join(d.RawLines, "\n"),
+		classNote: &printItem{},
+		edges:     make(map[string][]*printChain),
+		sgOpen:    make(map[string]*printItem),
+		sgEnd:     make(map[string]*printItem),
+		titleOnly: make(map[string]bool),
+		nodeNote:  make(map[string]*printItem),
+		shapes:    make(map[string]*ShapeSpec),
+		inline:    make(map[string]*NodeRef),
+		remap:     make(map[int]int),
+		printed:   make(map[string]bool),
+	}
+	if err := p.collect(); err != nil {
+		return "", err
+	}
+	p.placeShapes()
+	p.renumberEdges()
+	return p.print(), nil
+}
+
+// collect sorts the diagram's statements into sections
+func (p *printer) collect() error {
+	var pending []string
+	var last *printItem
+	var lastLine int
+	var scope []string
+	seen := make(map[string]bool)
+	edgeIndex := 0
+
+	// attach gives an item the comments before it and makes it the target
+	// of a trailing comment
+	attach := func(item *printItem, span Span) {
+		item.leading = append(item.leading, pending...)
+		pending = nil
+		last = item
+		lastLine = span.End.Line
+	}
+
+	for _, stmt := range p.d.AST.Statements {
+		switch s := stmt.(type) {
+		case *CommentStmt:
+			comment := strings.TrimSpace("%% " + s.Text)
+			if last != nil && s.Start.Line == lastLine && last.trailing == "" {
+				last.trailing = comment
+				continue
+			}
+			pending = append(pending, comment)
+
+		case *BadStmt:
+			return fmt.Errorf("%s: cannot format unrecognized statement: %s", p.d.Locate(s.Span), s.Text)
+
+		case *HeaderStmt:
+			item := &printItem{text: strings.TrimSpace("flowchart " + s.Direction)}
+			p.header = append(p.header, item)
+			attach(item, s.Span)
+
+		case *DirectionStmt:
+			// Subgraph directions are printed from the model
+			item := &printItem{text: "direction " + s.Direction}
+			if len(scope) == 0 {
+				p.header = append(p.header, item)
+			}
+			attach(item, s.Span)
+
+		case *SubgraphStmt:
+			for _, id := range scope {
+				if id == s.ID {
+					return fmt.Errorf("%s: cannot format subgraph '%s' nested inside itself", p.d.Locate(s.Span), s.ID)
+				}
+			}
+			item := &printItem{}
+			p.sgOpen[s.ID] = item
+			rest := strings.TrimSpace(strings.TrimPrefix(sourceText(p.code, s.Span), "subgraph"))
+			p.titleOnly[s.ID] = strings.HasPrefix(rest, `"`)
+			attach(item, s.Span)
+			scope = append(scope, s.ID)
+
+		case *EndStmt:
+			item := &printItem{}
+			if len(scope) > 0 {
+				p.sgEnd[scope[len(scope)-1]] = item
+				scope = scope[:len(scope)-1]
+			}
+			attach(item, s.Span)
+
+		case *ClassDefStmt:
+			item := &printItem{text: "classDef " + strings.Join(s.Names, ",") + " " + s.Styles}
+			p.classDefs = append(p.classDefs, item)
+			attach(item, s.Span)
+
+		case *ClassStmt:
+			// Class statements are printed from the model, grouped by
+			// class, so their comments are gathered ahead of the section
+			attach(p.classNote, s.Span)
+
+		case *StyleStmt:
+			item := &printItem{text: "style " + s.Node + " " + s.Styles}
+			p.styles = append(p.styles, item)
+			attach(item, s.Span)
+
+		case *LinkStyleStmt:
+			item := &printItem{text: "linkStyle " + s.Links + " " + s.Styles}
+			p.styles = append(p.styles, item)
+			attach(item, s.Span)
+
+		case *ClickStmt:
+			item := &printItem{text: "click " + s.Node + " " + s.Args}
+			p.styles = append(p.styles, item)
+			attach(item, s.Span)
+
+		case *ChainStmt:
+			for _, group := range s.Groups {
+				for _, ref := range group {
+					if _, isNode := p.d.Nodes[ref.ID]; isNode && !seen[ref.ID] {
+						seen[ref.ID] = true
+						p.order = append(p.order, ref.ID)
+					}
+					if ref.Shape != nil {
+						p.shapes[ref.ID] = ref.Shape
+					}
+				}
+			}
+
+			if len(s.Links) == 0 {
+				// A declaration: its comments go with the first node
+				id := s.Groups[0][0].ID
+				item, ok := p.nodeNote[id]
+				if !ok {
+					item = &printItem{}
+					p.nodeNote[id] = item
+				}
+				attach(item, s.Span)
+				continue
+			}
+
+			home := ""
+			if len(scope) > 0 {
+				home = scope[len(scope)-1]
+			}
+			chain := &printChain{item: &printItem{}, stmt: s, index: edgeIndex}
+			edgeIndex += chain.edgeCount()
+			p.edges[home] = append(p.edges[home], chain)
+			attach(chain.item, s.Span)
+		}
+	}
+
+	p.trailer = pending
+	return nil
+}
+
+// placeShapes picks the reference that carries the shape of each node
+// without a declaration statement: its first mention in an edge written
+// inside the node's own subgraph
+func (p *printer) placeShapes() {
+	for home, chains := range p.edges {
+		for _, chain := range chains {
+			for _, group := range chain.stmt.Groups {
+				for _, ref := range group {
+					node, ok := p.d.Nodes[ref.ID]
+					if !ok || node.Subgraph != home || p.inline[ref.ID] != nil || p.nodeNote[ref.ID] != nil {
+						continue
+					}
+					if _, shaped := p.shapes[ref.ID]; shaped {
+						p.inline[ref.ID] = ref
+					}
+				}
+			}
+		}
+	}
+}
+
+// renumberEdges maps source edge indices to their printed positions, so
+// linkStyle statements keep styling the same edges
+func (p *printer) renumberEdges() {
+	next := 0
+	visited := make(map[string]bool)
+	var visit func(sg *Subgraph)
+	number := func(chains []*printChain) {
+		for _, chain := range chains {
+			for i := 0; i < chain.edgeCount(); i++ {
+				p.remap[chain.index+i] = next
+				next++
+			}
+		}
+	}
+	visit = func(sg *Subgraph) {
+		if visited[sg.ID] {
+			return
+		}
+		visited[sg.ID] = true
+		for _, child := range p.children(sg) {
+			visit(child)
+		}
+		number(p.edges[sg.ID])
+	}
+	for _, sg := range p.topSubgraphs() {
+		visit(sg)
+	}
+	number(p.edges[""])
+}
+
+// topSubgraphs returns the subgraphs not nested in another, in source order
+func (p *printer) topSubgraphs() []*Subgraph {
+	top := []*Subgraph{}
+	for _, sg := range p.d.Subgraphs {
+		if sg.Parent == "" {
+			top = append(top, sg)
+		}
+	}
+	return top
+}
+
+// children returns the subgraphs nested directly in sg
+func (p *printer) children(sg *Subgraph) []*Subgraph {
+	children := []*Subgraph{}
+	for _, id := range sg.Children {
+		if child := p.d.FindSubgraph(id); child != nil {
+			children = append(children, child)
+		}
+	}
+	return children
+}
+
+func (p *printer) print() string {
+	var sections [][]string
+	add := func(lines []string) {
+		if len(lines) > 0 {
+			sections = append(sections, lines)
+		}
+	}
+
+	// classDefs follow the header without a blank line
+	head := p.items(p.header, 0)
+	head = append(head, p.items(p.classDefs, 1)...)
+	add(head)
+
+	for _, sg := range p.topSubgraphs() {
+		add(p.subgraph(sg, 1))
+	}
+
+	add(p.declarations("", 1))
+	add(p.chains(p.edges[""], 1))
+	add(p.classLines())
+	add(p.styleLines())
+
+	trailer := []string{}
+	for _, c := range p.trailer {
+		trailer = append(trailer, indent+c)
+	}
+	add(trailer)
+
+	out := []string{}
+	for i, lines := range sections {
+		if i > 0 {
+			out = append(out, "")
+		}
+		out = append(out, lines...)
+	}
+	return strings.Join(out, "\n")
+}
+
+// subgraph prints a subgraph: direction, member declarations, child
+// subgraphs and the edges written inside it. A subgraph is printed once,
+// however often it is listed as a child.
+func (p *printer) subgraph(sg *Subgraph, depth int) []string {
+	if p.printed[sg.ID] {
+		return nil
+	}
+	p.printed[sg.ID] = true
+	open := p.sgOpen[sg.ID]
+	if open == nil {
+		open = &printItem{}
+	}
+	open.text = p.subgraphLine(sg)
+	lines := p.item(open, depth)
+
+	if sg.Direction != "" {
+		lines = append(lines, strings.Repeat(indent, depth+1)+"direction "+sg.Direction)
+	}
+	lines = append(lines, p.declarations(sg.ID, depth+1)...)
+	for _, child := range p.children(sg) {
+		lines = append(lines, p.subgraph(child, depth+1)...)
+	}
+	lines = append(lines, p.chains(p.edges[sg.ID], depth+1)...)
+
+	end := p.sgEnd[sg.ID]
+	if end == nil {
+		end = &printItem{}
+	}
+	for _, c := range end.leading {
+		lines = append(lines, strings.Repeat(indent, depth+1)+c)
+	}
+	return append(lines, p.item(&printItem{text: "end", trailing: end.trailing}, depth)...)
+}
+
+// subgraphLine prints the opening line of a subgraph
+func (p *printer) subgraphLine(sg *Subgraph) string {
+	if sg.Title == "" {
+		return "subgraph " + sg.ID
+	}
+	title := strings.TrimSpace(sg.RawTitle)
+	if title == "" {
+		title = sg.Title
+	}
+	if p.titleOnly[sg.ID] {
+		return "subgraph " + title
+	}
+	return "subgraph " + sg.ID + " [" + title + "]"
+}
+
+// declarations prints the node declarations of a subgraph, or of the top
+// level for "". Nodes declared on a line of their own stay declared; other
+// nodes are declared only if no edge in their subgraph mentions them.
+func (p *printer) declarations(home string, depth int) []string {
+	lines := []string{}
+	for _, id := range p.order {
+		if p.d.Nodes[id].Subgraph != home {
+			continue
+		}
+		item := p.nodeNote[id]
+		if item == nil {
+			if p.inline[id] != nil || p.mentionedIn(home, id) {
+				continue
+			}
+			item = &printItem{}
+		}
+		item.text = id + p.shapeText(id)
+		lines = append(lines, p.item(item, depth)...)
+	}
+	return lines
+}
+
+// mentionedIn reports whether an edge written in the subgraph uses the node
+func (p *printer) mentionedIn(home, id string) bool {
+	for _, chain := range p.edges[home] {
+		for _, group := range chain.stmt.Groups {
+			for _, ref := range group {
+				if ref.ID == id {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// chains prints edge statements
+func (p *printer) chains(chains []*printChain, depth int) []string {
+	lines := []string{}
+	for _, chain := range chains {
+		chain.item.text = p.chain(chain.stmt)
+		lines = append(lines, p.item(chain.item, depth)...)
+	}
+	return lines
+}
+
+// chain prints an edge statement with one space around each link. Link
+// labels are written in pipe form.
+func (p *printer) chain(s *ChainStmt) string {
+	var b strings.Builder
+	for i, group := range s.Groups {
+		if i > 0 {
+			link := s.Links[i-1]
+			b.WriteString(" " + link.Arrow)
+			if link.Label != "" {
+				b.WriteString("|" + p.labelText(link.LabelSpan, link.Label) + "|")
+			}
+			b.WriteString(" ")
+		}
+		refs := make([]string, len(group))
+		for j, ref := range group {
+			refs[j] = ref.ID
+			if p.inline[ref.ID] == ref {
+				refs[j] += p.shapeText(ref.ID)
+			}
+		}
+		b.WriteString(strings.Join(refs, " & "))
+	}
+	return b.String()
+}
+
+// shapeText prints a node's last shape with its label as written
+func (p *printer) shapeText(id string) string {
+	shape, ok := p.shapes[id]
+	if !ok {
+		return ""
+	}
+	if shape.Open == "@{" {
+		return sourceText(p.code, shape.Span)
+	}
+	return shape.Open + p.labelText(shape.LabelSpan, shape.Label) + shape.Close
+}
+
+// labelText returns a label as written, falling back to its parsed text
+func (p *printer) labelText(span Span, label string) string {
+	if raw := strings.TrimSpace(sourceText(p.code, span)); raw != "" {
+		return raw
+	}
+	return label
+}
+
+// classLines prints class assignments grouped by class, with classes and
+// nodes in order of first application
+func (p *printer) classLines() []string {
+	classes := []string{}
+	nodes := make(map[string][]string)
+	seen := make(map[string]bool)
+	for _, app := range p.d.ClassApplications {
+		if _, ok := nodes[app.Class]; !ok {
+			classes = append(classes, app.Class)
+		}
+		if key := app.Class + " " + app.Node; !seen[key] {
+			seen[key] = true
+			nodes[app.Class] = append(nodes[app.Class], app.Node)
+		}
+	}
+	lines := []string{}
+	for _, c := range p.classNote.leading {
+		lines = append(lines, indent+c)
+	}
+	for i, class := range classes {
+		line := indent + "class " + strings.Join(nodes[class], ",") + " " + class
+		if i == 0 && p.classNote.trailing != "" {
+			line += " " + p.classNote.trailing
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// styleLines prints style, linkStyle and click statements, with linkStyle
+// indices renumbered to the printed edge order
+func (p *printer) styleLines() []string {
+	for _, item := range p.styles {
+		target, styles, ok := strings.Cut(strings.TrimPrefix(item.text, "linkStyle "), " ")
+		if !ok || !strings.HasPrefix(item.text, "linkStyle ") {
+			continue
+		}
+		indices := splitList(target)
+		for i, index := range indices {
+			if n, err := strconv.Atoi(index); err == nil {
+				if printed, ok := p.remap[n]; ok {
+					indices[i] = strconv.Itoa(printed)
+				}
+			}
+		}
+		item.text = "linkStyle " + strings.Join(indices, ",") + " " + styles
+	}
+	return p.items(p.styles, 1)
+}
+
+// items prints statements at the given depth
+func (p *printer) items(items []*printItem, depth int) []string {
+	lines := []string{}
+	for _, item := range items {
+		lines = append(lines, p.item(item, depth)...)
+	}
+	return lines
+}
+
+// item prints one statement with its comments
+func (p *printer) item(item *printItem, depth int) []string {
+	pad := strings.Repeat(indent, depth)
+	lines := []string{}
+	for _, c := range item.leading {
+		lines = append(lines, pad+c)
+	}
+	line := pad + item.text
+	if item.trailing != "" {
+		line += " " + item.trailing
+	}
+	return append(lines, line)
+}
@@ -0,0 +1,164 @@
+package parser
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// formatInputs are diagrams with every kind of statement the printer
+// handles, written untidily
+var formatInputs = map[string]string{
+	"sections": `flowchart TD
+%% entry points
+E1([POST /pay]) -- HTTP --> S1
+subgraph target ["Payment Service"]
+  S1[Validate]-->S2[Charge] %% main path
+end
+S2 ==>|gRPC| D1
+subgraph deps ["Dependencies"]
+D1[Ledger]
+end
+class S1,S2 service
+classDef service fill:#a5d8ff
+linkStyle 2 stroke:#f00`,
+
+	"nested": `flowchart LR
+    subgraph system ["Payments"]
+        direction TB
+        subgraph svc
+            A[(Orders)] -.-> B
+        end
+        B{{Cron}}
+    end
+    A:::database
+    B --> system
+    style B fill:#fff
+    click B href "https://example.com"`,
+
+	"fan-out": `graph TD
+    A & B --> C & D
+    C -- async ---> E@{ shape: cyl, label: "Events" }
+    linkStyle 0,4 stroke:#f00
+    linkStyle default stroke:#999`,
+
+	"multi-line labels": "flowchart TD\n    A[\"Payment\nService\"] -->|gRPC:\nGet| B[Ledger<br>Service]",
+}
+
+func TestFormatCanonical(t *testing.T) {
+	got, err := Format(mustParse(t, formatInputs["sections"]))
+	if err != nil {
+		t.Fatalf("Format: %v", err)
+	}
+	want := `flowchart TD
+    classDef service fill:#a5d8ff
+
+    subgraph target ["Payment Service"]
+        S1[Validate] --> S2[Charge] %% main path
+    end
+
+    subgraph deps ["Dependencies"]
+        D1[Ledger]
+    end
+
+    %% entry points
+    E1([POST /pay]) -->|HTTP| S1
+    S2 ==>|gRPC| D1
+
+    class S1,S2 service
+
+    linkStyle 2 stroke:#f00`
+	if got != want {
+		t.Errorf("Format =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestFormatIdempotent(t *testing.T) {
+	inputs := map[string]string{}
+	for name, code := range formatInputs {
+		inputs[name] = code
+	}
+	// testdata holds copies of examples/diagram-example.md and
+	// templates/diagram-template.md from the repository root
+	for _, path := range []string{"testdata/diagram-example.md", "testdata/diagram-template.md"} {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("read %s: %v", path, err)
+		}
+		for _, block := range ExtractMermaidBlocks(string(content)) {
+			inputs[fmt.Sprintf("%s block %d", filepath.Base(path), block.Index+1)] = block.Code
+		}
+	}
+
+	for name, code := range inputs {
+		t.Run(name, func(t *testing.T) {
+			once, err := Format(mustParse(t, code))
+			if err != nil {
+				t.Fatalf("Format: %v", err)
+			}
+			twice, err := Format(mustParse(t, once))
+			if err != nil {
+				t.Fatalf("Format of formatted output: %v", err)
+			}
+			if once != twice {
+				t.Errorf("formatting again changed the output:\n%s\n---\n%s", once, twice)
+			}
+		})
+	}
+}
+
+// summary describes what a diagram means, independent of how it is
+// written: nodes with their label, shape, subgraph and classes, edges
+// with their label and style, and subgraphs with their parent
+func summary(d *Diagram) []string {
+	lines := []string{"direction " + d.Direction}
+	for id, node := range d.Nodes {
+		lines = append(lines, "node "+id+" "+node.Label+" "+node.Shape+" in "+node.Subgraph+
+			" classes "+strings.Join(node.Classes, ",")+" style "+node.Style)
+	}
+	for _, e := range d.Edges {
+		lines = append(lines, "edge "+e.From+" "+e.ArrowType+" "+e.To+" "+e.Label+" style "+e.Style)
+	}
+	for _, sg := range d.Subgraphs {
+		lines = append(lines, "subgraph "+sg.ID+" "+sg.Title+" in "+sg.Parent+" direction "+sg.Direction)
+	}
+	for name, styles := range d.ClassDefs {
+		lines = append(lines, "classDef "+name+" "+styles)
+	}
+	sort.Strings(lines)
+	return lines
+}
+
+func TestFormatRoundTrip(t *testing.T) {
+	for name, code := range formatInputs {
+		t.Run(name, func(t *testing.T) {
+			d := mustParse(t, code)
+			out, err := Format(d)
+			if err != nil {
+				t.Fatalf("Format: %v", err)
+			}
+			if got, want := summary(mustParse(t, out)), summary(d); !reflect.DeepEqual(got, want) {
+				t.Errorf("formatted diagram means something else:\ngot  %q\nwant %q", got, want)
+			}
+		})
+	}
+}
+
+func TestFormatRejectsUnrecognized(t *testing.T) {
+	_, err := Format(mustParse(t, "flowchart TD\n    A =>> B"))
+	if err == nil || !strings.Contains(err.Error(), "A =>> B") {
+		t.Errorf("Format error = %v, want one naming the unrecognized statement", err)
+	}
+}
+
+func TestFormatRejectsSubgraphInsideItself(t *testing.T) {
+	d := mustParse(t, "flowchart TD\n    subgraph A\n    subgraph A\n    X-->Y\n    end\n    end")
+	_, err := Format(d)
+	if err == nil || !strings.Contains(err.Error(), "subgraph 'A' nested inside itself") {
+		t.Errorf("Format error = %v, want one naming the nested subgraph", err)
+	}
+}
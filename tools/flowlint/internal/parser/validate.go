@@ -0,0 +1,239 @@
+package parser
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// MermaidVersion is the mermaid release whose flowchart grammar Validate
+// follows: bracket shapes, the @{ shape } syntax, link tokens, subgraphs,
+// and classDef, class, style, linkStyle and click statements
+const MermaidVersion = "11.4"
+
+// reservedIDs are keywords mermaid's flowchart grammar does not accept as
+// node IDs. "end" is the common one: a node called end closes a subgraph.
+var reservedIDs = map[string]bool{
+	"end":       true,
+	"subgraph":  true,
+	"graph":     true,
+	"flowchart": true,
+	"direction": true,
+	"classDef":  true,
+	"class":     true,
+	"style":     true,
+	"linkStyle": true,
+	"click":     true,
+}
+
+// IsReservedID reports whether mermaid rejects id as a node ID
+func IsReservedID(id string) bool {
+	return reservedIDs[id]
+}
+
+// statementKeywords are the words that may start a statement, for
+// suggesting a fix when the first word is misspelled
+var statementKeywords = []string{
+	"flowchart", "graph", "subgraph", "end", "direction",
+	"classDef", "class", "style", "linkStyle", "click",
+}
+
+// linkRunRe matches a whitespace-delimited run of link characters
+var linkRunRe = regexp.MustCompile(`(?:^|\s)([<xo]?[-=.~]+[-=.~<>xo]*)(?:\s|$)`)
+
+// Validate checks the diagram against the flowchart grammar of
+// MermaidVersion without running mermaid. It reports statements that do
+// not parse, with the likely cause, a missing or repeated header,
+// unbalanced subgraph and end, subgraphs nested in one with the same ID,
+// reserved words used as node IDs, node shapes mermaid would not close
+// where the parser did, and linkStyle indices beyond the last edge. An
+// empty result means mermaid should accept the diagram.
+func Validate(d *Diagram) []Diagnostic {
+	code := strings.Join(d.RawLines, "\n")
+	diags := []Diagnostic{}
+	report := func(span Span, format string, args ...interface{}) {
+		text, _, _ := strings.Cut(sourceText(code, span), "\n")
+		diags = append(diags, Diagnostic{
+			Loc:     d.Locate(span),
+			Message: fmt.Sprintf(format, args...),
+			Text:    strings.TrimSpace(text),
+		})
+	}
+
+	started := false
+	var open []*SubgraphStmt
+	for _, stmt := range d.AST.Statements {
+		if _, ok := stmt.(*CommentStmt); ok {
+			continue
+		}
+		_, isHeader := stmt.(*HeaderStmt)
+		switch {
+		case isHeader && started:
+			report(stmt.Extent(), "diagram type declared more than once")
+		case !isHeader && !started:
+			report(stmt.Extent(), "diagram must start with 'flowchart' or 'graph'")
+		}
+		started = true
+
+		switch s := stmt.(type) {
+		case *BadStmt:
+			report(s.Span, "%s", explainBad(s.Text))
+
+		case *SubgraphStmt:
+			for _, outer := range open {
+				if outer.ID == s.ID {
+					report(s.Span, "subgraph '%s' is nested inside a subgraph with the same ID", s.ID)
+					break
+				}
+			}
+			open = append(open, s)
+
+		case *EndStmt:
+			if len(open) == 0 {
+				report(s.Span, "'end' without an open subgraph")
+				continue
+			}
+			open = open[:len(open)-1]
+
+		case *ChainStmt:
+			for _, group := range s.Groups {
+				for _, ref := range group {
+					if reservedIDs[ref.ID] {
+						report(ref.Span, "'%s' is a reserved word and cannot be a node ID", ref.ID)
+					}
+					if ref.Shape != nil {
+						if problem := shapeProblem(code, ref.Shape); problem != "" {
+							report(ref.Shape.Span, "%s", problem)
+						}
+					}
+				}
+			}
+
+		case *LinkStyleStmt:
+			for _, index := range splitList(s.Links) {
+				if index == "default" {
+					continue
+				}
+				i, err := strconv.Atoi(index)
+				if err != nil || i < 0 {
+					report(s.Span, "linkStyle index '%s' is not a number", index)
+				} else if i >= len(d.Edges) {
+					report(s.Span, "linkStyle index %d is out of range: the diagram has %d links", i, len(d.Edges))
+				}
+			}
+		}
+	}
+
+	for _, sg := range open {
+		report(sg.Span, "subgraph '%s' is never closed with 'end'", sg.ID)
+	}
+
+	return diags
+}
+
+// shapeProblem returns why mermaid rejects a node shape, or "". The
+// parser is more lenient than mermaid in two ways: an opener whose closer
+// is missing falls back to a shorter shape, so I[(x] becomes a rectangle
+// labelled "(x", and an unquoted label may hold brackets and quotes,
+// which end the label in mermaid.
+func shapeProblem(code string, shape *ShapeSpec) string {
+	if shape.Attrs != nil {
+		return ""
+	}
+
+	written := sourceText(code, shape.Span)
+	opener, closers := "", []string{}
+	for _, d := range shapeDelims {
+		if len(d.Open) > len(shape.Open) && strings.HasPrefix(d.Open, shape.Open) && strings.HasPrefix(written, d.Open) {
+			if len(d.Open) > len(opener) {
+				opener, closers = d.Open, nil
+			}
+			if d.Open == opener {
+				closers = append(closers, "'"+d.Close+"'")
+			}
+		}
+	}
+	if opener != "" {
+		return fmt.Sprintf("shape '%s' is never closed with %s", opener, strings.Join(closers, " or "))
+	}
+
+	if shape.Quoted {
+		return ""
+	}
+	if strings.Contains(shape.Label, `"`) {
+		return "unquoted label contains '\"'; write it as #quot;"
+	}
+	if i := strings.IndexAny(shape.Label, "[](){}"); i >= 0 {
+		return fmt.Sprintf("unquoted label contains '%c'; wrap the label in double quotes", shape.Label[i])
+	}
+	return ""
+}
+
+// explainBad guesses why a statement did not parse
+func explainBad(text string) string {
+	if strings.Count(text, `"`)%2 == 1 {
+		return "unterminated string"
+	}
+	if open, close := bracketBalance(text); open != close {
+		return fmt.Sprintf("unbalanced brackets: %d opened, %d closed", open, close)
+	}
+	for _, m := range linkRunRe.FindAllStringSubmatch(text, -1) {
+		if n, _ := scanLink(m[1]); n != len(m[1]) {
+			return fmt.Sprintf("invalid link '%s'", m[1])
+		}
+	}
+
+	if fields := strings.Fields(text); len(fields) > 0 {
+		first := fields[0]
+		for _, keyword := range statementKeywords {
+			if first != keyword && len(first) >= 3 && editDistance(strings.ToLower(first), strings.ToLower(keyword)) <= 2 {
+				return fmt.Sprintf("unknown keyword '%s' (did you mean '%s'?)", first, keyword)
+			}
+		}
+	}
+	for _, r := range text {
+		if !isIdentRune(r) && !strings.ContainsRune(" \t-=.~<>&|:;[](){}/\\\"'@,#", r) {
+			return fmt.Sprintf("illegal character '%c'", r)
+		}
+	}
+	return "unrecognized statement"
+}
+
+// bracketBalance counts opening and closing brackets outside quotes
+func bracketBalance(text string) (open, close int) {
+	quoted := false
+	for _, r := range text {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case quoted:
+		case r == '[' || r == '(' || r == '{':
+			open++
+		case r == ']' || r == ')' || r == '}':
+			close++
+		}
+	}
+	return open, close
+}
+
+// editDistance is the Levenshtein distance between two words
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur := make([]int, len(b)+1)
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev = cur
+	}
+	return prev[len(b)]
+}
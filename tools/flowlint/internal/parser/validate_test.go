@@ -0,0 +1,110 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		code string
+		want []string
+	}{
+		{
+			name: "valid",
+			code: "flowchart TD\n    A[\"Label (x)\"] --> B[(Orders DB)]\n    C@{ shape: cyl, label: \"Events (v2)\" }\n    D[First\nSecond] ==>|gRPC| E{{Cron}}",
+			want: []string{},
+		},
+		{
+			name: "unclosed cylinder falls back to a rectangle",
+			code: "flowchart TD\n    I[(unclosed] --> B",
+			want: []string{"2:6: shape '[(' is never closed with ')]': [(unclosed]"},
+		},
+		{
+			name: "unclosed hexagon",
+			code: "flowchart TD\n    A{{Cron}",
+			want: []string{"2:6: shape '{{' is never closed with '}}': {{Cron}"},
+		},
+		{
+			name: "unclosed lean shape",
+			code: "flowchart TD\n    A[/Input]",
+			want: []string{`2:6: shape '[/' is never closed with '/]' or '\]': [/Input]`},
+		},
+		{
+			name: "parentheses in an unquoted label",
+			code: "flowchart TD\n    A[Label (x)]",
+			want: []string{"2:6: unquoted label contains '('; wrap the label in double quotes: [Label (x)]"},
+		},
+		{
+			name: "quotes in an unquoted label",
+			code: "flowchart TD\n    C[a \"quoted\" b]",
+			want: []string{"2:6: unquoted label contains '\"'; write it as #quot;: [a \"quoted\" b]"},
+		},
+		{
+			name: "missing header",
+			code: "A --> B",
+			want: []string{"1:1: diagram must start with 'flowchart' or 'graph': A --> B"},
+		},
+		{
+			name: "unclosed subgraph",
+			code: "flowchart TD\n    subgraph deps\n        A",
+			want: []string{"2:5: subgraph 'deps' is never closed with 'end': subgraph deps"},
+		},
+		{
+			name: "subgraph inside itself",
+			code: "flowchart TD\n    subgraph A\n    subgraph A\n    X-->Y\n    end\n    end",
+			want: []string{"3:5: subgraph 'A' is nested inside a subgraph with the same ID: subgraph A"},
+		},
+		{
+			name: "end without subgraph",
+			code: "flowchart TD\n    A\n    end",
+			want: []string{"3:5: 'end' without an open subgraph: end"},
+		},
+		{
+			name: "linkStyle out of range",
+			code: "flowchart TD\n    A --> B\n    linkStyle 1 stroke:#f00",
+			want: []string{"3:5: linkStyle index 1 is out of range: the diagram has 1 links: linkStyle 1 stroke:#f00"},
+		},
+		{
+			name: "invalid link",
+			code: "flowchart TD\n    A =>> B",
+			want: []string{"2:5: invalid link '=>>': A =>> B"},
+		},
+		{
+			name: "misspelled keyword",
+			code: "flowchart TD\n    subgrap deps",
+			want: []string{"2:5: unknown keyword 'subgrap' (did you mean 'subgraph'?): subgrap deps"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{}
+			for _, d := range Validate(mustParse(t, tt.code)) {
+				got = append(got, d.String())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Validate =\n%q\nwant\n%q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExplainBad(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"", "unrecognized statement"},
+		{" \t", "unrecognized statement"},
+		{`A["Payment]`, "unterminated string"},
+		{"A[Payment", "unbalanced brackets: 1 opened, 0 closed"},
+		{"subgrap deps", "unknown keyword 'subgrap' (did you mean 'subgraph'?)"},
+		{"A $ B", "illegal character '$'"},
+	}
+	for _, tt := range tests {
+		if got := explainBad(tt.text); got != tt.want {
+			t.Errorf("explainBad(%q) = %q, want %q", tt.text, got, tt.want)
+		}
+	}
+}
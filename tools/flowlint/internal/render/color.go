@@ -0,0 +1,90 @@
+package render
+
+import (
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// namedColors are the CSS color keywords accepted in raster and PDF
+// output. SVG output passes colors through unchanged.
+var namedColors = map[string]color.NRGBA{
+	"black":     {0x00, 0x00, 0x00, 0xff},
+	"white":     {0xff, 0xff, 0xff, 0xff},
+	"red":       {0xff, 0x00, 0x00, 0xff},
+	"green":     {0x00, 0x80, 0x00, 0xff},
+	"blue":      {0x00, 0x00, 0xff, 0xff},
+	"yellow":    {0xff, 0xff, 0x00, 0xff},
+	"orange":    {0xff, 0xa5, 0x00, 0xff},
+	"purple":    {0x80, 0x00, 0x80, 0xff},
+	"gray":      {0x80, 0x80, 0x80, 0xff},
+	"grey":      {0x80, 0x80, 0x80, 0xff},
+	"lightgray": {0xd3, 0xd3, 0xd3, 0xff},
+	"lightgrey": {0xd3, 0xd3, 0xd3, 0xff},
+	"darkgray":  {0xa9, 0xa9, 0xa9, 0xff},
+	"darkgrey":  {0xa9, 0xa9, 0xa9, 0xff},
+	"silver":    {0xc0, 0xc0, 0xc0, 0xff},
+	"navy":      {0x00, 0x00, 0x80, 0xff},
+	"teal":      {0x00, 0x80, 0x80, 0xff},
+	"maroon":    {0x80, 0x00, 0x00, 0xff},
+	"olive":     {0x80, 0x80, 0x00, 0xff},
+	"lime":      {0x00, 0xff, 0x00, 0xff},
+	"aqua":      {0x00, 0xff, 0xff, 0xff},
+	"cyan":      {0x00, 0xff, 0xff, 0xff},
+	"fuchsia":   {0xff, 0x00, 0xff, 0xff},
+	"magenta":   {0xff, 0x00, 0xff, 0xff},
+	"pink":      {0xff, 0xc0, 0xcb, 0xff},
+	"brown":     {0xa5, 0x2a, 0x2a, 0xff},
+	"gold":      {0xff, 0xd7, 0x00, 0xff},
+}
+
+// parseColor reads a CSS color: #rgb, #rrggbb, #rrggbbaa, rgb(), rgba()
+// or a keyword from namedColors
+func parseColor(s string) (color.NRGBA, bool) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if c, ok := namedColors[s]; ok {
+		return c, true
+	}
+
+	if strings.HasPrefix(s, "#") {
+		hex := s[1:]
+		if len(hex) == 3 || len(hex) == 4 {
+			long := ""
+			for _, r := range hex {
+				long += string(r) + string(r)
+			}
+			hex = long
+		}
+		if len(hex) == 6 {
+			hex += "ff"
+		}
+		v, err := strconv.ParseUint(hex, 16, 32)
+		if len(hex) != 8 || err != nil {
+			return color.NRGBA{}, false
+		}
+		return color.NRGBA{R: uint8(v >> 24), G: uint8(v >> 16), B: uint8(v >> 8), A: uint8(v)}, true
+	}
+
+	for _, fn := range []string{"rgba(", "rgb("} {
+		if !strings.HasPrefix(s, fn) || !strings.HasSuffix(s, ")") {
+			continue
+		}
+		parts := strings.Split(s[len(fn):len(s)-1], ",")
+		if len(parts) != 3 && len(parts) != 4 {
+			return color.NRGBA{}, false
+		}
+		vals := make([]float64, 4)
+		vals[3] = 1
+		for i, part := range parts {
+			v, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+			if err != nil {
+				return color.NRGBA{}, false
+			}
+			vals[i] = v
+		}
+		channel := func(v float64) uint8 { return uint8(math.Max(0, math.Min(255, math.Round(v)))) }
+		return color.NRGBA{R: channel(vals[0]), G: channel(vals[1]), B: channel(vals[2]), A: channel(vals[3] * 255)}, true
+	}
+	return color.NRGBA{}, false
+}
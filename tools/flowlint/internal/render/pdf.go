@@ -0,0 +1,167 @@
+package render
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image/color"
+	"strconv"
+	"strings"
+
+	"github.com/user/flowlint/internal/font"
+	"github.com/user/flowlint/internal/layout"
+)
+
+// pointsPerPixel converts CSS pixels (1/96 inch) to PDF points (1/72 inch)
+const pointsPerPixel = 0.75
+
+// PDF draws a layout as a single-page vector PDF, shrunk to fit
+// opts.Width. Text is drawn as glyph outlines, so no font is embedded.
+// The document has no creation date or ID, so the same layout always
+// gives the same bytes. Color transparency is ignored.
+func PDF(l *layout.Layout, opts Options) ([]byte, error) {
+	s := buildScene(l, opts)
+	k := opts.fit(l) * pointsPerPixel
+	pageW, pageH := s.width*k, s.height*k
+
+	var content bytes.Buffer
+	// Flip the y axis so the scene's pixel coordinates can be used as is
+	fmt.Fprintf(&content, "%s 0 0 %s 0 %s cm\n1 j\n", pdfNum(k), pdfNum(-k), pdfNum(pageH))
+	if s.background != "" {
+		bg, ok := parseColor(s.background)
+		if !ok {
+			return nil, fmt.Errorf("unknown background color %q", s.background)
+		}
+		var p path
+		p.rect(layout.Rect{W: s.width, H: s.height}, 0)
+		fmt.Fprintf(&content, "%s rg\n", pdfColor(bg))
+		writePDFPath(&content, p)
+		content.WriteString("f\n")
+	}
+	for _, it := range s.items {
+		if it.shape != nil {
+			writePDFShape(&content, it.shape)
+		}
+		if it.text != nil {
+			writePDFText(&content, it.text)
+		}
+	}
+
+	var stream bytes.Buffer
+	zw, err := zlib.NewWriterLevel(&stream, zlib.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := zw.Write(content.Bytes()); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << >> /Contents 4 0 R >>", pdfNum(pageW), pdfNum(pageH)),
+		fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", stream.Len(), stream.String()),
+	}
+
+	var b bytes.Buffer
+	b.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = b.Len()
+		fmt.Fprintf(&b, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+	xref := b.Len()
+	fmt.Fprintf(&b, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, off := range offsets {
+		fmt.Fprintf(&b, "%010d 00000 n \n", off)
+	}
+	fmt.Fprintf(&b, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+	return b.Bytes(), nil
+}
+
+func writePDFShape(b *bytes.Buffer, sh *shape) {
+	fill, hasFill := parseColor(sh.fill)
+	hasFill = hasFill && sh.fill != ""
+	strokeCol, hasStroke := parseColor(sh.stroke)
+	hasStroke = hasStroke && sh.stroke != "" && sh.width > 0
+	if len(sh.path) == 0 || !hasFill && !hasStroke {
+		return
+	}
+
+	if hasFill {
+		fmt.Fprintf(b, "%s rg\n", pdfColor(fill))
+	}
+	if hasStroke {
+		fmt.Fprintf(b, "%s RG %s w ", pdfColor(strokeCol), pdfNum(sh.width))
+		dashes := []string{}
+		for _, d := range dashArray(sh.dashes) {
+			dashes = append(dashes, pdfNum(d))
+		}
+		fmt.Fprintf(b, "[%s] 0 d\n", strings.Join(dashes, " "))
+	}
+	writePDFPath(b, sh.path)
+	switch {
+	case hasFill && hasStroke:
+		b.WriteString("B\n")
+	case hasFill:
+		b.WriteString("f\n")
+	default:
+		b.WriteString("S\n")
+	}
+}
+
+func writePDFText(b *bytes.Buffer, t *text) {
+	col, ok := parseColor(t.color)
+	if !ok {
+		return
+	}
+	var p path
+	for i, y := range t.baselines() {
+		line := t.lines[i]
+		x := t.at.X - font.Width(line, layout.FontSize, t.bold)/2
+		p = append(p, outlinePath(font.Outline(line, x, y, layout.FontSize, t.bold))...)
+	}
+	if len(p) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "%s rg\n", pdfColor(col))
+	writePDFPath(b, p)
+	b.WriteString("f\n")
+}
+
+func writePDFPath(b *bytes.Buffer, p path) {
+	for _, seg := range p {
+		for _, pt := range seg.points {
+			fmt.Fprintf(b, "%s %s ", pdfNum(pt.X), pdfNum(pt.Y))
+		}
+		switch seg.op {
+		case 'M':
+			b.WriteString("m\n")
+		case 'L':
+			b.WriteString("l\n")
+		case 'C':
+			b.WriteString("c\n")
+		case 'Z':
+			b.WriteString("h\n")
+		}
+	}
+}
+
+// pdfColor formats a color as the operands of rg or RG
+func pdfColor(c color.NRGBA) string {
+	channel := func(v uint8) string { return pdfNum(float64(v) / 255) }
+	return channel(c.R) + " " + channel(c.G) + " " + channel(c.B)
+}
+
+// pdfNum formats a number with up to three decimals
+func pdfNum(v float64) string {
+	s := strconv.FormatFloat(v, 'f', 3, 64)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	if s == "-0" || s == "" {
+		return "0"
+	}
+	return s
+}
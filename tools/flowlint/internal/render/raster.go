@@ -0,0 +1,400 @@
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+
+	"github.com/user/flowlint/internal/font"
+	"github.com/user/flowlint/internal/layout"
+)
+
+// tolerance is the largest distance, in device pixels, between a curve
+// and the line segments that replace it
+const tolerance = 0.2
+
+// maxPixels bounds the size of a raster image
+const maxPixels = 20000 * 20000
+
+// PNG draws a layout as a PNG image. The diagram is shrunk to fit
+// opts.Width, then drawn at opts.Scale device pixels per pixel.
+func PNG(l *layout.Layout, opts Options) ([]byte, error) {
+	img, err := Raster(l, opts)
+	if err != nil {
+		return nil, err
+	}
+	var b bytes.Buffer
+	enc := png.Encoder{CompressionLevel: png.BestCompression}
+	if err := enc.Encode(&b, img); err != nil {
+		return nil, fmt.Errorf("failed to encode png: %w", err)
+	}
+	return b.Bytes(), nil
+}
+
+// PixelSize returns the width and height of the raster image of a layout
+func PixelSize(l *layout.Layout, opts Options) (int, int) {
+	k := opts.fit(l) * opts.scale()
+	return int(math.Ceil(l.Width * k)), int(math.Ceil(l.Height * k))
+}
+
+// Raster draws a layout into an image, sized as PNG describes
+func Raster(l *layout.Layout, opts Options) (*image.RGBA, error) {
+	s := buildScene(l, opts)
+	k := opts.fit(l) * opts.scale()
+	w, h := PixelSize(l, opts)
+	if w <= 0 || h <= 0 || float64(w)*float64(h) > maxPixels {
+		return nil, fmt.Errorf("image size %dx%d is out of range", w, h)
+	}
+
+	c := &canvas{img: image.NewRGBA(image.Rect(0, 0, w, h)), k: k}
+	if s.background != "" {
+		bg, ok := parseColor(s.background)
+		if !ok {
+			return nil, fmt.Errorf("unknown background color %q", s.background)
+		}
+		var p path
+		p.rect(layout.Rect{W: s.width, H: s.height}, 0)
+		c.fill(c.flatten(p), bg)
+	}
+
+	for _, it := range s.items {
+		if it.shape != nil {
+			c.shape(it.shape)
+		}
+		if it.text != nil {
+			c.text(it.text)
+		}
+	}
+	return c.img, nil
+}
+
+// canvas rasterizes scene items with anti-aliasing. Coordinates are
+// scaled by k from layout pixels to device pixels.
+type canvas struct {
+	img *image.RGBA
+	k   float64
+}
+
+// polyline is a flattened subpath in device pixels
+type polyline struct {
+	points []layout.Point
+	closed bool
+}
+
+func (c *canvas) shape(sh *shape) {
+	lines := c.flatten(sh.path)
+	if col, ok := parseColor(sh.fill); ok && sh.fill != "" {
+		c.fill(lines, col)
+	}
+	if col, ok := parseColor(sh.stroke); ok && sh.stroke != "" && sh.width > 0 {
+		if dashes := dashArray(sh.dashes); dashes != nil {
+			for i := range dashes {
+				dashes[i] *= c.k
+			}
+			lines = dash(lines, dashes)
+		}
+		c.fill(stroke(lines, sh.width*c.k), col)
+	}
+}
+
+func (c *canvas) text(t *text) {
+	col, ok := parseColor(t.color)
+	if !ok {
+		return
+	}
+	var p path
+	for i, y := range t.baselines() {
+		line := t.lines[i]
+		x := t.at.X - font.Width(line, layout.FontSize, t.bold)/2
+		p = append(p, outlinePath(font.Outline(line, x, y, layout.FontSize, t.bold))...)
+	}
+	c.fill(c.flatten(p), col)
+}
+
+// flatten turns a path into polylines in device pixels
+func (c *canvas) flatten(p path) []polyline {
+	lines := []polyline{}
+	var cur *polyline
+	at := func(pt layout.Point) layout.Point {
+		return layout.Point{X: pt.X * c.k, Y: pt.Y * c.k}
+	}
+	for _, seg := range p {
+		switch seg.op {
+		case 'M':
+			lines = append(lines, polyline{points: []layout.Point{at(seg.points[0])}})
+			cur = &lines[len(lines)-1]
+		case 'L':
+			if cur != nil {
+				cur.points = append(cur.points, at(seg.points[0]))
+			}
+		case 'C':
+			if cur != nil {
+				p0 := cur.points[len(cur.points)-1]
+				cur.points = append(cur.points, flattenCubic(p0, at(seg.points[0]), at(seg.points[1]), at(seg.points[2]))...)
+			}
+		case 'Z':
+			if cur != nil {
+				cur.closed = true
+				cur = nil
+			}
+		}
+	}
+	return lines
+}
+
+// flattenCubic returns points along a cubic curve, ending with p3
+func flattenCubic(p0, p1, p2, p3 layout.Point) []layout.Point {
+	dd := math.Max(
+		math.Hypot(p0.X-2*p1.X+p2.X, p0.Y-2*p1.Y+p2.Y),
+		math.Hypot(p1.X-2*p2.X+p3.X, p1.Y-2*p2.Y+p3.Y),
+	)
+	n := int(math.Ceil(math.Sqrt(0.75 * dd / tolerance)))
+	n = max(1, min(n, 64))
+	points := make([]layout.Point, 0, n)
+	for i := 1; i <= n; i++ {
+		t := float64(i) / float64(n)
+		u := 1 - t
+		a, b, c, d := u*u*u, 3*u*u*t, 3*u*t*t, t*t*t
+		points = append(points, layout.Point{
+			X: a*p0.X + b*p1.X + c*p2.X + d*p3.X,
+			Y: a*p0.Y + b*p1.Y + c*p2.Y + d*p3.Y,
+		})
+	}
+	return points
+}
+
+// dash splits polylines into the visible dashes of a dash pattern
+func dash(lines []polyline, pattern []float64) []polyline {
+	out := []polyline{}
+	for _, line := range lines {
+		pts := line.points
+		if line.closed && len(pts) > 1 {
+			pts = append(append([]layout.Point{}, pts...), pts[0])
+		}
+		i, left, on := 0, pattern[0], true
+		var cur []layout.Point
+		if on && len(pts) > 0 {
+			cur = []layout.Point{pts[0]}
+		}
+		for j := 0; j+1 < len(pts); j++ {
+			p, q := pts[j], pts[j+1]
+			seg := math.Hypot(q.X-p.X, q.Y-p.Y)
+			pos := 0.0
+			for seg-pos > left {
+				pos += left
+				t := pos / seg
+				mid := layout.Point{X: p.X + (q.X-p.X)*t, Y: p.Y + (q.Y-p.Y)*t}
+				if on {
+					out = append(out, polyline{points: append(cur, mid)})
+					cur = nil
+				} else {
+					cur = []layout.Point{mid}
+				}
+				on = !on
+				i = (i + 1) % len(pattern)
+				left = pattern[i]
+			}
+			left -= seg - pos
+			if on {
+				cur = append(cur, q)
+			}
+		}
+		if on && len(cur) > 1 {
+			out = append(out, polyline{points: cur})
+		}
+	}
+	return out
+}
+
+// stroke returns polygons covering polylines drawn with the given width,
+// with round joins and butt caps. All polygons wind the same way, so
+// filling them with the nonzero rule draws their union.
+func stroke(lines []polyline, width float64) []polyline {
+	half := width / 2
+	out := []polyline{}
+	for _, line := range lines {
+		pts := line.points
+		if line.closed && len(pts) > 1 {
+			pts = append(append([]layout.Point{}, pts...), pts[0])
+		}
+		for j := 0; j+1 < len(pts); j++ {
+			p, q := pts[j], pts[j+1]
+			length := math.Hypot(q.X-p.X, q.Y-p.Y)
+			if length == 0 {
+				continue
+			}
+			nx, ny := -(q.Y-p.Y)/length*half, (q.X-p.X)/length*half
+			out = append(out, oriented([]layout.Point{
+				{X: p.X + nx, Y: p.Y + ny}, {X: q.X + nx, Y: q.Y + ny},
+				{X: q.X - nx, Y: q.Y - ny}, {X: p.X - nx, Y: p.Y - ny},
+			}))
+		}
+		// Round joins at interior vertices, and at every vertex of a
+		// closed line
+		last := len(pts) - 1
+		for j := 1; j < last; j++ {
+			out = append(out, disk(pts[j], half))
+		}
+		if line.closed && last > 0 {
+			out = append(out, disk(pts[0], half))
+		}
+	}
+	return out
+}
+
+// disk returns a polygon approximating a circle
+func disk(c layout.Point, r float64) polyline {
+	n := int(math.Ceil(2 * math.Pi * r / 1.5))
+	n = max(8, min(n, 32))
+	pts := make([]layout.Point, n)
+	for i := range pts {
+		a := 2 * math.Pi * float64(i) / float64(n)
+		pts[i] = layout.Point{X: c.X + r*math.Cos(a), Y: c.Y + r*math.Sin(a)}
+	}
+	return oriented(pts)
+}
+
+// oriented returns a closed polygon wound clockwise (y down)
+func oriented(pts []layout.Point) polyline {
+	area := 0.0
+	for i := range pts {
+		p, q := pts[i], pts[(i+1)%len(pts)]
+		area += p.X*q.Y - q.X*p.Y
+	}
+	if area < 0 {
+		for i, j := 0, len(pts)-1; i < j; i, j = i+1, j-1 {
+			pts[i], pts[j] = pts[j], pts[i]
+		}
+	}
+	return polyline{points: pts, closed: true}
+}
+
+// fill paints the inside of polygons by the nonzero rule. Open polylines
+// are closed implicitly. Coverage is computed exactly per pixel from the
+// signed area under each edge.
+func (c *canvas) fill(lines []polyline, col color.NRGBA) {
+	bounds := c.img.Bounds()
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, line := range lines {
+		for _, p := range line.points {
+			minX, minY = math.Min(minX, p.X), math.Min(minY, p.Y)
+			maxX, maxY = math.Max(maxX, p.X), math.Max(maxY, p.Y)
+		}
+	}
+	x0 := max(bounds.Min.X, int(math.Floor(minX)))
+	y0 := max(bounds.Min.Y, int(math.Floor(minY)))
+	x1 := min(bounds.Max.X, int(math.Ceil(maxX)))
+	y1 := min(bounds.Max.Y, int(math.Ceil(maxY)))
+	if x1 <= x0 || y1 <= y0 {
+		return
+	}
+
+	a := &accumulator{w: x1 - x0, h: y1 - y0}
+	a.acc = make([]float64, (a.w+2)*a.h)
+	for _, line := range lines {
+		pts := line.points
+		for i := range pts {
+			p, q := pts[i], pts[(i+1)%len(pts)]
+			a.line(p.X-float64(x0), p.Y-float64(y0), q.X-float64(x0), q.Y-float64(y0))
+		}
+	}
+
+	alpha := float64(col.A) / 255
+	for y := 0; y < a.h; y++ {
+		sum := 0.0
+		row := a.acc[y*(a.w+2):]
+		for x := 0; x < a.w; x++ {
+			sum += row[x]
+			cover := math.Min(1, math.Abs(sum))
+			if cover < 1.0/512 {
+				continue
+			}
+			blend(c.img, x0+x, y0+y, col, cover*alpha)
+		}
+	}
+}
+
+// blend composites a color over a pixel with the given opacity
+func blend(img *image.RGBA, x, y int, col color.NRGBA, alpha float64) {
+	i := img.PixOffset(x, y)
+	px := img.Pix[i : i+4 : i+4]
+	over := func(src, dst uint8) uint8 {
+		return uint8(math.Round(float64(src)*alpha + float64(dst)*(1-alpha)))
+	}
+	px[0] = over(col.R, px[0])
+	px[1] = over(col.G, px[1])
+	px[2] = over(col.B, px[2])
+	px[3] = over(255, px[3])
+}
+
+// accumulator collects, for each pixel, the signed area of the polygon
+// edges crossing it; a running sum along a row gives the coverage
+type accumulator struct {
+	w, h int
+	acc  []float64 // rows of w+2 cells
+}
+
+// line adds the edge from (x0, y0) to (x1, y1)
+func (a *accumulator) line(x0, y0, x1, y1 float64) {
+	if y0 == y1 {
+		return
+	}
+	dir := 1.0
+	if y0 > y1 {
+		dir = -1
+		x0, y0, x1, y1 = x1, y1, x0, y0
+	}
+	// Area left of the bitmap counts as if it were at its left edge
+	clampX := func(x float64) float64 { return math.Max(0, math.Min(float64(a.w), x)) }
+	dxdy := (x1 - x0) / (y1 - y0)
+	x := x0
+	if y0 < 0 {
+		x -= y0 * dxdy
+	}
+	start := max(0, int(y0))
+	end := min(a.h, int(math.Ceil(y1)))
+	for y := start; y < end; y++ {
+		row := y * (a.w + 2)
+		dy := math.Min(float64(y+1), y1) - math.Max(float64(y), y0)
+		xnext := x + dxdy*dy
+		d := dy * dir
+		xa, xb := clampX(x), clampX(xnext)
+		if xa > xb {
+			xa, xb = xb, xa
+		}
+		floorA := math.Floor(xa)
+		ia := int(floorA)
+		ceilB := math.Ceil(xb)
+		ib := int(ceilB)
+		if ib <= ia+1 {
+			// The edge stays within one pixel column
+			xm := 0.5*(xa+xb) - floorA
+			a.acc[row+ia] += d - d*xm
+			a.acc[row+ia+1] += d * xm
+		} else {
+			s := 1 / (xb - xa)
+			fa := xa - floorA
+			a0 := 0.5 * s * (1 - fa) * (1 - fa)
+			fb := xb - ceilB + 1
+			am := 0.5 * s * fb * fb
+			a.acc[row+ia] += d * a0
+			if ib == ia+2 {
+				a.acc[row+ia+1] += d * (1 - a0 - am)
+			} else {
+				a1 := s * (1.5 - fa)
+				a.acc[row+ia+1] += d * (a1 - a0)
+				for xi := ia + 2; xi < ib-1; xi++ {
+					a.acc[row+xi] += d * s
+				}
+				a2 := a1 + float64(ib-ia-3)*s
+				a.acc[row+ib-1] += d * (1 - a2 - am)
+			}
+			a.acc[row+ib] += d * am
+		}
+		x = xnext
+	}
+}
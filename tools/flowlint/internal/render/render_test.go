@@ -0,0 +1,109 @@
+package render
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/user/flowlint/internal/layout"
+	"github.com/user/flowlint/internal/parser"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// inputs returns the names of the diagrams in testdata
+func inputs(t *testing.T) []string {
+	t.Helper()
+	files, err := filepath.Glob(filepath.Join("testdata", "*.mmd"))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no inputs in testdata: %v", err)
+	}
+	names := make([]string, len(files))
+	for i, file := range files {
+		names[i] = strings.TrimSuffix(filepath.Base(file), ".mmd")
+	}
+	return names
+}
+
+// mustLayout parses and lays out testdata/<name>.mmd
+func mustLayout(t *testing.T, name string) *layout.Layout {
+	t.Helper()
+	code, err := os.ReadFile(filepath.Join("testdata", name+".mmd"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, err := parser.ParseMermaid(string(code))
+	if err != nil {
+		t.Fatalf("ParseMermaid: %v", err)
+	}
+	return layout.Compute(d)
+}
+
+// checkGolden compares output with testdata/<file>, rewriting it first
+// when -update is given
+func checkGolden(t *testing.T, file string, got []byte) {
+	t.Helper()
+	golden := filepath.Join("testdata", file)
+	if *update {
+		if err := os.WriteFile(golden, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("%v (run go test -update to create it)", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("output differs from %s: got %d bytes, want %d", golden, len(got), len(want))
+	}
+}
+
+// formats draws a layout in every image format
+var formats = []struct {
+	ext  string
+	draw func(*layout.Layout, Options) ([]byte, error)
+}{
+	{"svg", func(l *layout.Layout, opts Options) ([]byte, error) { return SVG(l, opts), nil }},
+	{"png", PNG},
+	{"pdf", PDF},
+}
+
+func TestRenderGolden(t *testing.T) {
+	opts := Options{Background: "white"}
+	for _, name := range inputs(t) {
+		l := mustLayout(t, name)
+		for _, f := range formats {
+			t.Run(name+"."+f.ext, func(t *testing.T) {
+				got, err := f.draw(l, opts)
+				if err != nil {
+					t.Fatalf("render: %v", err)
+				}
+				checkGolden(t, name+"."+f.ext+".golden", got)
+			})
+		}
+	}
+}
+
+func TestRenderReproducible(t *testing.T) {
+	opts := Options{Width: 400, Scale: 2, Background: "transparent"}
+	for _, name := range inputs(t) {
+		for _, f := range formats {
+			t.Run(name+"."+f.ext, func(t *testing.T) {
+				first, err := f.draw(mustLayout(t, name), opts)
+				if err != nil {
+					t.Fatalf("render: %v", err)
+				}
+				second, err := f.draw(mustLayout(t, name), opts)
+				if err != nil {
+					t.Fatalf("render: %v", err)
+				}
+				if !bytes.Equal(first, second) {
+					t.Errorf("rendering twice gave different bytes: %d and %d long", len(first), len(second))
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,560 @@
+package render
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/user/flowlint/internal/font"
+	"github.com/user/flowlint/internal/layout"
+	"github.com/user/flowlint/internal/parser"
+)
+
+// Default colors, used where no classDef or style applies
+const (
+	defaultFill   = "#ffffff"
+	defaultStroke = "#495057"
+	defaultText   = "#212529"
+	defaultEdge   = "#495057"
+	clusterFill   = "#f8f9fa"
+	clusterStroke = "#adb5bd"
+	clusterText   = "#495057"
+	edgeLabelFill = "#ffffff"
+)
+
+// Stroke widths and arrowhead size, in pixels
+const (
+	shapeStrokeWidth = 1.0
+	thickEdgeWidth   = 3.0
+	normalEdgeWidth  = 1.5
+	dottedEdgeDashes = "6 4"
+	headSize         = 10.0
+)
+
+// kappa places the control points of a cubic curve approximating a
+// quarter ellipse
+const kappa = 0.5522847498
+
+// Options controls rendering. Width, Scale and Background mirror the -w,
+// -s and -b flags of mermaid-cli.
+type Options struct {
+	Width      float64 // page width the diagram must fit in; 0 for its natural size
+	Scale      float64 // device pixels per pixel in raster output; 0 means 1
+	Background string  // color behind the drawing; empty or "transparent" for none
+}
+
+// fit returns the factor that shrinks a layout to the page width.
+// Like mermaid, a diagram narrower than the page keeps its size.
+func (o Options) fit(l *layout.Layout) float64 {
+	if o.Width > 0 && l.Width > o.Width {
+		return o.Width / l.Width
+	}
+	return 1
+}
+
+// scale returns the device pixel ratio
+func (o Options) scale() float64 {
+	if o.Scale > 0 {
+		return o.Scale
+	}
+	return 1
+}
+
+// background returns the background color, or "" for none
+func (o Options) background() string {
+	if strings.EqualFold(o.Background, "transparent") || o.Background == "none" {
+		return ""
+	}
+	return o.Background
+}
+
+// Colors is the resolved paint of a node or subgraph
+type Colors struct {
+	Fill        string
+	Stroke      string
+	Text        string
+	StrokeWidth float64
+	Dashes      string
+}
+
+// NodeColors resolves a node's colors: the default classDef, then its
+// classes in order, then its style statements
+func NodeColors(d *parser.Diagram, node *parser.Node) Colors {
+	c := Colors{Fill: defaultFill, Stroke: defaultStroke, Text: defaultText, StrokeWidth: shapeStrokeWidth}
+	c.apply(d.ClassDefs["default"])
+	for _, class := range node.Classes {
+		c.apply(d.ClassDefs[class])
+	}
+	c.apply(node.Style)
+	return c
+}
+
+// apply overrides colors with the properties of a style string
+func (c *Colors) apply(styles string) {
+	if styles == "" {
+		return
+	}
+	props := parser.ParseStyleProps(styles)
+	if v := props["fill"]; v != "" {
+		c.Fill = v
+	}
+	if v := props["stroke"]; v != "" {
+		c.Stroke = v
+	}
+	if v := props["color"]; v != "" {
+		c.Text = v
+	}
+	if w, ok := pixels(props["stroke-width"]); ok {
+		c.StrokeWidth = w
+	}
+	if v := props["stroke-dasharray"]; v != "" {
+		c.Dashes = v
+	}
+}
+
+// EdgeStyle is the resolved stroke of an edge
+type EdgeStyle struct {
+	Color  string
+	Width  float64
+	Dashes string
+}
+
+// EdgeStroke resolves an edge's stroke: thick for sync (==>), dashed for
+// async (-.->), thin for internal (-->), then any linkStyle
+func EdgeStroke(e *parser.Edge) EdgeStyle {
+	s := EdgeStyle{Color: defaultEdge, Width: normalEdgeWidth}
+	switch e.Stroke {
+	case parser.StrokeThick:
+		s.Width = thickEdgeWidth
+	case parser.StrokeDotted:
+		s.Dashes = dottedEdgeDashes
+	}
+	props := parser.ParseStyleProps(e.Style)
+	if v := props["stroke"]; v != "" {
+		s.Color = v
+	}
+	if w, ok := pixels(props["stroke-width"]); ok {
+		s.Width = w
+	}
+	if v := props["stroke-dasharray"]; v != "" {
+		s.Dashes = v
+	}
+	return s
+}
+
+// pixels parses a length such as "2px" or "2"
+func pixels(v string) (float64, bool) {
+	w, err := strconv.ParseFloat(strings.TrimSuffix(strings.TrimSpace(v), "px"), 64)
+	return w, err == nil && w >= 0
+}
+
+// scene is a layout reduced to paths and text, which every output
+// format can draw
+type scene struct {
+	width, height float64
+	background    string
+	items         []item
+}
+
+// item is a path or a block of text. Items with the same group are
+// emitted together as an SVG group.
+type item struct {
+	group *group
+	shape *shape
+	text  *text
+}
+
+// group names a diagram element in SVG output
+type group struct {
+	class, id string
+}
+
+// shape is a path with its paint
+type shape struct {
+	path   path
+	fill   string // empty for no fill
+	stroke string // empty for no stroke
+	width  float64
+	dashes string
+}
+
+// text is one or more centered lines
+type text struct {
+	at    layout.Point // center of the block
+	lines []string
+	color string
+	bold  bool
+}
+
+// path is a sequence of M, L, C (cubic) and Z segments
+type path []segment
+
+type segment struct {
+	op     byte
+	points []layout.Point
+}
+
+func (p *path) moveTo(x, y float64) {
+	*p = append(*p, segment{op: 'M', points: []layout.Point{{X: x, Y: y}}})
+}
+
+func (p *path) lineTo(x, y float64) {
+	*p = append(*p, segment{op: 'L', points: []layout.Point{{X: x, Y: y}}})
+}
+
+func (p *path) cubicTo(x1, y1, x2, y2, x, y float64) {
+	*p = append(*p, segment{op: 'C', points: []layout.Point{{X: x1, Y: y1}, {X: x2, Y: y2}, {X: x, Y: y}}})
+}
+
+// quadTo appends a quadratic curve as the equivalent cubic
+func (p *path) quadTo(c, to layout.Point) {
+	from := p.current()
+	p.cubicTo(from.X+2*(c.X-from.X)/3, from.Y+2*(c.Y-from.Y)/3, to.X+2*(c.X-to.X)/3, to.Y+2*(c.Y-to.Y)/3, to.X, to.Y)
+}
+
+// current returns the end point of the path
+func (p path) current() layout.Point {
+	for i := len(p) - 1; i >= 0; i-- {
+		if n := len(p[i].points); n > 0 {
+			return p[i].points[n-1]
+		}
+	}
+	return layout.Point{}
+}
+
+func (p *path) close() {
+	*p = append(*p, segment{op: 'Z'})
+}
+
+// polygon appends a closed polygon
+func (p *path) polygon(points ...layout.Point) {
+	for i, pt := range points {
+		if i == 0 {
+			p.moveTo(pt.X, pt.Y)
+		} else {
+			p.lineTo(pt.X, pt.Y)
+		}
+	}
+	p.close()
+}
+
+// rect appends a rectangle with corners rounded by radius rx
+func (p *path) rect(r layout.Rect, rx float64) {
+	rx = math.Min(rx, math.Min(r.W, r.H)/2)
+	if rx <= 0 {
+		p.polygon(layout.Point{X: r.X, Y: r.Y}, layout.Point{X: r.X + r.W, Y: r.Y},
+			layout.Point{X: r.X + r.W, Y: r.Y + r.H}, layout.Point{X: r.X, Y: r.Y + r.H})
+		return
+	}
+	x0, y0, x1, y1 := r.X, r.Y, r.X+r.W, r.Y+r.H
+	k := rx * (1 - kappa)
+	p.moveTo(x0+rx, y0)
+	p.lineTo(x1-rx, y0)
+	p.cubicTo(x1-k, y0, x1, y0+k, x1, y0+rx)
+	p.lineTo(x1, y1-rx)
+	p.cubicTo(x1, y1-k, x1-k, y1, x1-rx, y1)
+	p.lineTo(x0+rx, y1)
+	p.cubicTo(x0+k, y1, x0, y1-k, x0, y1-rx)
+	p.lineTo(x0, y0+rx)
+	p.cubicTo(x0, y0+k, x0+k, y0, x0+rx, y0)
+	p.close()
+}
+
+// arc appends quarter-ellipse curves around (cx, cy) from angle `from` to
+// angle `to`, in degrees measured clockwise from the x axis (y is down).
+// Both angles are multiples of 90 and the path must be at the start.
+func (p *path) arc(cx, cy, rx, ry float64, from, to int) {
+	at := func(deg int) (float64, float64, float64, float64) {
+		a := float64(deg) * math.Pi / 180
+		cos, sin := math.Round(math.Cos(a)), math.Round(math.Sin(a))
+		// Point and unit tangent in the direction of increasing angle
+		return cx + rx*cos, cy + ry*sin, -rx * sin, ry * cos
+	}
+	step := 90
+	if to < from {
+		step = -90
+	}
+	sign := float64(step / 90)
+	for deg := from; deg != to; deg += step {
+		x0, y0, tx0, ty0 := at(deg)
+		x1, y1, tx1, ty1 := at(deg + step)
+		p.cubicTo(x0+sign*kappa*tx0, y0+sign*kappa*ty0, x1-sign*kappa*tx1, y1-sign*kappa*ty1, x1, y1)
+	}
+}
+
+// ellipse appends a closed ellipse
+func (p *path) ellipse(cx, cy, rx, ry float64) {
+	p.moveTo(cx+rx, cy)
+	p.arc(cx, cy, rx, ry, 0, 360)
+	p.close()
+}
+
+// outlinePath converts a glyph outline to a path
+func outlinePath(outline []font.Segment) path {
+	var p path
+	for _, seg := range outline {
+		pts := make([]layout.Point, len(seg.Points))
+		for i, pt := range seg.Points {
+			pts[i] = layout.Point{X: pt.X, Y: pt.Y}
+		}
+		switch {
+		case seg.Op == 'M' && len(pts) == 1:
+			p.moveTo(pts[0].X, pts[0].Y)
+		case seg.Op == 'L' && len(pts) == 1 && len(p) > 0:
+			p.lineTo(pts[0].X, pts[0].Y)
+		case seg.Op == 'Q' && len(pts) == 2 && len(p) > 0:
+			p.quadTo(pts[0], pts[1])
+		case seg.Op == 'Z' && len(p) > 0:
+			p.close()
+		}
+	}
+	return p
+}
+
+// buildScene draws a layout as paths and text in layout coordinates
+func buildScene(l *layout.Layout, opts Options) *scene {
+	s := &scene{width: l.Width, height: l.Height, background: opts.background()}
+	for _, c := range l.Clusters {
+		s.cluster(l.Diagram, c)
+	}
+	for _, e := range l.Edges {
+		s.edge(e)
+	}
+	for _, n := range l.Nodes {
+		s.node(l.Diagram, n)
+	}
+	for _, e := range l.Edges {
+		s.edgeLabel(e)
+	}
+	return s
+}
+
+func (s *scene) add(g *group, sh *shape, t *text) {
+	s.items = append(s.items, item{group: g, shape: sh, text: t})
+}
+
+// cluster draws a subgraph box with its title centered at the top
+func (s *scene) cluster(d *parser.Diagram, c *layout.Cluster) {
+	colors := Colors{Fill: clusterFill, Stroke: clusterStroke, Text: clusterText, StrokeWidth: shapeStrokeWidth}
+	for _, style := range d.Styles {
+		if style.Target == c.ID {
+			colors.apply(style.Styles)
+		}
+	}
+	g := &group{class: "cluster", id: "cluster-" + c.ID}
+	var p path
+	p.rect(c.Rect, 4)
+	s.add(g, &shape{path: p, fill: colors.Fill, stroke: colors.Stroke, width: colors.StrokeWidth, dashes: colors.Dashes}, nil)
+	title := layout.Point{X: c.Rect.X + c.Rect.W/2, Y: c.Rect.Y + layout.LineHeight*0.75}
+	s.add(g, nil, &text{at: title, lines: []string{c.Title}, color: colors.Text, bold: true})
+}
+
+// edge draws an edge's line and heads. The line stops short of an arrow
+// tip so that thick strokes do not show past it.
+func (s *scene) edge(e *layout.Edge) {
+	if !e.Visible() || len(e.Points) < 2 {
+		return
+	}
+	st := EdgeStroke(e.Edge)
+	g := &group{class: "edge"}
+	points := append([]layout.Point{}, e.Points...)
+	heads := []*shape{}
+	if e.Edge.Head != parser.HeadNone {
+		n := len(points)
+		var head *shape
+		head, points[n-1] = arrowhead(e.Edge.Head, points[n-2], points[n-1], st)
+		heads = append(heads, head)
+		if e.Edge.Bidirectional {
+			head, points[0] = arrowhead(e.Edge.Head, points[1], points[0], st)
+			heads = append(heads, head)
+		}
+	}
+
+	var p path
+	p.moveTo(points[0].X, points[0].Y)
+	for _, pt := range points[1:] {
+		p.lineTo(pt.X, pt.Y)
+	}
+	s.add(g, &shape{path: p, stroke: st.Color, width: st.Width, dashes: st.Dashes}, nil)
+	for _, head := range heads {
+		s.add(g, head, nil)
+	}
+}
+
+// arrowhead returns the head drawn at tip for a line coming from `from`,
+// and where the line itself should end
+func arrowhead(kind string, from, tip layout.Point, st EdgeStyle) (*shape, layout.Point) {
+	dx, dy := tip.X-from.X, tip.Y-from.Y
+	length := math.Hypot(dx, dy)
+	if length == 0 {
+		return &shape{}, tip
+	}
+	ux, uy := dx/length, dy/length // along the line
+	nx, ny := -uy, ux              // across it
+	along := func(d float64) layout.Point {
+		return layout.Point{X: tip.X - ux*d, Y: tip.Y - uy*d}
+	}
+
+	var p path
+	switch kind {
+	case parser.HeadCross:
+		c, r := along(headSize/2), headSize*0.35
+		p.moveTo(c.X-(ux+nx)*r, c.Y-(uy+ny)*r)
+		p.lineTo(c.X+(ux+nx)*r, c.Y+(uy+ny)*r)
+		p.moveTo(c.X-(ux-nx)*r, c.Y-(uy-ny)*r)
+		p.lineTo(c.X+(ux-nx)*r, c.Y+(uy-ny)*r)
+		return &shape{path: p, stroke: st.Color, width: 2}, tip
+	case parser.HeadCircle:
+		c := along(headSize * 0.4)
+		p.ellipse(c.X, c.Y, headSize*0.4, headSize*0.4)
+		return &shape{path: p, fill: st.Color}, along(headSize * 0.8)
+	}
+	base := along(headSize)
+	half := headSize / 2
+	p.polygon(tip, layout.Point{X: base.X + nx*half, Y: base.Y + ny*half}, layout.Point{X: base.X - nx*half, Y: base.Y - ny*half})
+	return &shape{path: p, fill: st.Color}, along(headSize / 2)
+}
+
+// edgeLabel draws an edge label on a background so it stays legible
+func (s *scene) edgeLabel(e *layout.Edge) {
+	if !e.Visible() || len(e.Points) < 2 || e.Edge.Label == "" {
+		return
+	}
+	lines := strings.Split(e.Edge.Label, "\n")
+	w := 0.0
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+		w = math.Max(w, layout.TextWidth(lines[i]))
+	}
+	w += 8
+	h := float64(len(lines))*layout.LineHeight + 4
+	g := &group{class: "edge-label"}
+	var p path
+	p.rect(layout.Rect{X: e.Label.X - w/2, Y: e.Label.Y - h/2, W: w, H: h}, 0)
+	s.add(g, &shape{path: p, fill: edgeLabelFill}, nil)
+	s.add(g, nil, &text{at: e.Label, lines: lines, color: defaultText})
+}
+
+// node draws a node's shape and label
+func (s *scene) node(d *parser.Diagram, n *layout.Node) {
+	colors := NodeColors(d, n.Node)
+	g := &group{class: "node", id: "node-" + n.ID}
+	paint := func(p path, fill bool) *shape {
+		sh := &shape{path: p, stroke: colors.Stroke, width: colors.StrokeWidth, dashes: colors.Dashes}
+		if fill {
+			sh.fill = colors.Fill
+		}
+		return sh
+	}
+	for i, p := range shapePaths(n.Node.Shape, n.Rect) {
+		// Only the first path is the filled outline; the rest are details
+		s.add(g, paint(p, i == 0), nil)
+	}
+	s.add(g, nil, &text{at: n.Rect.Center(), lines: n.Lines, color: colors.Text})
+}
+
+// shapePaths returns the outline of a node shape in r, followed by any
+// detail lines drawn over it. Unknown shapes are drawn as rectangles.
+func shapePaths(shape string, r layout.Rect) []path {
+	x, y, w, h := r.X, r.Y, r.W, r.H
+	cx, cy := x+w/2, y+h/2
+	k := h * 0.4 // slant of parallelograms and trapezoids
+	pt := func(x, y float64) layout.Point { return layout.Point{X: x, Y: y} }
+
+	var p path
+	switch shape {
+	case "rounded":
+		p.rect(r, 10)
+	case "stadium":
+		p.rect(r, h/2)
+	case "double_rectangle":
+		p.rect(r, 0)
+		var bars path
+		bars.moveTo(x+8, y)
+		bars.lineTo(x+8, y+h)
+		bars.moveTo(x+w-8, y)
+		bars.lineTo(x+w-8, y+h)
+		return []path{p, bars}
+	case "cylinder":
+		// Body with the back of the top rim, then the front of the rim
+		ry := 8.0
+		p.moveTo(x, y+ry)
+		p.lineTo(x, y+h-ry)
+		p.arc(cx, y+h-ry, w/2, ry, 180, 0)
+		p.lineTo(x+w, y+ry)
+		p.arc(cx, y+ry, w/2, ry, 0, -180)
+		p.close()
+		var rim path
+		rim.moveTo(x, y+ry)
+		rim.arc(cx, y+ry, w/2, ry, 180, 0)
+		return []path{p, rim}
+	case "diamond":
+		p.polygon(pt(cx, y), pt(x+w, cy), pt(cx, y+h), pt(x, cy))
+	case "circle":
+		p.ellipse(cx, cy, w/2, h/2)
+	case "double_circle":
+		p.ellipse(cx, cy, w/2, h/2)
+		var inner path
+		inner.ellipse(cx, cy, w/2-5, h/2-5)
+		return []path{p, inner}
+	case "hexagon":
+		s := h * 0.3
+		p.polygon(pt(x+s, y), pt(x+w-s, y), pt(x+w, cy), pt(x+w-s, y+h), pt(x+s, y+h), pt(x, cy))
+	case "parallelogram":
+		p.polygon(pt(x+k, y), pt(x+w, y), pt(x+w-k, y+h), pt(x, y+h))
+	case "parallelogram_alt":
+		p.polygon(pt(x, y), pt(x+w-k, y), pt(x+w, y+h), pt(x+k, y+h))
+	case "trapezoid":
+		p.polygon(pt(x+k, y), pt(x+w-k, y), pt(x+w, y+h), pt(x, y+h))
+	case "trapezoid_alt":
+		p.polygon(pt(x, y), pt(x+w, y), pt(x+w-k, y+h), pt(x+k, y+h))
+	case "asymmetric":
+		p.polygon(pt(x, y), pt(x+w, y), pt(x+w, y+h), pt(x, y+h), pt(x+h/2, cy))
+	default:
+		p.rect(r, 0)
+	}
+	return []path{p}
+}
+
+// baselines returns the baseline of each line of a text block centered
+// vertically on its position
+func (t *text) baselines() []float64 {
+	first := t.at.Y - float64(len(t.lines)-1)*layout.LineHeight/2 + layout.FontSize*0.35
+	ys := make([]float64, len(t.lines))
+	for i := range t.lines {
+		ys[i] = first + float64(i)*layout.LineHeight
+	}
+	return ys
+}
+
+// dashArray parses an SVG dash array such as "6 4" or "6,4"
+func dashArray(dashes string) []float64 {
+	values := []float64{}
+	for _, field := range strings.FieldsFunc(dashes, func(r rune) bool { return r == ' ' || r == ',' }) {
+		if v, ok := pixels(field); ok {
+			values = append(values, v)
+		}
+	}
+	total := 0.0
+	for _, v := range values {
+		total += v
+	}
+	if total == 0 {
+		return nil
+	}
+	if len(values)%2 == 1 {
+		values = append(values, values...)
+	}
+	return values
+}
+
+// num formats a coordinate with one decimal, dropping a trailing .0
+func num(v float64) string {
+	s := fmt.Sprintf("%.1f", v)
+	s = strings.TrimSuffix(s, ".0")
+	if s == "-0" {
+		return "0"
+	}
+	return s
+}
@@ -0,0 +1,119 @@
+// Package render draws laid out diagrams as SVG, PNG or PDF without a
+// browser, or as box-drawing text for a terminal. Every image format draws
+// the same scene, and the output depends only on the layout and options,
+// so rendering a diagram twice gives identical bytes.
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/user/flowlint/internal/layout"
+)
+
+const fontFamily = "DejaVu Sans, Helvetica, Arial, sans-serif"
+
+// SVG draws a layout as a standalone SVG document. It is vector output,
+// so only the Background option applies.
+func SVG(l *layout.Layout, opts Options) []byte {
+	s := buildScene(l, opts)
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%s" height="%s" viewBox="0 0 %s %s" font-family="%s" font-size="%s">`+"\n",
+		num(s.width), num(s.height), num(s.width), num(s.height), fontFamily, num(layout.FontSize))
+	if s.background != "" {
+		fmt.Fprintf(&b, `<rect width="100%%" height="100%%" fill="%s"/>`+"\n", esc(s.background))
+	}
+
+	var open *group
+	for _, it := range s.items {
+		if it.group != open {
+			if open != nil {
+				b.WriteString("</g>\n")
+			}
+			open = it.group
+			if open.id != "" {
+				fmt.Fprintf(&b, `<g class="%s" id="%s">`, open.class, esc(open.id))
+			} else {
+				fmt.Fprintf(&b, `<g class="%s">`, open.class)
+			}
+		}
+		if it.shape != nil {
+			writeSVGShape(&b, it.shape)
+		}
+		if it.text != nil {
+			writeSVGText(&b, it.text)
+		}
+	}
+	if open != nil {
+		b.WriteString("</g>\n")
+	}
+
+	b.WriteString("</svg>\n")
+	return b.Bytes()
+}
+
+func writeSVGShape(b *bytes.Buffer, sh *shape) {
+	if len(sh.path) == 0 {
+		return
+	}
+	var d strings.Builder
+	for i, seg := range sh.path {
+		if i > 0 {
+			d.WriteByte(' ')
+		}
+		d.WriteByte(seg.op)
+		for j, p := range seg.points {
+			if j > 0 {
+				d.WriteByte(' ')
+			}
+			d.WriteString(num(p.X) + "," + num(p.Y))
+		}
+	}
+
+	fill := sh.fill
+	if fill == "" {
+		fill = "none"
+	}
+	fmt.Fprintf(b, `<path d="%s" fill="%s"`, d.String(), esc(fill))
+	if sh.stroke != "" && sh.width > 0 {
+		fmt.Fprintf(b, ` stroke="%s" stroke-width="%s"`, esc(sh.stroke), num(sh.width))
+		if sh.dashes != "" {
+			fmt.Fprintf(b, ` stroke-dasharray="%s"`, esc(sh.dashes))
+		}
+	}
+	b.WriteString("/>")
+}
+
+func writeSVGText(b *bytes.Buffer, t *text) {
+	fmt.Fprintf(b, `<text text-anchor="middle" fill="%s"`, esc(t.color))
+	if t.bold {
+		b.WriteString(` font-weight="bold"`)
+	}
+	b.WriteString(">")
+	for i, y := range t.baselines() {
+		fmt.Fprintf(b, `<tspan x="%s" y="%s">%s</tspan>`, num(t.at.X), num(y), esc(t.lines[i]))
+	}
+	b.WriteString("</text>")
+}
+
+// esc escapes text for use in XML content and attributes
+func esc(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch r {
+		case '&':
+			b.WriteString("&amp;")
+		case '<':
+			b.WriteString("&lt;")
+		case '>':
+			b.WriteString("&gt;")
+		case '"':
+			b.WriteString("&quot;")
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
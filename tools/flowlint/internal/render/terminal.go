@@ -0,0 +1,463 @@
+package render
+
+import (
+	"math"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/user/flowlint/internal/layout"
+	"github.com/user/flowlint/internal/parser"
+)
+
+// Size of a terminal character cell, in layout pixels
+const (
+	cellWidth  = 7.0
+	cellHeight = 14.0
+)
+
+// Directions a line leaves a cell in, combined into a mask
+const (
+	lineUp = 1 << iota
+	lineDown
+	lineLeft
+	lineRight
+)
+
+// Line weights, heavier ones winning where lines meet
+const (
+	weightDotted = iota + 1
+	weightThin
+	weightThick
+)
+
+// lineRunes maps a direction mask to its box-drawing character, per weight
+var lineRunes = map[int]map[int]rune{
+	weightThin: {
+		lineUp: '│', lineDown: '│', lineUp | lineDown: '│',
+		lineLeft: '─', lineRight: '─', lineLeft | lineRight: '─',
+		lineDown | lineRight: '┌', lineDown | lineLeft: '┐',
+		lineUp | lineRight: '└', lineUp | lineLeft: '┘',
+		lineUp | lineDown | lineRight: '├', lineUp | lineDown | lineLeft: '┤',
+		lineDown | lineLeft | lineRight: '┬', lineUp | lineLeft | lineRight: '┴',
+		lineUp | lineDown | lineLeft | lineRight: '┼',
+	},
+	weightThick: {
+		lineUp: '┃', lineDown: '┃', lineUp | lineDown: '┃',
+		lineLeft: '━', lineRight: '━', lineLeft | lineRight: '━',
+		lineDown | lineRight: '┏', lineDown | lineLeft: '┓',
+		lineUp | lineRight: '┗', lineUp | lineLeft: '┛',
+		lineUp | lineDown | lineRight: '┣', lineUp | lineDown | lineLeft: '┫',
+		lineDown | lineLeft | lineRight: '┳', lineUp | lineLeft | lineRight: '┻',
+		lineUp | lineDown | lineLeft | lineRight: '╋',
+	},
+	weightDotted: {
+		lineUp: '┆', lineDown: '┆', lineUp | lineDown: '┆',
+		lineLeft: '┄', lineRight: '┄', lineLeft | lineRight: '┄',
+	},
+}
+
+// boxRunes are the corners and sides of a node outline
+type boxRunes struct {
+	topLeft, topRight, bottomLeft, bottomRight, horizontal, vertical rune
+}
+
+// frameRunes outline subgraphs, so they stand apart from nodes
+var frameRunes = boxRunes{'╔', '╗', '╚', '╝', '═', '║'}
+
+// shapeRunes hint at a node's shape through its outline
+var shapeRunes = map[string]boxRunes{
+	"rectangle":         {'┌', '┐', '└', '┘', '─', '│'},
+	"rounded":           {'╭', '╮', '╰', '╯', '─', '│'},
+	"stadium":           {'╭', '╮', '╰', '╯', '─', '│'},
+	"circle":            {'╭', '╮', '╰', '╯', '─', '│'},
+	"double_circle":     {'╭', '╮', '╰', '╯', '─', '│'},
+	"cylinder":          {'╒', '╕', '╘', '╛', '═', '│'},
+	"double_rectangle":  {'╓', '╖', '╙', '╜', '─', '║'},
+	"diamond":           {'╱', '╲', '╲', '╱', '─', '│'},
+	"hexagon":           {'╱', '╲', '╲', '╱', '─', '│'},
+	"parallelogram":     {'╱', '╱', '╱', '╱', '─', '│'},
+	"parallelogram_alt": {'╲', '╲', '╲', '╲', '─', '│'},
+	"trapezoid":         {'╱', '╲', '╱', '╲', '─', '│'},
+	"trapezoid_alt":     {'╲', '╱', '╲', '╱', '─', '│'},
+	"asymmetric":        {'╲', '┐', '╱', '┘', '─', '│'},
+}
+
+// cell is a position on the character grid
+type cell struct {
+	row, col int
+}
+
+// cellBox is a box on the character grid, borders included
+type cellBox struct {
+	top, left, bottom, right int
+}
+
+func (b cellBox) contains(c cell) bool {
+	return c.row >= b.top && c.row <= b.bottom && c.col >= b.left && c.col <= b.right
+}
+
+// grid is a character canvas. Edge lines are kept as direction masks so
+// that lines meeting in a cell are drawn as one junction character.
+type grid struct {
+	runes  [][]rune
+	masks  [][]int
+	weight [][]int
+}
+
+// Terminal draws a layout with Unicode box-drawing characters, for
+// previews in a terminal. Subgraphs are double-line frames with the title
+// in the top border; sync edges are heavy lines, async edges dotted and
+// internal edges thin. The layout is scaled to the character grid, so
+// labels too long for their box are cut short.
+func Terminal(l *layout.Layout) string {
+	g := newGrid(toCol(l.Width)+1, toRow(l.Height)+1)
+	horizontal := l.Direction == "LR" || l.Direction == "RL"
+
+	for _, c := range l.Clusters {
+		g.outline(toBox(c.Rect), frameRunes)
+	}
+
+	type drawn struct {
+		path       []cell
+		start, end int // direction from the ends into the source and target
+	}
+	edges := make([]*drawn, len(l.Edges))
+	for i, e := range l.Edges {
+		from, okFrom := endpointBox(l, e.Edge.From)
+		to, okTo := endpointBox(l, e.Edge.To)
+		if !e.Visible() || len(e.Points) < 2 || !okFrom || !okTo {
+			continue
+		}
+		// Keep the part of the path between the two boxes
+		path := orthogonal(e.Points, horizontal)
+		first := 0
+		for first < len(path) && from.contains(path[first]) {
+			first++
+		}
+		last := first
+		for last < len(path) && !to.contains(path[last]) {
+			last++
+		}
+		if first == last {
+			continue
+		}
+		d := &drawn{
+			path:  path[first:last],
+			start: toward(path[first], from),
+			end:   toward(path[last-1], to),
+		}
+		// Where the path runs into a box, the head points the way it runs
+		if first > 0 {
+			d.start = direction(path[first], path[first-1])
+		}
+		if last < len(path) {
+			d.end = direction(path[last-1], path[last])
+		}
+		edges[i] = d
+		g.line(edges[i].path, edgeWeight(e.Edge))
+	}
+	for i, e := range l.Edges {
+		if d := edges[i]; d != nil {
+			g.head(d.path[len(d.path)-1], d.end, e.Edge.Head)
+			if e.Edge.Bidirectional {
+				g.head(d.path[0], d.start, e.Edge.Head)
+			}
+		}
+	}
+	// Labels are centered on the middle of the drawn path
+	for i, e := range l.Edges {
+		if edges[i] == nil || e.Edge.Label == "" {
+			continue
+		}
+		label := strings.Join(strings.Fields(e.Edge.Label), " ")
+		at := edges[i].path[len(edges[i].path)/2]
+		at.col -= utf8.RuneCountInString(label) / 2
+		g.write(at, label)
+	}
+
+	// Titles go over any edge crossing the top border
+	for _, c := range l.Clusters {
+		b := toBox(c.Rect)
+		if title := fitText(" "+c.Title+" ", b.right-b.left-3); title != "  " {
+			g.write(cell{b.top, b.left + 2}, title)
+		}
+	}
+
+	for _, n := range l.Nodes {
+		b := toBox(n.Rect)
+		if b.bottom-b.top-1 < len(n.Lines) {
+			b.bottom = b.top + len(n.Lines) + 1
+		}
+		runes, ok := shapeRunes[n.Node.Shape]
+		if !ok {
+			runes = shapeRunes["rectangle"]
+		}
+		g.clear(b)
+		g.outline(b, runes)
+		inner := b.right - b.left - 1
+		first := b.top + 1 + (b.bottom-b.top-1-len(n.Lines))/2
+		for i, line := range n.Lines {
+			line = fitText(line, inner)
+			g.write(cell{first + i, b.left + 1 + (inner-utf8.RuneCountInString(line))/2}, line)
+		}
+	}
+
+	return g.String()
+}
+
+// toCol and toRow map layout coordinates to the character grid
+func toCol(x float64) int {
+	return int(math.Round(x / cellWidth))
+}
+
+func toRow(y float64) int {
+	return int(math.Round(y / cellHeight))
+}
+
+func toCell(p layout.Point) cell {
+	return cell{toRow(p.Y), toCol(p.X)}
+}
+
+func toBox(r layout.Rect) cellBox {
+	return cellBox{
+		top:    toRow(r.Y),
+		left:   toCol(r.X),
+		bottom: toRow(r.Y+r.H) - 1,
+		right:  toCol(r.X+r.W) - 1,
+	}
+}
+
+// endpointBox returns the grid box of an edge endpoint
+func endpointBox(l *layout.Layout, id string) (cellBox, bool) {
+	if n := l.Node(id); n != nil {
+		return toBox(n.Rect), true
+	}
+	if c := l.Cluster(id); c != nil {
+		return toBox(c.Rect), true
+	}
+	return cellBox{}, false
+}
+
+// edgeWeight picks the line weight of an edge from its stroke
+func edgeWeight(e *parser.Edge) int {
+	switch e.Stroke {
+	case parser.StrokeThick:
+		return weightThick
+	case parser.StrokeDotted:
+		return weightDotted
+	}
+	return weightThin
+}
+
+// orthogonal turns an edge polyline into a path of grid cells made of
+// horizontal and vertical runs. A diagonal segment becomes a step that
+// turns halfway along the rank axis.
+func orthogonal(points []layout.Point, horizontal bool) []cell {
+	path := []cell{toCell(points[0])}
+	runTo := func(to cell) {
+		from := path[len(path)-1]
+		for from != to {
+			switch {
+			case from.row < to.row:
+				from.row++
+			case from.row > to.row:
+				from.row--
+			case from.col < to.col:
+				from.col++
+			default:
+				from.col--
+			}
+			path = append(path, from)
+		}
+	}
+	for _, p := range points[1:] {
+		from, to := path[len(path)-1], toCell(p)
+		switch {
+		case from.row == to.row || from.col == to.col:
+		case horizontal:
+			mid := (from.col + to.col) / 2
+			runTo(cell{from.row, mid})
+			runTo(cell{to.row, mid})
+		default:
+			mid := (from.row + to.row) / 2
+			runTo(cell{mid, from.col})
+			runTo(cell{mid, to.col})
+		}
+		runTo(to)
+	}
+	return path
+}
+
+// fitText cuts text to at most width characters, marking the cut
+func fitText(text string, width int) string {
+	if width <= 0 {
+		return ""
+	}
+	runes := []rune(text)
+	if len(runes) <= width {
+		return text
+	}
+	return string(runes[:width-1]) + "…"
+}
+
+func newGrid(cols, rows int) *grid {
+	g := &grid{
+		runes:  make([][]rune, rows),
+		masks:  make([][]int, rows),
+		weight: make([][]int, rows),
+	}
+	for r := range g.runes {
+		g.runes[r] = make([]rune, cols)
+		g.masks[r] = make([]int, cols)
+		g.weight[r] = make([]int, cols)
+	}
+	return g
+}
+
+func (g *grid) inBounds(c cell) bool {
+	return c.row >= 0 && c.row < len(g.runes) && c.col >= 0 && c.col < len(g.runes[c.row])
+}
+
+// set puts a character in a cell, replacing any line through it
+func (g *grid) set(c cell, r rune) {
+	if !g.inBounds(c) {
+		return
+	}
+	g.runes[c.row][c.col] = r
+	g.masks[c.row][c.col] = 0
+	g.weight[c.row][c.col] = 0
+}
+
+func (g *grid) write(at cell, text string) {
+	for _, r := range text {
+		g.set(at, r)
+		at.col++
+	}
+}
+
+// clear blanks a box so that nothing drawn earlier shows through
+func (g *grid) clear(b cellBox) {
+	for r := b.top; r <= b.bottom; r++ {
+		for c := b.left; c <= b.right; c++ {
+			g.set(cell{r, c}, ' ')
+		}
+	}
+}
+
+func (g *grid) outline(b cellBox, runes boxRunes) {
+	for c := b.left + 1; c < b.right; c++ {
+		g.set(cell{b.top, c}, runes.horizontal)
+		g.set(cell{b.bottom, c}, runes.horizontal)
+	}
+	for r := b.top + 1; r < b.bottom; r++ {
+		g.set(cell{r, b.left}, runes.vertical)
+		g.set(cell{r, b.right}, runes.vertical)
+	}
+	g.set(cell{b.top, b.left}, runes.topLeft)
+	g.set(cell{b.top, b.right}, runes.topRight)
+	g.set(cell{b.bottom, b.left}, runes.bottomLeft)
+	g.set(cell{b.bottom, b.right}, runes.bottomRight)
+}
+
+// line draws a path of adjacent cells, joining it to lines already there
+func (g *grid) line(path []cell, weight int) {
+	for i, c := range path {
+		if !g.inBounds(c) {
+			continue
+		}
+		mask := 0
+		if i > 0 {
+			mask |= direction(c, path[i-1])
+		}
+		if i+1 < len(path) {
+			mask |= direction(c, path[i+1])
+		}
+		mask |= g.masks[c.row][c.col]
+		w := max(weight, g.weight[c.row][c.col])
+		r, ok := lineRunes[w][mask]
+		if !ok {
+			r = lineRunes[weightThin][mask]
+		}
+		g.runes[c.row][c.col] = r
+		g.masks[c.row][c.col] = mask
+		g.weight[c.row][c.col] = w
+	}
+}
+
+// head draws the head of an edge in a cell, pointing in a direction
+func (g *grid) head(at cell, dir int, head string) {
+	var r rune
+	switch head {
+	case parser.HeadArrow:
+		r = map[int]rune{lineUp: '▲', lineDown: '▼', lineLeft: '◀', lineRight: '▶'}[dir]
+	case parser.HeadCross:
+		r = '×'
+	case parser.HeadCircle:
+		r = '●'
+	default:
+		return
+	}
+	g.set(at, r)
+}
+
+// toward returns the direction from a cell next to a box into the box
+func toward(c cell, b cellBox) int {
+	switch {
+	case c.row > b.bottom:
+		return lineUp
+	case c.row < b.top:
+		return lineDown
+	case c.col > b.right:
+		return lineLeft
+	}
+	return lineRight
+}
+
+// direction returns the direction from a cell to an adjacent one
+func direction(from, to cell) int {
+	switch {
+	case to.row < from.row:
+		return lineUp
+	case to.row > from.row:
+		return lineDown
+	case to.col < from.col:
+		return lineLeft
+	case to.col > from.col:
+		return lineRight
+	}
+	return 0
+}
+
+// String returns the drawing without blank margins or trailing spaces
+func (g *grid) String() string {
+	lines := make([]string, len(g.runes))
+	indent := math.MaxInt
+	for r, row := range g.runes {
+		var b strings.Builder
+		for _, ch := range row {
+			if ch == 0 {
+				ch = ' '
+			}
+			b.WriteRune(ch)
+		}
+		lines[r] = strings.TrimRight(b.String(), " ")
+		if lines[r] != "" {
+			indent = min(indent, len(lines[r])-len(strings.TrimLeft(lines[r], " ")))
+		}
+	}
+
+	out := []string{}
+	for _, line := range lines {
+		if line == "" && len(out) == 0 {
+			continue
+		}
+		if len(line) >= indent {
+			line = line[indent:]
+		}
+		out = append(out, line)
+	}
+	for len(out) > 0 && out[len(out)-1] == "" {
+		out = out[:len(out)-1]
+	}
+	return strings.Join(out, "\n") + "\n"
+}
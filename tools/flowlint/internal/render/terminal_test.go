@@ -0,0 +1,21 @@
+package render
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTerminalGolden(t *testing.T) {
+	for _, name := range inputs(t) {
+		t.Run(name, func(t *testing.T) {
+			got := Terminal(mustLayout(t, name))
+			if !utf8.ValidString(got) {
+				t.Fatalf("preview is not valid UTF-8")
+			}
+			if again := Terminal(mustLayout(t, name)); again != got {
+				t.Errorf("second preview differs:\n%s\nfirst:\n%s", again, got)
+			}
+			checkGolden(t, name+".txt.golden", []byte(got))
+		})
+	}
+}
@@ -0,0 +1,203 @@
+package styles
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// defaultGuideYAML is the built-in style guide: a copy of the repository's
+// styles/diagram-styles.yaml, which go:embed cannot reach from here
+//
+//go:embed diagram-styles.yaml
+var defaultGuideYAML []byte
+
+// Guide is the style guide, styles/diagram-styles.yaml, in typed form.
+// Rules, fixes and generators read it, so editing the YAML changes their
+// behavior without a rebuild.
+type Guide struct {
+	Path string `yaml:"-"` // file the guide was loaded from, "" for the built-in one
+
+	Layout           Layout            `yaml:"layout"`
+	Colors           map[string]Color  `yaml:"colors"` // by class
+	Shapes           map[string]string `yaml:"shapes"` // shape name by node type
+	Arrows           map[string]Arrow  `yaml:"arrows"` // by connection type: sync, async, internal
+	Labels           Labels            `yaml:"labels"`
+	Subgraphs        Subgraphs         `yaml:"subgraphs"`
+	ClassDefs        map[string]string `yaml:"-"` // full classDef statements by class
+	LayoutStrategies LayoutStrategies  `yaml:"layout_strategies"`
+	BestPractices    []string          `yaml:"best_practices"`
+	AntiPatterns     []string          `yaml:"anti_patterns"`
+
+	classOrder []string // classes in the order the classDefs are written
+}
+
+// Layout is the overall flow of a diagram
+type Layout struct {
+	Direction string   `yaml:"direction"`
+	Order     []string `yaml:"order"` // node groups from top to bottom
+}
+
+// Color is the fill, stroke and text color of a class
+type Color struct {
+	Fill   string `yaml:"fill"`
+	Stroke string `yaml:"stroke"`
+	Text   string `yaml:"text"`
+}
+
+// Arrow is how one kind of connection is drawn
+type Arrow struct {
+	Style       string   `yaml:"style"`
+	Description string   `yaml:"description"`
+	UseFor      []string `yaml:"use_for"`
+}
+
+// Labels holds the edge label formats, e.g. sync_grpc: "|gRPC: {method}|",
+// and the naming rules by kind of node
+type Labels struct {
+	Formats map[string]string `yaml:",inline"`
+	Naming  map[string]string `yaml:"naming"`
+}
+
+// Subgraphs holds the subgraph syntax and the standard subgraphs
+type Subgraphs struct {
+	Syntax   string     `yaml:"syntax"`
+	Standard []Subgraph `yaml:"standard"`
+}
+
+// Subgraph is a standard subgraph ID and display name
+type Subgraph struct {
+	ID   string `yaml:"id"`
+	Name string `yaml:"name"`
+}
+
+// LayoutStrategies holds the thresholds for choosing a layout
+type LayoutStrategies struct {
+	Thresholds Thresholds `yaml:"thresholds"`
+}
+
+// Thresholds decide between the grouped and linear pipeline layouts and
+// cap the size of a diagram
+type Thresholds struct {
+	UseGroupedMaxDeps     int  `yaml:"use_grouped_max_deps"`
+	UseGroupedMaxKafka    int  `yaml:"use_grouped_max_kafka"`
+	ForceLinearOnCrossing bool `yaml:"force_linear_on_crossing"`
+	MaxNodes              int  `yaml:"max_nodes"`
+	MaxEdges              int  `yaml:"max_edges"`
+}
+
+// guideFile is the YAML layout; classDefs is a block of statements
+type guideFile struct {
+	Guide     `yaml:",inline"`
+	ClassDefs string `yaml:"classDefs"`
+}
+
+// LoadGuide reads a style guide YAML file. Thresholds it leaves out are
+// the built-in ones; zero is a limit like any other.
+func LoadGuide(path string) (*Guide, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read style guide: %w", err)
+	}
+	return parseGuide(data, path, DefaultGuide().LayoutStrategies.Thresholds)
+}
+
+// DefaultGuide returns the built-in style guide, used when no
+// diagram-styles.yaml is found
+func DefaultGuide() *Guide {
+	g, err := parseGuide(defaultGuideYAML, "", Thresholds{})
+	if err != nil {
+		panic(fmt.Sprintf("built-in style guide: %v", err))
+	}
+	return g
+}
+
+// parseGuide reads style guide YAML, starting the thresholds from
+// defaults. path is only used in errors and as the guide's Path.
+func parseGuide(data []byte, path string, defaults Thresholds) (*Guide, error) {
+	var file guideFile
+	file.LayoutStrategies.Thresholds = defaults
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse style guide %s: %w", path, err)
+	}
+
+	g := file.Guide
+	g.Path = path
+	t := g.LayoutStrategies.Thresholds
+	for _, limit := range []struct {
+		key   string
+		value int
+	}{
+		{"use_grouped_max_deps", t.UseGroupedMaxDeps},
+		{"use_grouped_max_kafka", t.UseGroupedMaxKafka},
+		{"max_nodes", t.MaxNodes},
+		{"max_edges", t.MaxEdges},
+	} {
+		if limit.value < 0 {
+			return nil, fmt.Errorf("invalid style guide %s: layout_strategies.thresholds.%s is %d; it must not be negative", path, limit.key, limit.value)
+		}
+	}
+	g.ClassDefs = make(map[string]string)
+	for _, line := range strings.Split(file.ClassDefs, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) >= 3 && fields[0] == "classDef" {
+			g.ClassDefs[fields[1]] = strings.Join(fields, " ")
+			g.classOrder = append(g.classOrder, fields[1])
+		}
+	}
+	return &g, nil
+}
+
+// ClassDefLines returns the classDef statements in the order the guide
+// writes them
+func (g *Guide) ClassDefLines() []string {
+	lines := make([]string, len(g.classOrder))
+	for i, class := range g.classOrder {
+		lines[i] = g.ClassDefs[class]
+	}
+	return lines
+}
+
+// ClassDef returns the canonical classDef statement of a class: the one
+// in the guide's classDefs, or one built from its palette colors
+func (g *Guide) ClassDef(class string) (string, bool) {
+	if def, ok := g.ClassDefs[class]; ok {
+		return def, true
+	}
+	if c, ok := g.Colors[class]; ok {
+		return fmt.Sprintf("classDef %s fill:%s,stroke:%s,color:%s", class, c.Fill, c.Stroke, c.Text), true
+	}
+	return "", false
+}
+
+// ClassDefStyles returns the style properties of a classDef statement:
+// everything after the class name, so props written with spaces after
+// the commas are all kept
+func ClassDefStyles(def string) string {
+	fields := strings.SplitN(strings.Join(strings.Fields(def), " "), " ", 3)
+	if len(fields) < 3 {
+		return ""
+	}
+	return fields[2]
+}
+
+// ShapeOf returns the shape name the guide gives a node type, falling
+// back to the built-in one; "" if neither knows the type
+func (g *Guide) ShapeOf(nodeType string) string {
+	if shape, ok := g.Shapes[nodeType]; ok {
+		return shape
+	}
+	return NodeTypeToShape[nodeType]
+}
+
+// ArrowOf returns the link syntax for a connection type (sync, async or
+// internal), falling back to the built-in one
+func (g *Guide) ArrowOf(kind string) string {
+	if a, ok := g.Arrows[kind]; ok && a.Style != "" {
+		return a.Style
+	}
+	return ArrowTypes[kind]
+}
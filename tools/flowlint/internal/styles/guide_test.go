@@ -0,0 +1,105 @@
+package styles
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestClassDefStyles(t *testing.T) {
+	tests := []struct {
+		def  string
+		want string
+	}{
+		{"classDef db fill:#ffec99,stroke:#fcc419", "fill:#ffec99,stroke:#fcc419"},
+		{"classDef db fill:#ffec99, stroke:#fcc419, color:#e67700", "fill:#ffec99, stroke:#fcc419, color:#e67700"},
+		{"  classDef   db   fill:#ffec99,  stroke:#fcc419", "fill:#ffec99, stroke:#fcc419"},
+		{"classDef db", ""},
+	}
+	for _, tt := range tests {
+		if got := ClassDefStyles(tt.def); got != tt.want {
+			t.Errorf("ClassDefStyles(%q) = %q, want %q", tt.def, got, tt.want)
+		}
+	}
+}
+
+func TestLoadGuideThresholds(t *testing.T) {
+	defaults := DefaultGuide().LayoutStrategies.Thresholds
+	tests := []struct {
+		name string
+		yaml string
+		want Thresholds
+	}{
+		{"absent", "layout:\n  direction: TD\n", defaults},
+		{
+			name: "zero kept",
+			yaml: "layout_strategies:\n  thresholds:\n    max_nodes: 0\n    max_edges: 0\n",
+			want: Thresholds{UseGroupedMaxDeps: 8, UseGroupedMaxKafka: 4, ForceLinearOnCrossing: true},
+		},
+		{
+			name: "partial",
+			yaml: "layout_strategies:\n  thresholds:\n    use_grouped_max_kafka: 0\n    force_linear_on_crossing: false\n    max_nodes: 30\n",
+			want: Thresholds{UseGroupedMaxDeps: 8, MaxNodes: 30, MaxEdges: 25},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "diagram-styles.yaml")
+			if err := os.WriteFile(path, []byte(tt.yaml), 0644); err != nil {
+				t.Fatal(err)
+			}
+			g, err := LoadGuide(path)
+			if err != nil {
+				t.Fatalf("LoadGuide: %v", err)
+			}
+			if got := g.LayoutStrategies.Thresholds; got != tt.want {
+				t.Errorf("thresholds = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadGuideNegativeThreshold(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "diagram-styles.yaml")
+	if err := os.WriteFile(path, []byte("layout_strategies:\n  thresholds:\n    max_edges: -1\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	_, err := LoadGuide(path)
+	if err == nil || !strings.Contains(err.Error(), "max_edges is -1") {
+		t.Errorf("LoadGuide error = %v, want one about max_edges", err)
+	}
+}
+
+func TestDefaultGuide(t *testing.T) {
+	g := DefaultGuide()
+	if g.Path != "" {
+		t.Errorf("Path = %q, want none for the built-in guide", g.Path)
+	}
+	want := Thresholds{UseGroupedMaxDeps: 8, UseGroupedMaxKafka: 4, ForceLinearOnCrossing: true, MaxNodes: 15, MaxEdges: 25}
+	if got := g.LayoutStrategies.Thresholds; got != want {
+		t.Errorf("thresholds = %+v, want %+v", got, want)
+	}
+	if got := g.ClassDefLines(); len(got) != 7 || got[0] != "classDef service fill:#a5d8ff,stroke:#339af0,color:#1864ab" {
+		t.Errorf("ClassDefLines = %q, want the seven classDefs starting with service", got)
+	}
+	if got := g.ShapeOf("kafka_topic"); got != "cylinder" {
+		t.Errorf("ShapeOf(kafka_topic) = %q, want cylinder", got)
+	}
+	if got := g.ArrowOf("async"); got != "-.->" {
+		t.Errorf("ArrowOf(async) = %q, want -.->", got)
+	}
+}
+
+func TestDefaultGuideMatchesRepository(t *testing.T) {
+	repo, err := os.ReadFile(filepath.Join("..", "..", "..", "..", "styles", "diagram-styles.yaml"))
+	if os.IsNotExist(err) {
+		t.Skip("not in the repository checkout")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(repo) != string(defaultGuideYAML) {
+		t.Errorf("internal/styles/diagram-styles.yaml differs from styles/diagram-styles.yaml; copy it over")
+	}
+}
@@ -1,19 +1,19 @@
 package styles
 
-// Colors defines the official color palette
+// Colors defines the official color palette, as in the style guide
 var Colors = map[string]struct {
 	Fill   string
 	Stroke string
 	Text   string
 }{
-	"service":  {Fill: "#228be6", Stroke: "#1971c2", Text: "#ffffff"},
-	"entry":    {Fill: "#40c057", Stroke: "#2f9e44", Text: "#ffffff"},
-	"kafka":    {Fill: "#12b886", Stroke: "#099268", Text: "#ffffff"},
-	"database": {Fill: "#fab005", Stroke: "#f59f00", Text: "#000000"},
-	"cache":    {Fill: "#be4bdb", Stroke: "#9c36b5", Text: "#ffffff"},
-	"external": {Fill: "#868e96", Stroke: "#495057", Text: "#ffffff"},
-	"error":    {Fill: "#fa5252", Stroke: "#e03131", Text: "#ffffff"},
-	"warning":  {Fill: "#fd7e14", Stroke: "#e8590c", Text: "#ffffff"},
+	"service":  {Fill: "#a5d8ff", Stroke: "#339af0", Text: "#1864ab"},
+	"entry":    {Fill: "#b2f2bb", Stroke: "#51cf66", Text: "#2b8a3e"},
+	"kafka":    {Fill: "#96f2d7", Stroke: "#38d9a9", Text: "#087f5b"},
+	"database": {Fill: "#ffec99", Stroke: "#fcc419", Text: "#e67700"},
+	"cache":    {Fill: "#d0bfff", Stroke: "#9775fa", Text: "#6741d9"},
+	"external": {Fill: "#dee2e6", Stroke: "#adb5bd", Text: "#495057"},
+	"error":    {Fill: "#ffc9c9", Stroke: "#ff6b6b", Text: "#c92a2a"},
+	"warning":  {Fill: "#ffd8a8", Stroke: "#ff922b", Text: "#d9480f"},
 }
 
 // Shapes defines the mermaid shape syntax for each node type
@@ -21,13 +21,20 @@ var Shapes = map[string]struct {
 	Open  string
 	Close string
 }{
-	"rectangle":        {Open: "[", Close: "]"},
-	"cylinder":         {Open: "[(", Close: ")]"},
-	"stadium":          {Open: "([", Close: "])"},
-	"double_rectangle": {Open: "[[", Close: "]]"},
-	"diamond":          {Open: "{", Close: "}"},
-	"rounded":          {Open: "(", Close: ")"},
-	"circle":           {Open: "((", Close: "))"},
+	"rectangle":         {Open: "[", Close: "]"},
+	"cylinder":          {Open: "[(", Close: ")]"},
+	"stadium":           {Open: "([", Close: "])"},
+	"double_rectangle":  {Open: "[[", Close: "]]"},
+	"diamond":           {Open: "{", Close: "}"},
+	"rounded":           {Open: "(", Close: ")"},
+	"circle":            {Open: "((", Close: "))"},
+	"double_circle":     {Open: "(((", Close: ")))"},
+	"hexagon":           {Open: "{{", Close: "}}"},
+	"parallelogram":     {Open: "[/", Close: "/]"},
+	"parallelogram_alt": {Open: "[\\", Close: "\\]"},
+	"trapezoid":         {Open: "[/", Close: "\\]"},
+	"trapezoid_alt":     {Open: "[\\", Close: "/]"},
+	"asymmetric":        {Open: ">", Close: "]"},
 }
 
 // ArrowTypes defines the arrow syntax for each connection type
@@ -44,9 +51,11 @@ var NodeTypeToShape = map[string]string{
 	"database":       "cylinder",
 	"kafka_topic":    "cylinder",
 	"consumer_group": "stadium",
+	"entry":          "stadium",
 	"external":       "double_rectangle",
 	"cache":          "rounded",
 	"decision":       "diamond",
+	"event":          "hexagon",
 }
 
 // NodeTypeToClass maps node types to their CSS class
@@ -0,0 +1,65 @@
+package styles
+
+import (
+	"regexp"
+	"sort"
+)
+
+// topicLabelRe matches Kafka topic names such as order.completed
+var topicLabelRe = regexp.MustCompile(`^[a-z0-9_-]+(\.[a-z0-9_-]+)+$`)
+
+// shapeHints tells apart types that share a shape with another type: such
+// a type is only read from the shape when its hint holds. Cylinders are
+// topics when labelled like one, and stadiums consumer groups when
+// something calls them.
+var shapeHints = map[string]func(label string, called bool) bool{
+	"kafka_topic":    func(label string, _ bool) bool { return topicLabelRe.MatchString(label) },
+	"consumer_group": func(_ string, called bool) bool { return called },
+}
+
+// TypesOfShape returns the node types the guide draws with a shape. Types
+// named after their class come first (service before handler), then the
+// rest in alphabetical order.
+func (g *Guide) TypesOfShape(shape string) []string {
+	types := []string{}
+	for t, s := range g.Shapes {
+		if s == shape {
+			types = append(types, t)
+		}
+	}
+	for t, s := range NodeTypeToShape {
+		if _, ok := g.Shapes[t]; !ok && s == shape {
+			types = append(types, t)
+		}
+	}
+	sort.Slice(types, func(i, j int) bool {
+		ci := NodeTypeToClass[types[i]] == types[i]
+		cj := NodeTypeToClass[types[j]] == types[j]
+		if ci != cj {
+			return ci
+		}
+		return types[i] < types[j]
+	})
+	return types
+}
+
+// TypeOfShape returns the node type a shape stands for when nothing else
+// tells, refined by the node's label and whether anything calls it; ""
+// if the guide draws no type with the shape
+func (g *Guide) TypeOfShape(shape, label string, called bool) string {
+	types := g.TypesOfShape(shape)
+	if len(types) == 0 {
+		return ""
+	}
+	for _, t := range types {
+		if hint, ok := shapeHints[t]; ok && hint(label, called) {
+			return t
+		}
+	}
+	for _, t := range types {
+		if _, ok := shapeHints[t]; !ok {
+			return t
+		}
+	}
+	return types[0]
+}
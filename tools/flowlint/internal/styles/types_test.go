@@ -0,0 +1,76 @@
+package styles
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTypesOfShape(t *testing.T) {
+	guide := DefaultGuide()
+	tests := []struct {
+		shape string
+		want  []string
+	}{
+		{"rectangle", []string{"service", "handler"}},
+		{"cylinder", []string{"database", "kafka_topic"}},
+		{"stadium", []string{"entry", "consumer_group"}},
+		{"double_rectangle", []string{"external"}},
+		{"circle", []string{}},
+	}
+	for _, tt := range tests {
+		if got := guide.TypesOfShape(tt.shape); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("TypesOfShape(%q) = %v, want %v", tt.shape, got, tt.want)
+		}
+	}
+}
+
+func TestTypeOfShape(t *testing.T) {
+	guide := DefaultGuide()
+	tests := []struct {
+		shape  string
+		label  string
+		called bool
+		want   string
+	}{
+		{"rectangle", "Order Service", false, "service"},
+		{"cylinder", "PostgreSQL", false, "database"},
+		{"cylinder", "order.completed", false, "kafka_topic"},
+		{"stadium", "POST /orders", false, "entry"},
+		{"stadium", "billing-consumers", true, "consumer_group"},
+		{"rounded", "Redis", false, "cache"},
+		{"double_rectangle", "Stripe API", false, "external"},
+		{"diamond", "retry?", false, "decision"},
+		{"circle", "start", false, ""},
+	}
+	for _, tt := range tests {
+		if got := guide.TypeOfShape(tt.shape, tt.label, tt.called); got != tt.want {
+			t.Errorf("TypeOfShape(%q, %q, %v) = %q, want %q", tt.shape, tt.label, tt.called, got, tt.want)
+		}
+	}
+}
+
+func TestTypeOfShapeFollowsGuide(t *testing.T) {
+	guide := DefaultGuide()
+	guide.Shapes["entry"] = "rounded"
+	guide.Shapes["cache"] = "hexagon"
+
+	if got := guide.TypeOfShape("rounded", "GET /orders", false); got != "entry" {
+		t.Errorf("rounded = %q, want entry", got)
+	}
+	if got := guide.TypeOfShape("hexagon", "Redis", false); got != "cache" {
+		t.Errorf("hexagon = %q, want cache", got)
+	}
+	if got := guide.TypeOfShape("stadium", "orders-consumers", false); got != "consumer_group" {
+		t.Errorf("stadium = %q, want consumer_group, the only stadium type left", got)
+	}
+}
+
+func TestShippedGuideShapes(t *testing.T) {
+	guide, err := LoadGuide("../../../../styles/diagram-styles.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(guide.Shapes, DefaultGuide().Shapes) {
+		t.Errorf("diagram-styles.yaml shapes = %v, built-in = %v", guide.Shapes, DefaultGuide().Shapes)
+	}
+}